@@ -196,11 +196,16 @@ func (c *Client) APIKeys() (APIKeys, error) {
 	return apiKeys, nil
 }
 
-// CreateAPIKey creates a new API key for the authenticated user.
+// CreateAPIKey creates a new API key with full access for the authenticated user.
 func (c *Client) CreateAPIKey(description string) (*APIKey, error) {
-	body, err := c.request.Post("/v1/api-keys", &APIKeyCreationRequest{
+	return c.CreateScopedAPIKey(&APIKeyCreationRequest{
 		Description: description,
 	})
+}
+
+// CreateScopedAPIKey creates a new API key with the given scopes and expiration date for the authenticated user.
+func (c *Client) CreateScopedAPIKey(apiKeyCreationRequest *APIKeyCreationRequest) (*APIKey, error) {
+	body, err := c.request.Post("/v1/api-keys", apiKeyCreationRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -219,6 +224,45 @@ func (c *Client) DeleteAPIKey(apiKeyID int64) error {
 	return c.request.Delete(fmt.Sprintf("/v1/api-keys/%d", apiKeyID))
 }
 
+// AuditLogs fetches the audit log entries, the most recent first (requires admin privileges).
+func (c *Client) AuditLogs(filter *AuditLogFilter) (*AuditLogResultSet, error) {
+	path := "/v1/audit-logs"
+	if filter != nil {
+		values := url.Values{}
+		if filter.Action != "" {
+			values.Set("action", filter.Action)
+		}
+		if filter.Actor != "" {
+			values.Set("actor", filter.Actor)
+		}
+		if filter.Search != "" {
+			values.Set("search", filter.Search)
+		}
+		if filter.Offset > 0 {
+			values.Set("offset", strconv.Itoa(filter.Offset))
+		}
+		if filter.Limit > 0 {
+			values.Set("limit", strconv.Itoa(filter.Limit))
+		}
+		if len(values) > 0 {
+			path += "?" + values.Encode()
+		}
+	}
+
+	body, err := c.request.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result AuditLogResultSet
+	if err := json.NewDecoder(body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
 // MarkAllAsRead marks all unread entries as read for a given user.
 func (c *Client) MarkAllAsRead(userID int64) error {
 	_, err := c.request.Put(fmt.Sprintf("/v1/users/%d/mark-all-as-read", userID), nil)
@@ -392,6 +436,172 @@ func (c *Client) RefreshCategory(categoryID int64) error {
 	return err
 }
 
+// Labels gets the list of labels.
+func (c *Client) Labels() (Labels, error) {
+	body, err := c.request.Get("/v1/labels")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var labels Labels
+	if err := json.NewDecoder(body).Decode(&labels); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return labels, nil
+}
+
+// LabelsWithCounters fetches the labels with their respective entry and unread counts.
+func (c *Client) LabelsWithCounters() (Labels, error) {
+	body, err := c.request.Get("/v1/labels?counts=true")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var labels Labels
+	if err := json.NewDecoder(body).Decode(&labels); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return labels, nil
+}
+
+// CreateLabel creates a new label.
+func (c *Client) CreateLabel(title string) (*Label, error) {
+	body, err := c.request.Post("/v1/labels", &LabelModificationRequest{Title: title})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var label *Label
+	if err := json.NewDecoder(body).Decode(&label); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return label, nil
+}
+
+// UpdateLabel renames a label.
+func (c *Client) UpdateLabel(labelID int64, title string) (*Label, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/labels/%d", labelID), &LabelModificationRequest{Title: title})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var label *Label
+	if err := json.NewDecoder(body).Decode(&label); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return label, nil
+}
+
+// DeleteLabel removes a label, the entries are not removed.
+func (c *Client) DeleteLabel(labelID int64) error {
+	return c.request.Delete(fmt.Sprintf("/v1/labels/%d", labelID))
+}
+
+// LabelEntries fetch entries with the given label.
+func (c *Client) LabelEntries(labelID int64, filter *Filter) (*EntryResultSet, error) {
+	path := buildFilterQueryString(fmt.Sprintf("/v1/labels/%d/entries", labelID), filter)
+
+	body, err := c.request.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result EntryResultSet
+	if err := json.NewDecoder(body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
+// SetEntryLabels replaces the labels attached to an entry.
+func (c *Client) SetEntryLabels(entryID int64, labelIDs []int64) error {
+	type payload struct {
+		LabelIDs []int64 `json:"label_ids"`
+	}
+
+	_, err := c.request.Put(fmt.Sprintf("/v1/entries/%d/labels", entryID), &payload{LabelIDs: labelIDs})
+	return err
+}
+
+// Highlights fetches the highlights of the user, the most recent first.
+func (c *Client) Highlights(offset, limit int) (*HighlightResultSet, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/highlights?offset=%d&limit=%d", offset, limit))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result HighlightResultSet
+	if err := json.NewDecoder(body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
+// EntryHighlights fetches the highlights of an entry.
+func (c *Client) EntryHighlights(entryID int64) (Highlights, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/entries/%d/highlights", entryID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var highlights Highlights
+	if err := json.NewDecoder(body).Decode(&highlights); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return highlights, nil
+}
+
+// CreateHighlight saves a passage of an entry.
+func (c *Client) CreateHighlight(entryID int64, highlightCreationRequest *HighlightCreationRequest) (*Highlight, error) {
+	body, err := c.request.Post(fmt.Sprintf("/v1/entries/%d/highlights", entryID), highlightCreationRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var highlight *Highlight
+	if err := json.NewDecoder(body).Decode(&highlight); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return highlight, nil
+}
+
+// UpdateHighlight updates the note of a highlight.
+func (c *Client) UpdateHighlight(entryID, highlightID int64, highlightModificationRequest *HighlightModificationRequest) (*Highlight, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/entries/%d/highlights/%d", entryID, highlightID), highlightModificationRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var highlight *Highlight
+	if err := json.NewDecoder(body).Decode(&highlight); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return highlight, nil
+}
+
+// DeleteHighlight removes a highlight.
+func (c *Client) DeleteHighlight(entryID, highlightID int64) error {
+	return c.request.Delete(fmt.Sprintf("/v1/entries/%d/highlights/%d", entryID, highlightID))
+}
+
 // Feeds gets all feeds.
 func (c *Client) Feeds() (Feeds, error) {
 	body, err := c.request.Get("/v1/feeds")
@@ -482,6 +692,22 @@ func (c *Client) UpdateFeed(feedID int64, feedChanges *FeedModificationRequest)
 	return f, nil
 }
 
+// BatchFeeds applies the same action to many feeds.
+func (c *Client) BatchFeeds(feedBatchRequest *FeedBatchRequest) (*FeedBatchResponse, error) {
+	body, err := c.request.Post("/v1/feeds/batch", feedBatchRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var response *FeedBatchResponse
+	if err := json.NewDecoder(body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return response, nil
+}
+
 // MarkFeedAsRead marks all unread entries of the feed as read.
 func (c *Client) MarkFeedAsRead(feedID int64) error {
 	_, err := c.request.Put(fmt.Sprintf("/v1/feeds/%d/mark-all-as-read", feedID), nil)
@@ -656,6 +882,23 @@ func (c *Client) ToggleStarred(entryID int64) error {
 	return err
 }
 
+// ToggleReadLater adds or removes the entry from the read later queue.
+func (c *Client) ToggleReadLater(entryID int64) error {
+	_, err := c.request.Put(fmt.Sprintf("/v1/entries/%d/later", entryID), nil)
+	return err
+}
+
+// SnoozeEntry hides an entry until the given time, for example "2024-03-04T09:00".
+func (c *Client) SnoozeEntry(entryID int64, snoozedUntil string) error {
+	_, err := c.request.Put(fmt.Sprintf("/v1/entries/%d/snooze", entryID), &EntrySnoozeRequest{SnoozedUntil: snoozedUntil})
+	return err
+}
+
+// UnsnoozeEntry cancels the snooze of an entry.
+func (c *Client) UnsnoozeEntry(entryID int64) error {
+	return c.request.Delete(fmt.Sprintf("/v1/entries/%d/snooze", entryID))
+}
+
 // SaveEntry sends an entry to a third-party service.
 func (c *Client) SaveEntry(entryID int64) error {
 	_, err := c.request.Post(fmt.Sprintf("/v1/entries/%d/save", entryID), nil)
@@ -801,10 +1044,22 @@ func buildFilterQueryString(path string, filter *Filter) string {
 			values.Set("starred", filter.Starred)
 		}
 
+		if filter.ReadLater != "" {
+			values.Set("later", filter.ReadLater)
+		}
+
+		if filter.Snoozed != "" {
+			values.Set("snoozed", filter.Snoozed)
+		}
+
 		if filter.Search != "" {
 			values.Set("search", filter.Search)
 		}
 
+		if filter.Language != "" {
+			values.Set("language", filter.Language)
+		}
+
 		if filter.CategoryID > 0 {
 			values.Set("category_id", strconv.FormatInt(filter.CategoryID, 10))
 		}
@@ -813,6 +1068,10 @@ func buildFilterQueryString(path string, filter *Filter) string {
 			values.Set("feed_id", strconv.FormatInt(filter.FeedID, 10))
 		}
 
+		if filter.LabelID > 0 {
+			values.Set("label_id", strconv.FormatInt(filter.LabelID, 10))
+		}
+
 		if filter.GloballyVisible {
 			values.Set("globally_visible", "true")
 		}
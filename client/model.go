@@ -48,6 +48,9 @@ type User struct {
 	ExternalFontHosts         string     `json:"external_font_hosts"`
 	AlwaysOpenExternalLinks   bool       `json:"always_open_external_links"`
 	OpenExternalLinksInNewTab bool       `json:"open_external_links_in_new_tab"`
+	EntryListLayout           string     `json:"entry_list_layout"`
+	RetentionPolicy           string     `json:"retention_policy"`
+	RetentionValue            int        `json:"retention_value"`
 }
 
 func (u User) String() string {
@@ -94,6 +97,9 @@ type UserModificationRequest struct {
 	ExternalFontHosts         *string  `json:"external_font_hosts"`
 	AlwaysOpenExternalLinks   *bool    `json:"always_open_external_links"`
 	OpenExternalLinksInNewTab *bool    `json:"open_external_links_in_new_tab"`
+	EntryListLayout           *string  `json:"entry_list_layout"`
+	RetentionPolicy           *string  `json:"retention_policy"`
+	RetentionValue            *int     `json:"retention_value"`
 }
 
 // Users represents a list of users.
@@ -101,12 +107,34 @@ type Users []User
 
 // Category represents a feed category.
 type Category struct {
-	ID           int64  `json:"id"`
-	Title        string `json:"title"`
-	UserID       int64  `json:"user_id,omitempty"`
-	HideGlobally bool   `json:"hide_globally,omitempty"`
-	FeedCount    *int   `json:"feed_count,omitempty"`
-	TotalUnread  *int   `json:"total_unread,omitempty"`
+	ID                    int64  `json:"id"`
+	Title                 string `json:"title"`
+	UserID                int64  `json:"user_id,omitempty"`
+	HideGlobally          bool   `json:"hide_globally,omitempty"`
+	ParentID              int64  `json:"parent_id,omitempty"`
+	RetentionPolicy       string `json:"retention_policy,omitempty"`
+	RetentionValue        int    `json:"retention_value,omitempty"`
+	ScraperRules          string `json:"scraper_rules,omitempty"`
+	RewriteRules          string `json:"rewrite_rules,omitempty"`
+	UrlRewriteRules       string `json:"urlrewrite_rules,omitempty"`
+	BlocklistRules        string `json:"blocklist_rules,omitempty"`
+	KeeplistRules         string `json:"keeplist_rules,omitempty"`
+	BlockFilterEntryRules string `json:"block_filter_entry_rules,omitempty"`
+	KeepFilterEntryRules  string `json:"keep_filter_entry_rules,omitempty"`
+	UserAgent             string `json:"user_agent,omitempty"`
+	Cookie                string `json:"cookie,omitempty"`
+	ProxyURL              string `json:"proxy_url,omitempty"`
+	FetchViaProxy         bool   `json:"fetch_via_proxy,omitempty"`
+	Crawler               bool   `json:"crawler,omitempty"`
+	AppriseServiceURLs    string `json:"apprise_service_urls,omitempty"`
+	WebhookURL            string `json:"webhook_url,omitempty"`
+	NtfyEnabled           bool   `json:"ntfy_enabled,omitempty"`
+	NtfyPriority          int    `json:"ntfy_priority,omitempty"`
+	NtfyTopic             string `json:"ntfy_topic,omitempty"`
+	PushoverEnabled       bool   `json:"pushover_enabled,omitempty"`
+	PushoverPriority      int    `json:"pushover_priority,omitempty"`
+	FeedCount             *int   `json:"feed_count,omitempty"`
+	TotalUnread           *int   `json:"total_unread,omitempty"`
 }
 
 func (c Category) String() string {
@@ -118,14 +146,96 @@ type Categories []*Category
 
 // CategoryCreationRequest represents the request to create a category.
 type CategoryCreationRequest struct {
-	Title        string `json:"title"`
-	HideGlobally bool   `json:"hide_globally"`
+	Title           string `json:"title"`
+	HideGlobally    bool   `json:"hide_globally"`
+	ParentID        int64  `json:"parent_id,omitempty"`
+	RetentionPolicy string `json:"retention_policy,omitempty"`
+	RetentionValue  int    `json:"retention_value,omitempty"`
 }
 
 // CategoryModificationRequest represents the request to update a category.
 type CategoryModificationRequest struct {
-	Title        *string `json:"title"`
-	HideGlobally *bool   `json:"hide_globally"`
+	Title                 *string `json:"title"`
+	HideGlobally          *bool   `json:"hide_globally"`
+	ParentID              *int64  `json:"parent_id,omitempty"`
+	RetentionPolicy       *string `json:"retention_policy,omitempty"`
+	RetentionValue        *int    `json:"retention_value,omitempty"`
+	ScraperRules          *string `json:"scraper_rules,omitempty"`
+	RewriteRules          *string `json:"rewrite_rules,omitempty"`
+	UrlRewriteRules       *string `json:"urlrewrite_rules,omitempty"`
+	BlocklistRules        *string `json:"blocklist_rules,omitempty"`
+	KeeplistRules         *string `json:"keeplist_rules,omitempty"`
+	BlockFilterEntryRules *string `json:"block_filter_entry_rules,omitempty"`
+	KeepFilterEntryRules  *string `json:"keep_filter_entry_rules,omitempty"`
+	UserAgent             *string `json:"user_agent,omitempty"`
+	Cookie                *string `json:"cookie,omitempty"`
+	ProxyURL              *string `json:"proxy_url,omitempty"`
+	FetchViaProxy         *bool   `json:"fetch_via_proxy,omitempty"`
+	Crawler               *bool   `json:"crawler,omitempty"`
+	AppriseServiceURLs    *string `json:"apprise_service_urls,omitempty"`
+	WebhookURL            *string `json:"webhook_url,omitempty"`
+	NtfyEnabled           *bool   `json:"ntfy_enabled,omitempty"`
+	NtfyPriority          *int    `json:"ntfy_priority,omitempty"`
+	NtfyTopic             *string `json:"ntfy_topic,omitempty"`
+	PushoverEnabled       *bool   `json:"pushover_enabled,omitempty"`
+	PushoverPriority      *int    `json:"pushover_priority,omitempty"`
+}
+
+// Label represents a user-defined label attached to entries.
+type Label struct {
+	ID          int64  `json:"id"`
+	Title       string `json:"title"`
+	UserID      int64  `json:"user_id,omitempty"`
+	EntryCount  *int   `json:"entry_count,omitempty"`
+	UnreadCount *int   `json:"unread_count,omitempty"`
+}
+
+func (l Label) String() string {
+	return fmt.Sprintf("#%d %s", l.ID, l.Title)
+}
+
+// Labels represents a list of labels.
+type Labels []*Label
+
+// LabelModificationRequest represents the request to create or update a label.
+type LabelModificationRequest struct {
+	Title string `json:"title"`
+}
+
+// Highlight represents a passage of an entry saved by the user.
+type Highlight struct {
+	ID          int64     `json:"id"`
+	UserID      int64     `json:"user_id"`
+	EntryID     int64     `json:"entry_id"`
+	Quote       string    `json:"quote"`
+	Note        string    `json:"note"`
+	StartOffset int       `json:"start_offset"`
+	EndOffset   int       `json:"end_offset"`
+	CreatedAt   time.Time `json:"created_at"`
+	ChangedAt   time.Time `json:"changed_at"`
+	Entry       *Entry    `json:"entry,omitempty"`
+}
+
+// Highlights represents a list of highlights.
+type Highlights []*Highlight
+
+// HighlightCreationRequest represents the request to create a highlight.
+type HighlightCreationRequest struct {
+	Quote       string `json:"quote"`
+	Note        string `json:"note,omitempty"`
+	StartOffset int    `json:"start_offset"`
+	EndOffset   int    `json:"end_offset"`
+}
+
+// HighlightModificationRequest represents the request to update a highlight.
+type HighlightModificationRequest struct {
+	Note *string `json:"note"`
+}
+
+// HighlightResultSet represents the response when fetching all highlights.
+type HighlightResultSet struct {
+	Total      int        `json:"total"`
+	Highlights Highlights `json:"highlights"`
 }
 
 // Subscription represents a feed subscription.
@@ -144,36 +254,48 @@ type Subscriptions []*Subscription
 
 // Feed represents a Miniflux feed.
 type Feed struct {
-	ID                          int64     `json:"id"`
-	UserID                      int64     `json:"user_id"`
-	FeedURL                     string    `json:"feed_url"`
-	SiteURL                     string    `json:"site_url"`
-	Title                       string    `json:"title"`
-	CheckedAt                   time.Time `json:"checked_at,omitempty"`
-	EtagHeader                  string    `json:"etag_header,omitempty"`
-	LastModifiedHeader          string    `json:"last_modified_header,omitempty"`
-	ParsingErrorMsg             string    `json:"parsing_error_message,omitempty"`
-	ParsingErrorCount           int       `json:"parsing_error_count,omitempty"`
-	Disabled                    bool      `json:"disabled"`
-	IgnoreHTTPCache             bool      `json:"ignore_http_cache"`
-	AllowSelfSignedCertificates bool      `json:"allow_self_signed_certificates"`
-	FetchViaProxy               bool      `json:"fetch_via_proxy"`
-	ScraperRules                string    `json:"scraper_rules"`
-	RewriteRules                string    `json:"rewrite_rules"`
-	UrlRewriteRules             string    `json:"urlrewrite_rules"`
-	BlocklistRules              string    `json:"blocklist_rules"`
-	KeeplistRules               string    `json:"keeplist_rules"`
-	BlockFilterEntryRules       string    `json:"block_filter_entry_rules"`
-	KeepFilterEntryRules        string    `json:"keep_filter_entry_rules"`
-	Crawler                     bool      `json:"crawler"`
-	UserAgent                   string    `json:"user_agent"`
-	Cookie                      string    `json:"cookie"`
-	Username                    string    `json:"username"`
-	Password                    string    `json:"password"`
-	Category                    *Category `json:"category,omitempty"`
-	HideGlobally                bool      `json:"hide_globally"`
-	DisableHTTP2                bool      `json:"disable_http2"`
-	ProxyURL                    string    `json:"proxy_url"`
+	ID                          int64      `json:"id"`
+	UserID                      int64      `json:"user_id"`
+	FeedURL                     string     `json:"feed_url"`
+	SiteURL                     string     `json:"site_url"`
+	Title                       string     `json:"title"`
+	CheckedAt                   time.Time  `json:"checked_at,omitempty"`
+	EtagHeader                  string     `json:"etag_header,omitempty"`
+	LastModifiedHeader          string     `json:"last_modified_header,omitempty"`
+	ParsingErrorMsg             string     `json:"parsing_error_message,omitempty"`
+	ParsingErrorCount           int        `json:"parsing_error_count,omitempty"`
+	Disabled                    bool       `json:"disabled"`
+	IgnoreHTTPCache             bool       `json:"ignore_http_cache"`
+	AllowSelfSignedCertificates bool       `json:"allow_self_signed_certificates"`
+	FetchViaProxy               bool       `json:"fetch_via_proxy"`
+	InheritFetchViaProxy        bool       `json:"inherit_fetch_via_proxy"`
+	ScraperRules                string     `json:"scraper_rules"`
+	RewriteRules                string     `json:"rewrite_rules"`
+	UrlRewriteRules             string     `json:"urlrewrite_rules"`
+	BlocklistRules              string     `json:"blocklist_rules"`
+	KeeplistRules               string     `json:"keeplist_rules"`
+	BlockFilterEntryRules       string     `json:"block_filter_entry_rules"`
+	KeepFilterEntryRules        string     `json:"keep_filter_entry_rules"`
+	Crawler                     bool       `json:"crawler"`
+	InheritCrawler              bool       `json:"inherit_crawler"`
+	LLMProcessing               bool       `json:"llm_processing"`
+	UserAgent                   string     `json:"user_agent"`
+	Cookie                      string     `json:"cookie"`
+	HTTPHeaders                 string     `json:"http_headers"`
+	ClientCertificate           string     `json:"client_certificate"`
+	ClientKey                   string     `json:"client_key"`
+	CACertificates              string     `json:"ca_certificates"`
+	Username                    string     `json:"username"`
+	Password                    string     `json:"password"`
+	Category                    *Category  `json:"category,omitempty"`
+	HideGlobally                bool       `json:"hide_globally"`
+	DisableHTTP2                bool       `json:"disable_http2"`
+	ProxyURL                    string     `json:"proxy_url"`
+	ProxyPool                   string     `json:"proxy_pool"`
+	PausedUntil                 *time.Time `json:"paused_until,omitempty"`
+	HideEntriesWhilePaused      bool       `json:"hide_entries_while_paused"`
+	RetentionPolicy             string     `json:"retention_policy"`
+	RetentionValue              int        `json:"retention_value"`
 }
 
 // FeedCreationRequest represents the request to create a feed.
@@ -182,13 +304,20 @@ type FeedCreationRequest struct {
 	CategoryID                  int64  `json:"category_id"`
 	UserAgent                   string `json:"user_agent"`
 	Cookie                      string `json:"cookie"`
+	HTTPHeaders                 string `json:"http_headers"`
+	ClientCertificate           string `json:"client_certificate"`
+	ClientKey                   string `json:"client_key"`
+	CACertificates              string `json:"ca_certificates"`
 	Username                    string `json:"username"`
 	Password                    string `json:"password"`
 	Crawler                     bool   `json:"crawler"`
+	InheritCrawler              bool   `json:"inherit_crawler"`
+	LLMProcessing               bool   `json:"llm_processing"`
 	Disabled                    bool   `json:"disabled"`
 	IgnoreHTTPCache             bool   `json:"ignore_http_cache"`
 	AllowSelfSignedCertificates bool   `json:"allow_self_signed_certificates"`
 	FetchViaProxy               bool   `json:"fetch_via_proxy"`
+	InheritFetchViaProxy        bool   `json:"inherit_fetch_via_proxy"`
 	ScraperRules                string `json:"scraper_rules"`
 	RewriteRules                string `json:"rewrite_rules"`
 	UrlRewriteRules             string `json:"urlrewrite_rules"`
@@ -199,6 +328,7 @@ type FeedCreationRequest struct {
 	HideGlobally                bool   `json:"hide_globally"`
 	DisableHTTP2                bool   `json:"disable_http2"`
 	ProxyURL                    string `json:"proxy_url"`
+	ProxyPool                   string `json:"proxy_pool"`
 }
 
 // FeedModificationRequest represents the request to update a feed.
@@ -214,8 +344,14 @@ type FeedModificationRequest struct {
 	BlockFilterEntryRules       *string `json:"block_filter_entry_rules"`
 	KeepFilterEntryRules        *string `json:"keep_filter_entry_rules"`
 	Crawler                     *bool   `json:"crawler"`
+	InheritCrawler              *bool   `json:"inherit_crawler"`
+	LLMProcessing               *bool   `json:"llm_processing"`
 	UserAgent                   *string `json:"user_agent"`
 	Cookie                      *string `json:"cookie"`
+	HTTPHeaders                 *string `json:"http_headers"`
+	ClientCertificate           *string `json:"client_certificate"`
+	ClientKey                   *string `json:"client_key"`
+	CACertificates              *string `json:"ca_certificates"`
 	Username                    *string `json:"username"`
 	Password                    *string `json:"password"`
 	CategoryID                  *int64  `json:"category_id"`
@@ -223,9 +359,47 @@ type FeedModificationRequest struct {
 	IgnoreHTTPCache             *bool   `json:"ignore_http_cache"`
 	AllowSelfSignedCertificates *bool   `json:"allow_self_signed_certificates"`
 	FetchViaProxy               *bool   `json:"fetch_via_proxy"`
+	InheritFetchViaProxy        *bool   `json:"inherit_fetch_via_proxy"`
 	HideGlobally                *bool   `json:"hide_globally"`
 	DisableHTTP2                *bool   `json:"disable_http2"`
 	ProxyURL                    *string `json:"proxy_url"`
+	ProxyPool                   *string `json:"proxy_pool"`
+	PausedUntil                 *string `json:"paused_until"` // RFC 3339 date, an empty string resumes the feed.
+	HideEntriesWhilePaused      *bool   `json:"hide_entries_while_paused"`
+	RetentionPolicy             *string `json:"retention_policy"`
+	RetentionValue              *int    `json:"retention_value"`
+}
+
+// Feed batch actions.
+const (
+	FeedBatchActionUpdate  = "update"
+	FeedBatchActionMove    = "move"
+	FeedBatchActionEnable  = "enable"
+	FeedBatchActionDisable = "disable"
+	FeedBatchActionRefresh = "refresh"
+	FeedBatchActionDelete  = "delete"
+)
+
+// FeedBatchRequest represents the request to apply the same action to many feeds.
+type FeedBatchRequest struct {
+	FeedIDs    []int64                  `json:"feed_ids"`
+	Action     string                   `json:"action"`
+	CategoryID int64                    `json:"category_id,omitempty"`
+	Changes    *FeedModificationRequest `json:"changes,omitempty"`
+}
+
+// FeedBatchError represents the validation error of one feed in a batch request.
+type FeedBatchError struct {
+	FeedID       int64  `json:"feed_id"`
+	ErrorMessage string `json:"error_message"`
+}
+
+// FeedBatchResponse represents the result of a batch request.
+// When a feed is invalid, no feed is modified and the errors are returned with a bad request status.
+type FeedBatchResponse struct {
+	FeedIDs      []int64          `json:"feed_ids"`
+	ErrorMessage string           `json:"error_message,omitempty"`
+	Errors       []FeedBatchError `json:"errors,omitempty"`
 }
 
 // FeedIcon represents the feed icon.
@@ -245,25 +419,32 @@ type Feeds []*Feed
 
 // Entry represents a subscription item in the system.
 type Entry struct {
-	ID          int64      `json:"id"`
-	Date        time.Time  `json:"published_at"`
-	ChangedAt   time.Time  `json:"changed_at"`
-	CreatedAt   time.Time  `json:"created_at"`
-	Feed        *Feed      `json:"feed,omitempty"`
-	Hash        string     `json:"hash"`
-	URL         string     `json:"url"`
-	CommentsURL string     `json:"comments_url"`
-	Title       string     `json:"title"`
-	Status      string     `json:"status"`
-	Content     string     `json:"content"`
-	Author      string     `json:"author"`
-	ShareCode   string     `json:"share_code"`
-	Enclosures  Enclosures `json:"enclosures,omitempty"`
-	Tags        []string   `json:"tags"`
-	ReadingTime int        `json:"reading_time"`
-	UserID      int64      `json:"user_id"`
-	FeedID      int64      `json:"feed_id"`
-	Starred     bool       `json:"starred"`
+	ID           int64      `json:"id"`
+	Date         time.Time  `json:"published_at"`
+	ChangedAt    time.Time  `json:"changed_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+	Feed         *Feed      `json:"feed,omitempty"`
+	Hash         string     `json:"hash"`
+	URL          string     `json:"url"`
+	CommentsURL  string     `json:"comments_url"`
+	Title        string     `json:"title"`
+	Status       string     `json:"status"`
+	Content      string     `json:"content"`
+	Author       string     `json:"author"`
+	ShareCode    string     `json:"share_code"`
+	Enclosures   Enclosures `json:"enclosures,omitempty"`
+	Tags         []string   `json:"tags"`
+	ReadingTime  int        `json:"reading_time"`
+	UserID       int64      `json:"user_id"`
+	FeedID       int64      `json:"feed_id"`
+	Starred      bool       `json:"starred"`
+	ReadLater    bool       `json:"read_later"`
+	SnoozedUntil *time.Time `json:"snoozed_until,omitempty"`
+	ThumbnailURL string     `json:"thumbnail_url"`
+	Language     string     `json:"language"`
+	Summary      string     `json:"summary"`
+	Labels       Labels     `json:"labels,omitempty"`
+	Highlights   Highlights `json:"highlights,omitempty"`
 }
 
 // EntryModificationRequest represents a request to modify an entry.
@@ -272,6 +453,12 @@ type EntryModificationRequest struct {
 	Content *string `json:"content"`
 }
 
+// EntrySnoozeRequest represents a request to hide an entry until the given time.
+// Values without timezone information are interpreted in the user timezone.
+type EntrySnoozeRequest struct {
+	SnoozedUntil string `json:"snoozed_until"`
+}
+
 // Entries represents a list of entries.
 type Entries []*Entry
 
@@ -294,8 +481,12 @@ type EnclosureUpdateRequest struct {
 type Enclosures []*Enclosure
 
 const (
-	FilterNotStarred  = "0"
-	FilterOnlyStarred = "1"
+	FilterNotStarred    = "0"
+	FilterOnlyStarred   = "1"
+	FilterNotReadLater  = "0"
+	FilterOnlyReadLater = "1"
+	FilterNotSnoozed    = "0"
+	FilterOnlySnoozed   = "1"
 )
 
 // Filter is used to filter entries.
@@ -306,6 +497,8 @@ type Filter struct {
 	Order           string
 	Direction       string
 	Starred         string
+	ReadLater       string
+	Snoozed         string
 	Before          int64
 	After           int64
 	PublishedBefore int64
@@ -315,8 +508,10 @@ type Filter struct {
 	BeforeEntryID   int64
 	AfterEntryID    int64
 	Search          string
+	Language        string
 	CategoryID      int64
 	FeedID          int64
+	LabelID         int64
 	Statuses        []string
 	GloballyVisible bool
 }
@@ -338,12 +533,25 @@ type VersionResponse struct {
 	OS        string `json:"os"`
 }
 
+// API key scopes.
+const (
+	APIKeyScopeReadOnly     = "read_only"
+	APIKeyScopeEntriesWrite = "entries_write"
+	APIKeyScopeFeedsAdmin   = "feeds_admin"
+	APIKeyScopeFull         = "full"
+	APIKeyScopeGoogleReader = "google_reader"
+	APIKeyScopeFever        = "fever"
+)
+
 // APIKey represents an application API key.
+// The token is only returned when the API key is created.
 type APIKey struct {
 	ID          int64      `json:"id"`
 	UserID      int64      `json:"user_id"`
 	Token       string     `json:"token"`
 	Description string     `json:"description"`
+	Scopes      []string   `json:"scopes"`
+	ExpiresAt   *time.Time `json:"expires_at"`
 	LastUsedAt  *time.Time `json:"last_used_at"`
 	CreatedAt   time.Time  `json:"created_at"`
 }
@@ -353,7 +561,38 @@ type APIKeys []*APIKey
 
 // APIKeyCreationRequest represents the request to create an API key.
 type APIKeyCreationRequest struct {
-	Description string `json:"description"`
+	Description string     `json:"description"`
+	Scopes      []string   `json:"scopes,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+}
+
+// AuditLog represents an entry of the audit log.
+type AuditLog struct {
+	ID            int64     `json:"id"`
+	ActorID       int64     `json:"actor_id"`
+	ActorUsername string    `json:"actor_username"`
+	Action        string    `json:"action"`
+	Target        string    `json:"target"`
+	ClientIP      string    `json:"client_ip"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// AuditLogs represents a collection of audit log entries.
+type AuditLogs []*AuditLog
+
+// AuditLogResultSet represents the response when fetching the audit log.
+type AuditLogResultSet struct {
+	Total     int       `json:"total"`
+	AuditLogs AuditLogs `json:"audit_logs"`
+}
+
+// AuditLogFilter is used to filter the audit log.
+type AuditLogFilter struct {
+	Action string
+	Actor  string
+	Search string
+	Offset int
+	Limit  int
 }
 
 func SetOptionalField[T any](value T) *T {
@@ -48,11 +48,17 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	sr.HandleFunc("/categories/{categoryID}/refresh", handler.refreshCategory).Methods(http.MethodPut)
 	sr.HandleFunc("/categories/{categoryID}/entries", handler.getCategoryEntries).Methods(http.MethodGet)
 	sr.HandleFunc("/categories/{categoryID}/entries/{entryID}", handler.getCategoryEntry).Methods(http.MethodGet)
+	sr.HandleFunc("/labels", handler.createLabel).Methods(http.MethodPost)
+	sr.HandleFunc("/labels", handler.getLabels).Methods(http.MethodGet)
+	sr.HandleFunc("/labels/{labelID}", handler.updateLabel).Methods(http.MethodPut)
+	sr.HandleFunc("/labels/{labelID}", handler.removeLabel).Methods(http.MethodDelete)
+	sr.HandleFunc("/labels/{labelID}/entries", handler.getLabelEntries).Methods(http.MethodGet)
 	sr.HandleFunc("/discover", handler.discoverSubscriptions).Methods(http.MethodPost)
 	sr.HandleFunc("/feeds", handler.createFeed).Methods(http.MethodPost)
 	sr.HandleFunc("/feeds", handler.getFeeds).Methods(http.MethodGet)
 	sr.HandleFunc("/feeds/counters", handler.fetchCounters).Methods(http.MethodGet)
 	sr.HandleFunc("/feeds/refresh", handler.refreshAllFeeds).Methods(http.MethodPut)
+	sr.HandleFunc("/feeds/batch", handler.batchFeeds).Methods(http.MethodPost)
 	sr.HandleFunc("/feeds/{feedID}/refresh", handler.refreshFeed).Methods(http.MethodPut)
 	sr.HandleFunc("/feeds/{feedID}", handler.getFeed).Methods(http.MethodGet)
 	sr.HandleFunc("/feeds/{feedID}", handler.updateFeed).Methods(http.MethodPut)
@@ -69,7 +75,16 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	sr.HandleFunc("/entries/{entryID}", handler.updateEntry).Methods(http.MethodPut)
 	sr.HandleFunc("/entries/{entryID}/bookmark", handler.toggleStarred).Methods(http.MethodPut)
 	sr.HandleFunc("/entries/{entryID}/star", handler.toggleStarred).Methods(http.MethodPut)
+	sr.HandleFunc("/entries/{entryID}/later", handler.toggleReadLater).Methods(http.MethodPut)
+	sr.HandleFunc("/entries/{entryID}/snooze", handler.snoozeEntry).Methods(http.MethodPut)
+	sr.HandleFunc("/entries/{entryID}/snooze", handler.unsnoozeEntry).Methods(http.MethodDelete)
 	sr.HandleFunc("/entries/{entryID}/save", handler.saveEntry).Methods(http.MethodPost)
+	sr.HandleFunc("/entries/{entryID}/labels", handler.setEntryLabels).Methods(http.MethodPut)
+	sr.HandleFunc("/entries/{entryID}/highlights", handler.getEntryHighlights).Methods(http.MethodGet)
+	sr.HandleFunc("/entries/{entryID}/highlights", handler.createHighlight).Methods(http.MethodPost)
+	sr.HandleFunc("/entries/{entryID}/highlights/{highlightID}", handler.updateHighlight).Methods(http.MethodPut)
+	sr.HandleFunc("/entries/{entryID}/highlights/{highlightID}", handler.removeHighlight).Methods(http.MethodDelete)
+	sr.HandleFunc("/highlights", handler.getHighlights).Methods(http.MethodGet)
 	sr.HandleFunc("/entries/{entryID}/fetch-content", handler.fetchContent).Methods(http.MethodGet)
 	sr.HandleFunc("/flush-history", handler.flushHistory).Methods(http.MethodPut, http.MethodDelete)
 	sr.HandleFunc("/icons/{iconID}", handler.getIconByIconID).Methods(http.MethodGet)
@@ -80,6 +95,7 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	sr.HandleFunc("/api-keys", handler.createAPIKey).Methods(http.MethodPost)
 	sr.HandleFunc("/api-keys", handler.getAPIKeys).Methods(http.MethodGet)
 	sr.HandleFunc("/api-keys/{apiKeyID}", handler.deleteAPIKey).Methods(http.MethodDelete)
+	sr.HandleFunc("/audit-logs", handler.getAuditLogs).Methods(http.MethodGet)
 }
 
 func (h *handler) versionHandler(w http.ResponseWriter, r *http.Request) {
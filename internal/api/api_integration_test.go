@@ -12,6 +12,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	miniflux "miniflux.app/v2/client"
 )
@@ -789,8 +790,11 @@ func TestAPIKeysEndpoint(t *testing.T) {
 	if apiKeys[0].UserID != regularTestUser.ID {
 		t.Fatalf(`Invalid user ID for API key, got "%v" instead of "%v"`, apiKeys[0].UserID, regularTestUser.ID)
 	}
-	if apiKeys[0].Token != apiKey.Token {
-		t.Fatalf(`Invalid API key token, got "%v" instead of "%v"`, apiKeys[0].Token, apiKey.Token)
+	if apiKeys[0].Token != "" {
+		t.Fatalf(`The API key token should only be returned at creation, got "%v"`, apiKeys[0].Token)
+	}
+	if len(apiKeys[0].Scopes) != 1 || apiKeys[0].Scopes[0] != miniflux.APIKeyScopeFull {
+		t.Fatalf(`Invalid API key scopes, got %v instead of [full]`, apiKeys[0].Scopes)
 	}
 	if apiKeys[0].Description != "Test API Key" {
 		t.Fatalf(`Invalid API key description, got "%v" instead of "Test API Key"`, apiKeys[0].Description)
@@ -839,6 +843,59 @@ func TestAPIKeysEndpoint(t *testing.T) {
 	}
 }
 
+func TestScopedAPIKeysEndpoint(t *testing.T) {
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	apiKey, err := regularUserClient.CreateScopedAPIKey(&miniflux.APIKeyCreationRequest{
+		Description: "Read-only API Key",
+		Scopes:      []string{miniflux.APIKeyScopeReadOnly},
+		ExpiresAt:   &expiresAt,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if apiKey.ExpiresAt == nil || !apiKey.ExpiresAt.Equal(expiresAt) {
+		t.Fatalf(`Invalid API key expiration date, got %v instead of %v`, apiKey.ExpiresAt, expiresAt)
+	}
+
+	apiKeyClient := miniflux.NewClient(testConfig.testBaseURL, apiKey.Token)
+	if _, err := apiKeyClient.Categories(); err != nil {
+		t.Fatalf(`A read-only API key should be able to read categories: %v`, err)
+	}
+
+	if _, err := apiKeyClient.CreateCategory("Test Category"); !errors.Is(err, miniflux.ErrForbidden) {
+		t.Fatalf(`A read-only API key should not be able to create categories, got %v`, err)
+	}
+
+	if _, err := regularUserClient.CreateScopedAPIKey(&miniflux.APIKeyCreationRequest{
+		Description: "Invalid API Key",
+		Scopes:      []string{"invalid"},
+	}); err == nil {
+		t.Fatal(`Creating an API key with an invalid scope should raise an error`)
+	}
+
+	expiredAt := time.Now().Add(-time.Hour)
+	if _, err := regularUserClient.CreateScopedAPIKey(&miniflux.APIKeyCreationRequest{
+		Description: "Expired API Key",
+		ExpiresAt:   &expiredAt,
+	}); err == nil {
+		t.Fatal(`Creating an API key with an expiration date in the past should raise an error`)
+	}
+}
+
 func TestMarkUserAsReadEndpoint(t *testing.T) {
 	testConfig := newIntegrationTestConfig()
 	if !testConfig.isConfigured() {
@@ -1185,6 +1242,63 @@ func TestDeleteCategoryEndpoint(t *testing.T) {
 	}
 }
 
+func TestCannotDeleteCategoryWithConflictingSubcategory(t *testing.T) {
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+
+	if _, err := regularUserClient.CreateCategory("News"); err != nil {
+		t.Fatal(err)
+	}
+
+	parentCategory, err := regularUserClient.CreateCategory("Parent")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subcategory, err := regularUserClient.CreateCategoryWithOptions(&miniflux.CategoryCreationRequest{
+		Title:    "News",
+		ParentID: parentCategory.ID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := regularUserClient.DeleteCategory(parentCategory.ID); err == nil {
+		t.Fatal(`Deleting a category with a subcategory conflicting with another top-level category should raise an error`)
+	}
+
+	if _, err := regularUserClient.UpdateCategory(subcategory.ID, "Other News"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := regularUserClient.DeleteCategory(parentCategory.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	categories, err := regularUserClient.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, category := range categories {
+		if category.ID == subcategory.ID && category.ParentID != 0 {
+			t.Errorf(`The subcategory should be moved to the top level, got parent #%d`, category.ParentID)
+		}
+	}
+}
+
 func TestCannotDeleteInexistingCategory(t *testing.T) {
 	testConfig := newIntegrationTestConfig()
 	if !testConfig.isConfigured() {
@@ -1796,6 +1910,81 @@ func TestDeleteFeedEndpoint(t *testing.T) {
 	}
 }
 
+func TestBatchFeedsEndpoint(t *testing.T) {
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+
+	feedID, err := regularUserClient.CreateFeed(&miniflux.FeedCreationRequest{
+		FeedURL: testConfig.testFeedURL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := regularUserClient.BatchFeeds(&miniflux.FeedBatchRequest{
+		FeedIDs: []int64{feedID},
+		Action:  miniflux.FeedBatchActionDisable,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(response.Errors) != 0 {
+		t.Fatalf(`Unexpected batch errors: %v`, response.Errors)
+	}
+
+	feed, err := regularUserClient.Feed(feedID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !feed.Disabled {
+		t.Fatalf(`The feed should be disabled`)
+	}
+
+	_, err = regularUserClient.BatchFeeds(&miniflux.FeedBatchRequest{
+		FeedIDs: []int64{feedID, 123456789},
+		Action:  miniflux.FeedBatchActionEnable,
+	})
+	if !errors.Is(err, miniflux.ErrBadRequest) || !strings.Contains(err.Error(), "feed #123456789") {
+		t.Fatalf(`A batch with an invalid feed should be rejected, got %v`, err)
+	}
+
+	feed, err = regularUserClient.Feed(feedID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !feed.Disabled {
+		t.Fatalf(`The feed should not be modified when the batch is rejected`)
+	}
+}
+
+func TestBatchFeedsWithInvalidAction(t *testing.T) {
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	client := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+
+	if _, err := client.BatchFeeds(&miniflux.FeedBatchRequest{FeedIDs: []int64{1}, Action: "archive"}); err == nil {
+		t.Fatal(`Invalid batch actions should be rejected`)
+	}
+}
+
 func TestRefreshAllFeedsEndpoint(t *testing.T) {
 	testConfig := newIntegrationTestConfig()
 	if !testConfig.isConfigured() {
@@ -2932,3 +3121,41 @@ func TestFlushHistoryEndpoint(t *testing.T) {
 		t.Fatalf(`Invalid total, got %d`, readEntries.Total)
 	}
 }
+
+func TestGetAuditLogsEndpoint(t *testing.T) {
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	result, err := adminClient.AuditLogs(&miniflux.AuditLogFilter{Action: "user.create", Search: regularTestUser.Username})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total != 1 || len(result.AuditLogs) != 1 {
+		t.Fatalf(`Unexpected number of audit log entries, got %d`, result.Total)
+	}
+
+	auditLog := result.AuditLogs[0]
+	if auditLog.ActorUsername != testConfig.testAdminUsername {
+		t.Errorf(`Unexpected actor, got %q instead of %q`, auditLog.ActorUsername, testConfig.testAdminUsername)
+	}
+
+	if auditLog.Target != regularTestUser.Username {
+		t.Errorf(`Unexpected target, got %q instead of %q`, auditLog.Target, regularTestUser.Username)
+	}
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+	if _, err := regularUserClient.AuditLogs(nil); !errors.Is(err, miniflux.ErrForbidden) {
+		t.Fatalf(`Regular users should not be able to read the audit log, got %v`, err)
+	}
+}
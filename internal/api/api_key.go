@@ -29,12 +29,13 @@ func (h *handler) createAPIKey(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	apiKey, err := h.store.CreateAPIKey(userID, apiKeyCreationRequest.Description)
+	apiKey, err := h.store.CreateAPIKey(userID, &apiKeyCreationRequest)
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
 
+	h.store.Audit(request.UserID(r), model.AuditActionAPIKeyCreate, apiKey.Description, request.ClientIP(r))
 	json.Created(w, r, apiKey)
 }
 
@@ -52,6 +53,17 @@ func (h *handler) deleteAPIKey(w http.ResponseWriter, r *http.Request) {
 	userID := request.UserID(r)
 	apiKeyID := request.RouteInt64Param(r, "apiKeyID")
 
+	apiKey, err := h.store.APIKey(userID, apiKeyID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if apiKey == nil {
+		json.NotFound(w, r)
+		return
+	}
+
 	if err := h.store.DeleteAPIKey(userID, apiKeyID); err != nil {
 		if errors.Is(err, storage.ErrAPIKeyNotFound) {
 			json.NotFound(w, r)
@@ -60,5 +72,7 @@ func (h *handler) deleteAPIKey(w http.ResponseWriter, r *http.Request) {
 		json.ServerError(w, r, err)
 		return
 	}
+
+	h.store.Audit(request.UserID(r), model.AuditActionAPIKeyRemove, apiKey.Description, request.ClientIP(r))
 	json.NoContent(w, r)
 }
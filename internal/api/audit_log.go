@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/json"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/validator"
+)
+
+type auditLogsResponse struct {
+	Total     int             `json:"total"`
+	AuditLogs model.AuditLogs `json:"audit_logs"`
+}
+
+func (h *handler) getAuditLogs(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	limit := request.QueryIntParam(r, "limit", 100)
+	offset := request.QueryIntParam(r, "offset", 0)
+
+	if err := validator.ValidateRange(offset, limit); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	filter := storage.AuditLogFilter{
+		Action: request.QueryStringParam(r, "action", ""),
+		Actor:  request.QueryStringParam(r, "actor", ""),
+		Search: request.QueryStringParam(r, "search", ""),
+	}
+
+	auditLogs, err := h.store.AuditLogs(filter, offset, limit)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, &auditLogsResponse{Total: h.store.CountAuditLogs(filter), AuditLogs: auditLogs})
+}
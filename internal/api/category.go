@@ -60,7 +60,7 @@ func (h *handler) updateCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if validationErr := validator.ValidateCategoryModification(h.store, userID, category.ID, &categoryModificationRequest); validationErr != nil {
+	if validationErr := validator.ValidateCategoryModification(h.store, userID, category, &categoryModificationRequest); validationErr != nil {
 		json.BadRequest(w, r, validationErr.Error())
 		return
 	}
@@ -72,6 +72,10 @@ func (h *handler) updateCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if request.FeedCredentialsHidden(r) {
+		category.HideCredentials()
+	}
+
 	json.Created(w, r, category)
 }
 
@@ -113,6 +117,13 @@ func (h *handler) getCategories(w http.ResponseWriter, r *http.Request) {
 		json.ServerError(w, r, err)
 		return
 	}
+
+	if request.FeedCredentialsHidden(r) {
+		for i := range categories {
+			categories[i].HideCredentials()
+		}
+	}
+
 	json.OK(w, r, categories)
 }
 
@@ -125,6 +136,11 @@ func (h *handler) removeCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if validationErr := validator.ValidateCategoryRemoval(h.store, userID, categoryID); validationErr != nil {
+		json.BadRequest(w, r, validationErr.Error())
+		return
+	}
+
 	if err := h.store.RemoveCategory(userID, categoryID); err != nil {
 		json.ServerError(w, r, err)
 		return
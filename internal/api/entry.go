@@ -16,9 +16,12 @@ import (
 	"miniflux.app/v2/internal/integration"
 	"miniflux.app/v2/internal/mediaproxy"
 	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/language"
 	"miniflux.app/v2/internal/reader/processor"
 	"miniflux.app/v2/internal/reader/readingtime"
+	"miniflux.app/v2/internal/reader/summarizer"
 	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/timezone"
 	"miniflux.app/v2/internal/validator"
 )
 
@@ -36,6 +39,10 @@ func (h *handler) getEntryFromBuilder(w http.ResponseWriter, r *http.Request, b
 
 	entry.Content = mediaproxy.RewriteDocumentWithAbsoluteProxyURL(h.router, entry.Content)
 	entry.Enclosures.ProxifyEnclosureURL(h.router, config.Opts.MediaProxyMode(), config.Opts.MediaProxyResourceTypes())
+	entry.ProxifyThumbnailURL(h.router, config.Opts.MediaProxyMode(), config.Opts.MediaProxyResourceTypes())
+	if entry.Feed != nil && request.FeedCredentialsHidden(r) {
+		entry.Feed.HideCredentials()
+	}
 
 	json.OK(w, r, entry)
 }
@@ -75,19 +82,19 @@ func (h *handler) getEntry(w http.ResponseWriter, r *http.Request) {
 
 func (h *handler) getFeedEntries(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
-	h.findEntries(w, r, feedID, 0)
+	h.findEntries(w, r, feedID, 0, 0)
 }
 
 func (h *handler) getCategoryEntries(w http.ResponseWriter, r *http.Request) {
 	categoryID := request.RouteInt64Param(r, "categoryID")
-	h.findEntries(w, r, 0, categoryID)
+	h.findEntries(w, r, 0, categoryID, 0)
 }
 
 func (h *handler) getEntries(w http.ResponseWriter, r *http.Request) {
-	h.findEntries(w, r, 0, 0)
+	h.findEntries(w, r, 0, 0, 0)
 }
 
-func (h *handler) findEntries(w http.ResponseWriter, r *http.Request, feedID int64, categoryID int64) {
+func (h *handler) findEntries(w http.ResponseWriter, r *http.Request, feedID, categoryID, labelID int64) {
 	statuses := request.QueryStringParamList(r, "status")
 	for _, status := range statuses {
 		if err := validator.ValidateEntryStatus(status); err != nil {
@@ -128,11 +135,18 @@ func (h *handler) findEntries(w http.ResponseWriter, r *http.Request, feedID int
 		return
 	}
 
+	labelID = request.QueryInt64Param(r, "label_id", labelID)
+	if labelID > 0 && !h.store.LabelIDExists(userID, labelID) {
+		json.BadRequest(w, r, errors.New("invalid label ID"))
+		return
+	}
+
 	tags := request.QueryStringParamList(r, "tags")
 
 	builder := h.store.NewEntryQueryBuilder(userID)
 	builder.WithFeedID(feedID)
 	builder.WithCategoryID(categoryID)
+	builder.WithLabelID(labelID)
 	builder.WithStatuses(statuses)
 	builder.WithSorting(order, direction)
 	builder.WithOffset(offset)
@@ -165,6 +179,10 @@ func (h *handler) findEntries(w http.ResponseWriter, r *http.Request, feedID int
 
 	for i := range entries {
 		entries[i].Content = mediaproxy.RewriteDocumentWithAbsoluteProxyURL(h.router, entries[i].Content)
+		entries[i].ProxifyThumbnailURL(h.router, config.Opts.MediaProxyMode(), config.Opts.MediaProxyResourceTypes())
+		if entries[i].Feed != nil && request.FeedCredentialsHidden(r) {
+			entries[i].Feed.HideCredentials()
+		}
 	}
 
 	json.OK(w, r, &entriesResponse{Total: count, Entries: entries})
@@ -200,11 +218,100 @@ func (h *handler) toggleStarred(w http.ResponseWriter, r *http.Request) {
 	json.NoContent(w, r)
 }
 
+func (h *handler) toggleReadLater(w http.ResponseWriter, r *http.Request) {
+	entryID := request.RouteInt64Param(r, "entryID")
+	if err := h.store.ToggleReadLater(request.UserID(r), entryID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) snoozeEntry(w http.ResponseWriter, r *http.Request) {
+	var entrySnoozeRequest model.EntrySnoozeRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&entrySnoozeRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if user == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	snoozedUntil, err := timezone.Parse(user.Timezone, entrySnoozeRequest.SnoozedUntil)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if validationErr := validator.ValidateEntrySnooze(snoozedUntil); validationErr != nil {
+		json.BadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	entryID := request.RouteInt64Param(r, "entryID")
+	builder := h.store.NewEntryQueryBuilder(user.ID)
+	builder.WithEntryID(entryID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	entry, err := builder.GetEntry()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.SnoozeEntry(user.ID, entry.ID, snoozedUntil); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) unsnoozeEntry(w http.ResponseWriter, r *http.Request) {
+	entryID := request.RouteInt64Param(r, "entryID")
+	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
+	builder.WithEntryID(entryID)
+	builder.WithSnoozed(true)
+
+	entry, err := builder.GetEntry()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.UnsnoozeEntry(entry.UserID, entry.ID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
 func (h *handler) saveEntry(w http.ResponseWriter, r *http.Request) {
 	entryID := request.RouteInt64Param(r, "entryID")
 	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
 	builder.WithEntryID(entryID)
 	builder.WithoutStatus(model.EntryStatusRemoved)
+	builder.WithHighlights()
 
 	if !h.store.HasSaveEntry(request.UserID(r)) {
 		json.BadRequest(w, r, errors.New("no third-party integration enabled"))
@@ -275,6 +382,7 @@ func (h *handler) updateEntry(w http.ResponseWriter, r *http.Request) {
 	}
 
 	entryUpdateRequest.Patch(entry)
+	entry.Summary = summarizer.Summarize(entry.Content)
 	if user.ShowReadingTime {
 		entry.ReadingTime = readingtime.EstimateReadingTime(entry.Content, user.DefaultReadingSpeed, user.CJKReadingSpeed)
 	}
@@ -284,6 +392,10 @@ func (h *handler) updateEntry(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if entry.Feed != nil && request.FeedCredentialsHidden(r) {
+		entry.Feed.HideCredentials()
+	}
+
 	json.Created(w, r, entry)
 }
 
@@ -353,6 +465,7 @@ func (h *handler) fetchContent(w http.ResponseWriter, r *http.Request) {
 func (h *handler) flushHistory(w http.ResponseWriter, r *http.Request) {
 	loggedUserID := request.UserID(r)
 	go h.store.FlushHistory(loggedUserID)
+	h.store.Audit(request.UserID(r), model.AuditActionHistoryFlush, "", request.ClientIP(r))
 	json.Accepted(w, r)
 }
 
@@ -400,7 +513,25 @@ func configureFilters(builder *storage.EntryQueryBuilder, r *http.Request) {
 		}
 	}
 
+	if request.HasQueryParam(r, "later") {
+		readLater, err := strconv.ParseBool(r.URL.Query().Get("later"))
+		if err == nil {
+			builder.WithReadLater(readLater)
+		}
+	}
+
+	if request.HasQueryParam(r, "snoozed") {
+		snoozed, err := strconv.ParseBool(r.URL.Query().Get("snoozed"))
+		if err == nil {
+			builder.WithSnoozed(snoozed)
+		}
+	}
+
 	if searchQuery := request.QueryStringParam(r, "search", ""); searchQuery != "" {
 		builder.WithSearchQuery(searchQuery)
 	}
+
+	if entryLanguage := request.QueryStringParam(r, "language", ""); entryLanguage != "" {
+		builder.WithLanguage(language.Normalize(entryLanguage))
+	}
 }
@@ -5,8 +5,11 @@ package api // import "miniflux.app/v2/internal/api"
 
 import (
 	json_parser "encoding/json"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
 	"miniflux.app/v2/internal/config"
@@ -134,6 +137,10 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if request.FeedCredentialsHidden(r) {
+		originalFeed.HideCredentials()
+	}
+
 	json.Created(w, r, originalFeed)
 }
 
@@ -175,6 +182,10 @@ func (h *handler) getCategoryFeeds(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if request.FeedCredentialsHidden(r) {
+		feeds.HideCredentials()
+	}
+
 	json.OK(w, r, feeds)
 }
 
@@ -185,6 +196,10 @@ func (h *handler) getFeeds(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if request.FeedCredentialsHidden(r) {
+		feeds.HideCredentials()
+	}
+
 	json.OK(w, r, feeds)
 }
 
@@ -211,6 +226,10 @@ func (h *handler) getFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if request.FeedCredentialsHidden(r) {
+		feed.HideCredentials()
+	}
+
 	json.OK(w, r, feed)
 }
 
@@ -230,3 +249,72 @@ func (h *handler) removeFeed(w http.ResponseWriter, r *http.Request) {
 
 	json.NoContent(w, r)
 }
+
+func (h *handler) batchFeeds(w http.ResponseWriter, r *http.Request) {
+	var feedBatchRequest model.FeedBatchRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&feedBatchRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	userID := request.UserID(r)
+
+	if validationErr := validator.ValidateFeedBatchRequest(h.store, userID, &feedBatchRequest); validationErr != nil {
+		json.BadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	feeds, err := h.store.FeedsByIDs(userID, feedBatchRequest.FeedIDs)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	response := &model.FeedBatchResponse{FeedIDs: feedBatchRequest.FeedIDs}
+	if validationErrors := validator.ValidateFeedBatchFeeds(h.store, userID, &feedBatchRequest, feeds); len(validationErrors) > 0 {
+		messages := make([]string, 0, len(validationErrors))
+		for _, feedID := range feedBatchRequest.FeedIDs {
+			if validationErr, found := validationErrors[feedID]; found {
+				response.Errors = append(response.Errors, model.FeedBatchError{FeedID: feedID, ErrorMessage: validationErr.Error().Error()})
+				messages = append(messages, fmt.Sprintf("feed #%d: %v", feedID, validationErr.Error()))
+			}
+		}
+
+		// Nothing is applied when a feed is invalid, the errors are detailed for each feed.
+		response.ErrorMessage = "no feed has been modified, " + strings.Join(messages, "; ")
+		json.BadRequestWithBody(w, r, errors.New(response.ErrorMessage), response)
+		return
+	}
+
+	switch feedBatchRequest.Action {
+	case model.FeedBatchActionDelete:
+		if err := h.store.RemoveFeeds(userID, feedBatchRequest.FeedIDs); err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+	case model.FeedBatchActionRefresh:
+		jobs := make(model.JobList, 0, len(feeds))
+		for _, feed := range feeds {
+			jobs = append(jobs, model.Job{UserID: userID, FeedID: feed.ID, FeedURL: feed.FeedURL})
+		}
+		go h.pool.Push(jobs)
+	default:
+		for _, feed := range feeds {
+			feedBatchRequest.Patch(feed)
+			feed.ResetErrorCounter()
+		}
+
+		if err := h.store.UpdateFeeds(feeds); err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+	}
+
+	slog.Info("Applied a batch action to feeds from the API",
+		slog.Int64("user_id", userID),
+		slog.String("action", feedBatchRequest.Action),
+		slog.Int("nb_feeds", len(feeds)),
+	)
+
+	json.OK(w, r, response)
+}
@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	json_parser "encoding/json"
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/json"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/validator"
+)
+
+type highlightsResponse struct {
+	Total      int              `json:"total"`
+	Highlights model.Highlights `json:"highlights"`
+}
+
+func (h *handler) getHighlights(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	limit := request.QueryIntParam(r, "limit", 100)
+	offset := request.QueryIntParam(r, "offset", 0)
+
+	if err := validator.ValidateRange(offset, limit); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	highlights, err := h.store.Highlights(userID, offset, limit)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, &highlightsResponse{Total: h.store.CountHighlights(userID), Highlights: highlights})
+}
+
+func (h *handler) getEntryHighlights(w http.ResponseWriter, r *http.Request) {
+	entry, found := h.findHighlightedEntry(w, r)
+	if !found {
+		return
+	}
+
+	highlights := entry.Highlights
+	if highlights == nil {
+		highlights = make(model.Highlights, 0)
+	}
+
+	json.OK(w, r, highlights)
+}
+
+func (h *handler) createHighlight(w http.ResponseWriter, r *http.Request) {
+	entry, found := h.findHighlightedEntry(w, r)
+	if !found {
+		return
+	}
+
+	var highlightCreationRequest model.HighlightCreationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&highlightCreationRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if validationErr := validator.ValidateHighlightCreation(&highlightCreationRequest); validationErr != nil {
+		json.BadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	highlight, err := h.store.CreateHighlight(entry.UserID, entry.ID, &highlightCreationRequest)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, highlight)
+}
+
+func (h *handler) updateHighlight(w http.ResponseWriter, r *http.Request) {
+	highlight, found := h.findHighlight(w, r)
+	if !found {
+		return
+	}
+
+	var highlightModificationRequest model.HighlightModificationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&highlightModificationRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	highlightModificationRequest.Patch(highlight)
+
+	if err := h.store.UpdateHighlight(highlight); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, highlight)
+}
+
+func (h *handler) removeHighlight(w http.ResponseWriter, r *http.Request) {
+	highlight, found := h.findHighlight(w, r)
+	if !found {
+		return
+	}
+
+	if err := h.store.RemoveHighlight(highlight.UserID, highlight.ID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+// findHighlightedEntry returns the entry of the request with its highlights, a response is sent when it's not found.
+func (h *handler) findHighlightedEntry(w http.ResponseWriter, r *http.Request) (*model.Entry, bool) {
+	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
+	builder.WithEntryID(request.RouteInt64Param(r, "entryID"))
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	entry, err := builder.GetEntry()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return nil, false
+	}
+
+	if entry == nil {
+		json.NotFound(w, r)
+		return nil, false
+	}
+
+	return entry, true
+}
+
+// findHighlight returns the highlight of the request, a response is sent when it's not found.
+func (h *handler) findHighlight(w http.ResponseWriter, r *http.Request) (*model.Highlight, bool) {
+	highlight, err := h.store.Highlight(request.UserID(r), request.RouteInt64Param(r, "highlightID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return nil, false
+	}
+
+	if highlight == nil || highlight.EntryID != request.RouteInt64Param(r, "entryID") {
+		json.NotFound(w, r)
+		return nil, false
+	}
+
+	return highlight, true
+}
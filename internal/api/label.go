@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	json_parser "encoding/json"
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/json"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) createLabel(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	var labelCreationRequest model.LabelCreationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&labelCreationRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if validationErr := validator.ValidateLabelCreation(h.store, userID, &labelCreationRequest); validationErr != nil {
+		json.BadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	label, err := h.store.CreateLabel(userID, &labelCreationRequest)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, label)
+}
+
+func (h *handler) updateLabel(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	labelID := request.RouteInt64Param(r, "labelID")
+
+	label, err := h.store.Label(userID, labelID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if label == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	var labelModificationRequest model.LabelModificationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&labelModificationRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if validationErr := validator.ValidateLabelModification(h.store, userID, label.ID, &labelModificationRequest); validationErr != nil {
+		json.BadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	labelModificationRequest.Patch(label)
+
+	if err := h.store.UpdateLabel(label); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, label)
+}
+
+func (h *handler) getLabels(w http.ResponseWriter, r *http.Request) {
+	var labels model.Labels
+	var err error
+
+	if request.QueryStringParam(r, "counts", "false") == "true" {
+		labels, err = h.store.LabelsWithEntryCount(request.UserID(r))
+	} else {
+		labels, err = h.store.Labels(request.UserID(r))
+	}
+
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+	json.OK(w, r, labels)
+}
+
+func (h *handler) removeLabel(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	labelID := request.RouteInt64Param(r, "labelID")
+
+	if !h.store.LabelIDExists(userID, labelID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveLabel(userID, labelID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) getLabelEntries(w http.ResponseWriter, r *http.Request) {
+	labelID := request.RouteInt64Param(r, "labelID")
+	if !h.store.LabelIDExists(request.UserID(r), labelID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	h.findEntries(w, r, 0, 0, labelID)
+}
+
+func (h *handler) setEntryLabels(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+
+	builder := h.store.NewEntryQueryBuilder(userID)
+	builder.WithEntryID(entryID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	entry, err := builder.GetEntry()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	var entryLabelsRequest model.EntryLabelsRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&entryLabelsRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if validationErr := validator.ValidateEntryLabels(h.store, userID, &entryLabelsRequest); validationErr != nil {
+		json.BadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	if err := h.store.SetEntryLabels(userID, entry.ID, entryLabelsRequest.LabelIDs); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
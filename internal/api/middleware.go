@@ -7,10 +7,18 @@ import (
 	"context"
 	"log/slog"
 	"net/http"
+	"strings"
+	"time"
 
+	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response/json"
+	"miniflux.app/v2/internal/ldap"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ratelimit"
 	"miniflux.app/v2/internal/storage"
+
+	"github.com/gorilla/mux"
 )
 
 type middleware struct {
@@ -49,7 +57,25 @@ func (m *middleware) apiKeyAuth(next http.Handler) http.Handler {
 			return
 		}
 
-		user, err := m.store.UserByAPIKey(token)
+		apiKey, err := m.store.APIKeyByToken(token)
+		if err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		if apiKey == nil || apiKey.IsExpired() {
+			slog.Warn("[API] No valid API key found with the provided token",
+				slog.Bool("authentication_failed", true),
+				slog.Bool("expired", apiKey != nil),
+				slog.String("client_ip", clientIP),
+				slog.String("user_agent", r.UserAgent()),
+				slog.String("request_uri", r.RequestURI),
+			)
+			json.Unauthorized(w, r)
+			return
+		}
+
+		user, err := m.store.UserByID(apiKey.UserID)
 		if err != nil {
 			json.ServerError(w, r, err)
 			return
@@ -66,6 +92,19 @@ func (m *middleware) apiKeyAuth(next http.Handler) http.Handler {
 			return
 		}
 
+		if requiredScope := requiredAPIKeyScope(r); !apiKey.Allows(requiredScope) {
+			slog.Warn("[API] The API key does not grant the scope required by this request",
+				slog.String("client_ip", clientIP),
+				slog.String("user_agent", r.UserAgent()),
+				slog.String("username", user.Username),
+				slog.Int64("api_key_id", apiKey.ID),
+				slog.String("required_scope", requiredScope),
+				slog.String("request_uri", r.RequestURI),
+			)
+			json.Forbidden(w, r)
+			return
+		}
+
 		slog.Info("[API] User authenticated successfully with the API Token Authentication",
 			slog.Bool("authentication_successful", true),
 			slog.String("client_ip", clientIP),
@@ -75,13 +114,14 @@ func (m *middleware) apiKeyAuth(next http.Handler) http.Handler {
 		)
 
 		m.store.SetLastLogin(user.ID)
-		m.store.SetAPIKeyUsedTimestamp(user.ID, token)
+		m.store.SetAPIKeyUsedTimestamp(user.ID, apiKey.ID)
 
 		ctx := r.Context()
 		ctx = context.WithValue(ctx, request.UserIDContextKey, user.ID)
 		ctx = context.WithValue(ctx, request.UserTimezoneContextKey, user.Timezone)
 		ctx = context.WithValue(ctx, request.IsAdminUserContextKey, user.IsAdmin)
 		ctx = context.WithValue(ctx, request.IsAuthenticatedContextKey, true)
+		ctx = context.WithValue(ctx, request.FeedCredentialsHiddenContextKey, !apiKey.Allows(model.APIKeyScopeFull))
 
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
@@ -120,7 +160,21 @@ func (m *middleware) basicAuth(next http.Handler) http.Handler {
 			return
 		}
 
-		if err := m.store.CheckPassword(username, password); err != nil {
+		limiter := ratelimit.AuthenticationLimiterInstance
+		if lockedUntil := limiter.LockedUntil(clientIP, username); !lockedUntil.IsZero() {
+			slog.Warn("[API] Basic HTTP Authentication refused because of too many failed authentication attempts",
+				slog.Bool("authentication_failed", true),
+				slog.String("client_ip", clientIP),
+				slog.String("user_agent", r.UserAgent()),
+				slog.String("username", username),
+				slog.String("request_uri", r.RequestURI),
+			)
+			json.TooManyRequests(w, r, time.Until(lockedUntil))
+			return
+		}
+
+		if err := ldap.CheckPassword(m.store, username, password, clientIP); err != nil {
+			limiter.RecordFailure(ratelimit.MethodAPI, clientIP, username)
 			slog.Warn("[API] Invalid username or password provided during Basic HTTP Authentication",
 				slog.Bool("authentication_failed", true),
 				slog.String("client_ip", clientIP),
@@ -150,6 +204,19 @@ func (m *middleware) basicAuth(next http.Handler) http.Handler {
 			return
 		}
 
+		if user.RequiresTOTP(config.Opts.TOTPRequired()) {
+			slog.Warn("[API] Basic HTTP Authentication is not allowed when two-factor authentication is enabled or required, an API key must be used",
+				slog.Bool("authentication_failed", true),
+				slog.String("client_ip", clientIP),
+				slog.String("user_agent", r.UserAgent()),
+				slog.String("username", username),
+				slog.String("request_uri", r.RequestURI),
+			)
+			json.Unauthorized(w, r)
+			return
+		}
+
+		limiter.RecordSuccess(username)
 		slog.Info("[API] User authenticated successfully with the Basic HTTP Authentication",
 			slog.Bool("authentication_successful", true),
 			slog.String("client_ip", clientIP),
@@ -169,3 +236,60 @@ func (m *middleware) basicAuth(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+// Routes modifying entries, allowed with the entries_write scope.
+var entriesWriteRoutes = map[string]bool{
+	"PUT /users/{userID:[0-9]+}/mark-all-as-read":        true,
+	"PUT /categories/{categoryID}/mark-all-as-read":      true,
+	"PUT /feeds/{feedID}/mark-all-as-read":               true,
+	"PUT /entries":                                       true,
+	"PUT /entries/{entryID}":                             true,
+	"PUT /entries/{entryID}/bookmark":                    true,
+	"PUT /entries/{entryID}/star":                        true,
+	"PUT /entries/{entryID}/later":                       true,
+	"GET /entries/{entryID}/fetch-content":               true,
+	"PUT /entries/{entryID}/snooze":                      true,
+	"DELETE /entries/{entryID}/snooze":                   true,
+	"POST /entries/{entryID}/save":                       true,
+	"PUT /entries/{entryID}/labels":                      true,
+	"POST /entries/{entryID}/highlights":                 true,
+	"PUT /entries/{entryID}/highlights/{highlightID}":    true,
+	"DELETE /entries/{entryID}/highlights/{highlightID}": true,
+	"PUT /flush-history":                                 true,
+	"DELETE /flush-history":                              true,
+	"PUT /enclosures/{enclosureID}":                      true,
+}
+
+// Routes managing users and API keys, or reading the audit log, only allowed with the full scope.
+var fullAccessRoutes = map[string]bool{
+	"POST /users":                   true,
+	"PUT /users/{userID:[0-9]+}":    true,
+	"DELETE /users/{userID:[0-9]+}": true,
+	"POST /api-keys":                true,
+	"DELETE /api-keys/{apiKeyID}":   true,
+	"GET /audit-logs":               true,
+}
+
+// requiredAPIKeyScope returns the API key scope needed to perform the request.
+// Reading is allowed to all Miniflux API scopes, except the audit log and fetching the original content of entries,
+// other modifications require the feeds_admin scope.
+func requiredAPIKeyScope(r *http.Request) string {
+	var pathTemplate string
+	if route := mux.CurrentRoute(r); route != nil {
+		pathTemplate, _ = route.GetPathTemplate()
+		if _, after, found := strings.Cut(pathTemplate, "/v1"); found {
+			pathTemplate = after
+		}
+	}
+
+	switch routeKey := r.Method + " " + pathTemplate; {
+	case fullAccessRoutes[routeKey]:
+		return model.APIKeyScopeFull
+	case entriesWriteRoutes[routeKey]:
+		return model.APIKeyScopeEntriesWrite
+	case r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions:
+		return model.APIKeyScopeReadOnly
+	default:
+		return model.APIKeyScopeFeedsAdmin
+	}
+}
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+
+	"github.com/gorilla/mux"
+)
+
+func TestRequiredAPIKeyScope(t *testing.T) {
+	scenarios := []struct {
+		method   string
+		path     string
+		expected string
+	}{
+		{http.MethodGet, "/v1/feeds", model.APIKeyScopeReadOnly},
+		{http.MethodGet, "/v1/users", model.APIKeyScopeReadOnly},
+		{http.MethodPut, "/v1/entries", model.APIKeyScopeEntriesWrite},
+		{http.MethodPut, "/v1/entries/1/bookmark", model.APIKeyScopeEntriesWrite},
+		{http.MethodPut, "/v1/feeds/1/mark-all-as-read", model.APIKeyScopeEntriesWrite},
+		{http.MethodGet, "/v1/entries/1/fetch-content", model.APIKeyScopeEntriesWrite},
+		{http.MethodPost, "/v1/feeds", model.APIKeyScopeFeedsAdmin},
+		{http.MethodDelete, "/v1/feeds/1", model.APIKeyScopeFeedsAdmin},
+		{http.MethodPost, "/v1/users", model.APIKeyScopeFull},
+		{http.MethodPost, "/v1/api-keys", model.APIKeyScopeFull},
+		{http.MethodGet, "/v1/audit-logs", model.APIKeyScopeFull},
+	}
+
+	var scope string
+	router := mux.NewRouter().PathPrefix("/base").Subrouter()
+	router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			scope = requiredAPIKeyScope(r)
+		})
+	})
+	Serve(router, nil, nil)
+
+	for _, scenario := range scenarios {
+		scope = ""
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(scenario.method, "/base"+scenario.path, nil))
+
+		if scope != scenario.expected {
+			t.Errorf(`Unexpected scope for %s %s, got %q instead of %q`, scenario.method, scenario.path, scope, scenario.expected)
+		}
+	}
+}
@@ -39,7 +39,7 @@ func (h *handler) discoverSubscriptions(w http.ResponseWriter, r *http.Request)
 
 	requestBuilder := fetcher.NewRequestBuilder()
 	requestBuilder.WithTimeout(config.Opts.HTTPClientTimeout())
-	requestBuilder.WithProxyRotator(proxyrotator.ProxyRotatorInstance)
+	requestBuilder.WithProxyRotator(proxyrotator.Pool(subscriptionDiscoveryRequest.ProxyPool))
 	requestBuilder.WithCustomFeedProxyURL(subscriptionDiscoveryRequest.ProxyURL)
 	requestBuilder.WithCustomApplicationProxyURL(config.Opts.HTTPClientProxyURL())
 	requestBuilder.UseCustomApplicationProxyURL(subscriptionDiscoveryRequest.FetchViaProxy)
@@ -48,6 +48,9 @@ func (h *handler) discoverSubscriptions(w http.ResponseWriter, r *http.Request)
 	requestBuilder.WithUsernameAndPassword(subscriptionDiscoveryRequest.Username, subscriptionDiscoveryRequest.Password)
 	requestBuilder.IgnoreTLSErrors(subscriptionDiscoveryRequest.AllowSelfSignedCertificates)
 	requestBuilder.DisableHTTP2(subscriptionDiscoveryRequest.DisableHTTP2)
+	requestBuilder.WithCustomHeaders(subscriptionDiscoveryRequest.RequestHeaders())
+	requestBuilder.WithClientCertificate(subscriptionDiscoveryRequest.ClientCertificate, subscriptionDiscoveryRequest.ClientKey)
+	requestBuilder.WithCACertificates(subscriptionDiscoveryRequest.CACertificates)
 
 	subscriptions, localizedError := subscription.NewSubscriptionFinder(requestBuilder).FindSubscriptions(
 		subscriptionDiscoveryRequest.URL,
@@ -47,6 +47,11 @@ func (h *handler) createUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.store.Audit(request.UserID(r), model.AuditActionUserCreate, user.Username, request.ClientIP(r))
+	if user.IsAdmin {
+		h.store.Audit(request.UserID(r), model.AuditActionUserAdminGrant, user.Username, request.ClientIP(r))
+	}
+
 	json.Created(w, r, user)
 }
 
@@ -80,6 +85,11 @@ func (h *handler) updateUser(w http.ResponseWriter, r *http.Request) {
 			json.BadRequest(w, r, errors.New("only administrators can change permissions of standard users"))
 			return
 		}
+
+		if userModificationRequest.TOTPRequired != nil && *userModificationRequest.TOTPRequired != originalUser.TOTPRequired {
+			json.BadRequest(w, r, errors.New("only administrators can change the two-factor authentication requirement"))
+			return
+		}
 	}
 
 	if validationErr := validator.ValidateUserModification(h.store, originalUser.ID, &userModificationRequest); validationErr != nil {
@@ -87,12 +97,21 @@ func (h *handler) updateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	wasAdmin := originalUser.IsAdmin
 	userModificationRequest.Patch(originalUser)
 	if err = h.store.UpdateUser(originalUser); err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
 
+	h.store.Audit(request.UserID(r), model.AuditActionUserUpdate, originalUser.Username, request.ClientIP(r))
+	switch {
+	case !wasAdmin && originalUser.IsAdmin:
+		h.store.Audit(request.UserID(r), model.AuditActionUserAdminGrant, originalUser.Username, request.ClientIP(r))
+	case wasAdmin && !originalUser.IsAdmin:
+		h.store.Audit(request.UserID(r), model.AuditActionUserAdminRevoke, originalUser.Username, request.ClientIP(r))
+	}
+
 	json.Created(w, r, originalUser)
 }
 
@@ -218,5 +237,6 @@ func (h *handler) removeUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	h.store.RemoveUserAsync(user.ID)
+	h.store.Audit(request.UserID(r), model.AuditActionUserRemove, user.Username, request.ClientIP(r))
 	json.NoContent(w, r)
 }
@@ -21,6 +21,18 @@ func runCleanupTasks(store *storage.Storage) {
 		slog.Int64("user_sessions_removed", nbUserSessions),
 	)
 
+	if interval := config.Opts.CleanupRemoveAuditLogsInterval(); interval > 0 {
+		if rowsAffected, err := store.CleanOldAuditLogs(interval); err != nil {
+			slog.Error("Unable to remove old audit logs", slog.Any("error", err))
+		} else {
+			slog.Info("Audit logs cleanup completed",
+				slog.Int64("audit_logs_removed", rowsAffected),
+			)
+		}
+	}
+
+	wakeUpSnoozedEntries(store)
+
 	startTime := time.Now()
 	if rowsAffected, err := store.ArchiveEntries(model.EntryStatusRead, config.Opts.CleanupArchiveReadInterval(), config.Opts.CleanupArchiveBatchSize()); err != nil {
 		slog.Error("Unable to archive read entries", slog.Any("error", err))
@@ -47,6 +59,14 @@ func runCleanupTasks(store *storage.Storage) {
 		}
 	}
 
+	if rowsAffected, err := store.ArchiveEntriesByRetentionPolicy(config.Opts.CleanupArchiveBatchSize()); err != nil {
+		slog.Error("Unable to archive entries by retention policy", slog.Any("error", err))
+	} else {
+		slog.Info("Archiving entries by retention policy completed",
+			slog.Int64("entries_archived", rowsAffected),
+		)
+	}
+
 	if enclosuresAffected, err := store.DeleteRemovedEntriesEnclosures(); err != nil {
 		slog.Error("Unable to delete enclosures from removed entries", slog.Any("error", err))
 	} else {
@@ -61,3 +81,13 @@ func runCleanupTasks(store *storage.Storage) {
 			slog.Int64("removed_entries_content_cleared", contentAffected))
 	}
 }
+
+func wakeUpSnoozedEntries(store *storage.Storage) {
+	if rowsAffected, err := store.WakeUpSnoozedEntries(); err != nil {
+		slog.Error("Unable to wake up snoozed entries", slog.Any("error", err))
+	} else if rowsAffected > 0 {
+		slog.Info("Waking up snoozed entries completed",
+			slog.Int64("snoozed_entries_woken_up", rowsAffected),
+		)
+	}
+}
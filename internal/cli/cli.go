@@ -4,37 +4,44 @@
 package cli // import "miniflux.app/v2/internal/cli"
 
 import (
+	"cmp"
 	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"log/slog"
 	"os"
+	"time"
 
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/database"
+	"miniflux.app/v2/internal/ldap"
+	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/proxyrotator"
+	"miniflux.app/v2/internal/ratelimit"
 	"miniflux.app/v2/internal/storage"
 	"miniflux.app/v2/internal/ui/static"
 	"miniflux.app/v2/internal/version"
 )
 
 const (
-	flagInfoHelp             = "Show build information"
-	flagVersionHelp          = "Show application version"
-	flagMigrateHelp          = "Run SQL migrations"
-	flagFlushSessionsHelp    = "Flush all sessions (disconnect users)"
-	flagCreateAdminHelp      = "Create an admin user from an interactive terminal"
-	flagResetPasswordHelp    = "Reset user password"
-	flagResetFeedErrorsHelp  = "Clear all feed errors for all users"
-	flagDebugModeHelp        = "Show debug logs"
-	flagConfigFileHelp       = "Load configuration file"
-	flagConfigDumpHelp       = "Print parsed configuration values"
-	flagHealthCheckHelp      = `Perform a health check on the given endpoint (the value "auto" try to guess the health check endpoint).`
-	flagRefreshFeedsHelp     = "Refresh a batch of feeds and exit"
-	flagRunCleanupTasksHelp  = "Run cleanup tasks (delete old sessions and archives old entries)"
-	flagExportUserFeedsHelp  = "Export user feeds (provide the username as argument)"
-	flagResetNextCheckAtHelp = "Reset the next check time for all feeds"
+	flagInfoHelp                = "Show build information"
+	flagVersionHelp             = "Show application version"
+	flagMigrateHelp             = "Run SQL migrations"
+	flagFlushSessionsHelp       = "Flush all sessions (disconnect users)"
+	flagCreateAdminHelp         = "Create an admin user from an interactive terminal"
+	flagResetPasswordHelp       = "Reset user password"
+	flagResetTOTPHelp           = "Disable two-factor authentication for a user"
+	flagResetFeedErrorsHelp     = "Clear all feed errors for all users"
+	flagDebugModeHelp           = "Show debug logs"
+	flagConfigFileHelp          = "Load configuration file"
+	flagConfigDumpHelp          = "Print parsed configuration values"
+	flagHealthCheckHelp         = `Perform a health check on the given endpoint (the value "auto" try to guess the health check endpoint).`
+	flagRefreshFeedsHelp        = "Refresh a batch of feeds and exit"
+	flagRunCleanupTasksHelp     = "Run cleanup tasks (delete old sessions and archives old entries)"
+	flagExportUserFeedsHelp     = "Export user feeds (provide the username as argument)"
+	flagResetNextCheckAtHelp    = "Reset the next check time for all feeds"
+	flagRotateEncryptionKeyHelp = "Encrypt all secrets with a new data key (and with the new ENCRYPTION_KEY if it has been changed)"
 )
 
 // Parse parses command line arguments.
@@ -47,6 +54,7 @@ func Parse() {
 		flagFlushSessions        bool
 		flagCreateAdmin          bool
 		flagResetPassword        bool
+		flagResetTOTP            bool
 		flagResetFeedErrors      bool
 		flagResetFeedNextCheckAt bool
 		flagDebugMode            bool
@@ -56,6 +64,7 @@ func Parse() {
 		flagRefreshFeeds         bool
 		flagRunCleanupTasks      bool
 		flagExportUserFeeds      string
+		flagRotateEncryptionKey  bool
 	)
 
 	flag.BoolVar(&flagInfo, "info", false, flagInfoHelp)
@@ -66,6 +75,7 @@ func Parse() {
 	flag.BoolVar(&flagFlushSessions, "flush-sessions", false, flagFlushSessionsHelp)
 	flag.BoolVar(&flagCreateAdmin, "create-admin", false, flagCreateAdminHelp)
 	flag.BoolVar(&flagResetPassword, "reset-password", false, flagResetPasswordHelp)
+	flag.BoolVar(&flagResetTOTP, "reset-totp", false, flagResetTOTPHelp)
 	flag.BoolVar(&flagResetFeedErrors, "reset-feed-errors", false, flagResetFeedErrorsHelp)
 	flag.BoolVar(&flagResetFeedNextCheckAt, "reset-feed-next-check-at", false, flagResetNextCheckAtHelp)
 	flag.BoolVar(&flagDebugMode, "debug", false, flagDebugModeHelp)
@@ -76,6 +86,7 @@ func Parse() {
 	flag.BoolVar(&flagRefreshFeeds, "refresh-feeds", false, flagRefreshFeedsHelp)
 	flag.BoolVar(&flagRunCleanupTasks, "run-cleanup-tasks", false, flagRunCleanupTasksHelp)
 	flag.StringVar(&flagExportUserFeeds, "export-user-feeds", "", flagExportUserFeedsHelp)
+	flag.BoolVar(&flagRotateEncryptionKey, "rotate-encryption-key", false, flagRotateEncryptionKeyHelp)
 	flag.Parse()
 
 	cfg := config.NewParser()
@@ -100,9 +111,9 @@ func Parse() {
 
 	if config.Opts.DisableLocalAuth() {
 		switch {
-		case config.Opts.OAuth2Provider() == "" && config.Opts.AuthProxyHeader() == "":
-			printErrorAndExit(errors.New("DISABLE_LOCAL_AUTH is enabled but neither OAUTH2_PROVIDER nor AUTH_PROXY_HEADER is not set. Please enable at least one authentication source"))
-		case config.Opts.OAuth2Provider() != "" && !config.Opts.IsOAuth2UserCreationAllowed():
+		case !config.Opts.HasOAuth2Providers() && config.Opts.AuthProxyHeader() == "":
+			printErrorAndExit(errors.New("DISABLE_LOCAL_AUTH is enabled but neither OAUTH2_PROVIDER, OAUTH2_OIDC_PROVIDERS nor AUTH_PROXY_HEADER is set. Please enable at least one authentication source"))
+		case config.Opts.HasOAuth2Providers() && !config.Opts.IsOAuth2UserCreationAllowed():
 			printErrorAndExit(errors.New("DISABLE_LOCAL_AUTH is enabled and an OAUTH2_PROVIDER is configured, but OAUTH2_USER_CREATION is not enabled"))
 		case config.Opts.AuthProxyHeader() != "" && !config.Opts.IsAuthProxyUserCreationAllowed():
 			printErrorAndExit(errors.New("DISABLE_LOCAL_AUTH is enabled and an AUTH_PROXY_HEADER is configured, but AUTH_PROXY_USER_CREATION is not enabled"))
@@ -206,6 +217,13 @@ func Parse() {
 		return
 	}
 
+	if flagRotateEncryptionKey {
+		if err := store.RotateEncryptionKey(); err != nil {
+			printErrorAndExit(err)
+		}
+		return
+	}
+
 	if flagExportUserFeeds != "" {
 		exportUserFeeds(store, flagExportUserFeeds)
 		return
@@ -226,6 +244,11 @@ func Parse() {
 		return
 	}
 
+	if flagResetTOTP {
+		resetTOTP(store)
+		return
+	}
+
 	// Run migrations and start the daemon.
 	if config.Opts.RunMigrations() {
 		if err := database.Migrate(db); err != nil {
@@ -249,6 +272,50 @@ func Parse() {
 		}
 	}
 
+	for name, proxyURLs := range config.Opts.HTTPClientProxyPools() {
+		slog.Info("Initializing proxy pool", slog.String("pool", name), slog.Int("proxies_count", len(proxyURLs)))
+		if _, err := proxyrotator.NewProxyPool(name, proxyURLs); err != nil {
+			printErrorAndExit(fmt.Errorf("unable to initialize proxy pool %q: %v", name, err))
+		}
+	}
+
+	for _, rotator := range proxyrotator.Rotators() {
+		rotator.SetEjectionPolicy(config.Opts.HTTPClientProxyMaxFailures(), config.Opts.HTTPClientProxyEjectionDuration())
+	}
+
+	if maxAttempts := config.Opts.AuthRateLimitMaxAttempts(); maxAttempts > 0 {
+		ratelimit.AuthenticationLimiterInstance = ratelimit.NewLimiter(
+			maxAttempts,
+			config.Opts.AuthRateLimitLockoutDuration(),
+			config.Opts.AuthRateLimitMaxLockoutDuration(),
+		)
+		ratelimit.AuthenticationLimiterInstance.SetLockoutHandler(func(method, clientIP, username string, lockedUntil time.Time) {
+			target := fmt.Sprintf("%s %s until %s", method, cmp.Or(username, clientIP), lockedUntil.Format(time.RFC3339))
+			store.Audit(0, model.AuditActionAuthenticationLockout, target, clientIP)
+		})
+	}
+
+	if ldapURL := config.Opts.LDAPURL(); ldapURL != "" {
+		slog.Info("Initializing LDAP authentication", slog.String("ldap_url", ldapURL))
+		ldap.AuthenticatorInstance, err = ldap.NewAuthenticator(ldap.Settings{
+			URL:                   ldapURL,
+			StartTLS:              config.Opts.LDAPStartTLS(),
+			CAFile:                config.Opts.LDAPTLSCAFile(),
+			BindDN:                config.Opts.LDAPBindDN(),
+			BindPassword:          config.Opts.LDAPBindPassword(),
+			UserBaseDN:            config.Opts.LDAPUserBaseDN(),
+			UserFilter:            config.Opts.LDAPUserFilter(),
+			AdminGroupDN:          config.Opts.LDAPAdminGroupDN(),
+			UserCreationAllowed:   config.Opts.IsLDAPUserCreationAllowed(),
+			LocalPasswordFallback: config.Opts.IsLDAPLocalPasswordFallbackAllowed(),
+			LinkLocalUsers:        config.Opts.IsLDAPLocalUserLinkingAllowed(),
+			Timeout:               config.Opts.LDAPTimeout(),
+		})
+		if err != nil {
+			printErrorAndExit(fmt.Errorf("unable to initialize LDAP authentication: %v", err))
+		}
+	}
+
 	if flagRefreshFeeds {
 		refreshFeeds(store)
 		return
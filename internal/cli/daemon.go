@@ -15,6 +15,7 @@ import (
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/http/server"
 	"miniflux.app/v2/internal/metric"
+	"miniflux.app/v2/internal/proxyrotator"
 	"miniflux.app/v2/internal/storage"
 	"miniflux.app/v2/internal/systemd"
 	"miniflux.app/v2/internal/worker"
@@ -33,6 +34,11 @@ func startDaemon(store *storage.Storage) {
 		runScheduler(store, pool)
 	}
 
+	if interval := config.Opts.HTTPClientProxyHealthCheckInterval(); interval > 0 && len(proxyrotator.Rotators()) > 0 {
+		slog.Debug("Starting proxy health checks", slog.Duration("interval", interval))
+		proxyrotator.StartHealthChecks(interval)
+	}
+
 	var httpServers []*http.Server
 	if config.Opts.HasHTTPService() {
 		httpServers = server.StartWebServer(store, pool)
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli // import "miniflux.app/v2/internal/cli"
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+)
+
+func resetTOTP(store *storage.Storage) {
+	fmt.Print("Enter Username: ")
+
+	reader := bufio.NewReader(os.Stdin)
+	username, _ := reader.ReadString('\n')
+
+	user, err := store.UserByUsername(strings.TrimSpace(username))
+	if err != nil {
+		printErrorAndExit(err)
+	}
+
+	if user == nil {
+		printErrorAndExit(fmt.Errorf("user not found"))
+	}
+
+	if err := store.DisableTOTP(user.ID); err != nil {
+		printErrorAndExit(err)
+	}
+
+	store.Audit(0, model.AuditActionTOTPReset, user.Username, "")
+
+	fmt.Println("Two-factor authentication disabled!")
+}
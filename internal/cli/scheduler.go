@@ -28,6 +28,9 @@ func runScheduler(store *storage.Storage, pool *worker.Pool) {
 		store,
 		config.Opts.CleanupFrequency(),
 	)
+
+	// Snoozed entries are checked every minute to wake them up on time.
+	go snoozeScheduler(store, time.Minute)
 }
 
 func feedScheduler(store *storage.Storage, pool *worker.Pool, frequency time.Duration, batchSize, errorLimit, limitPerHost int) {
@@ -54,3 +57,9 @@ func cleanupScheduler(store *storage.Storage, frequency time.Duration) {
 		runCleanupTasks(store)
 	}
 }
+
+func snoozeScheduler(store *storage.Storage, frequency time.Duration) {
+	for range time.Tick(frequency) {
+		wakeUpSnoozedEntries(store)
+	}
+}
@@ -6,6 +6,7 @@ package config // import "miniflux.app/v2/internal/config"
 import (
 	"bytes"
 	"os"
+	"path/filepath"
 	"reflect"
 	"slices"
 	"testing"
@@ -747,6 +748,61 @@ func TestCleanupRemoveSessionsDays(t *testing.T) {
 	}
 }
 
+func TestDefaultCleanupRemoveAuditLogsDaysValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 365 * 24 * time.Hour
+	result := opts.CleanupRemoveAuditLogsInterval()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CLEANUP_REMOVE_AUDIT_LOGS_DAYS value, got %v instead of %v`, result, expected)
+	}
+
+	sorted := opts.SortedOptions(false)
+	i := slices.IndexFunc(sorted, func(opt *option) bool {
+		return opt.Key == "CLEANUP_REMOVE_AUDIT_LOGS_DAYS"
+	})
+
+	expectedSerialized := 365
+	if got := sorted[i].Value; got != expectedSerialized {
+		t.Fatalf(`Unexpected value in option output, got %q instead of %q`, got, expectedSerialized)
+	}
+}
+
+func TestCleanupRemoveAuditLogsDays(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("CLEANUP_REMOVE_AUDIT_LOGS_DAYS", "7")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 7 * 24 * time.Hour
+	result := opts.CleanupRemoveAuditLogsInterval()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CLEANUP_REMOVE_AUDIT_LOGS_DAYS value, got %v instead of %v`, result, expected)
+	}
+
+	sorted := opts.SortedOptions(false)
+	i := slices.IndexFunc(sorted, func(opt *option) bool {
+		return opt.Key == "CLEANUP_REMOVE_AUDIT_LOGS_DAYS"
+	})
+
+	expectedSerialized := 7
+	if got := sorted[i].Value; got != expectedSerialized {
+		t.Fatalf(`Unexpected value in option output, got %q instead of %q`, got, expectedSerialized)
+	}
+}
+
 func TestDefaultWorkerPoolSizeValue(t *testing.T) {
 	os.Clearenv()
 
@@ -1395,6 +1451,174 @@ func TestDefaultOAuth2ProviderValue(t *testing.T) {
 	}
 }
 
+func TestOAuth2OIDCGroupOptions(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("OAUTH2_OIDC_GROUPS_CLAIMS", "groups, realm_access.roles")
+	os.Setenv("OAUTH2_OIDC_ADMIN_GROUPS", "admins")
+	os.Setenv("OAUTH2_OIDC_REQUIRED_GROUPS", "readers,admins")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if result := opts.OIDCGroupsClaims(); !slices.Equal(result, []string{"groups", "realm_access.roles"}) {
+		t.Fatalf(`Unexpected OAUTH2_OIDC_GROUPS_CLAIMS value, got %v`, result)
+	}
+
+	if result := opts.OIDCAdminGroups(); !slices.Equal(result, []string{"admins"}) {
+		t.Fatalf(`Unexpected OAUTH2_OIDC_ADMIN_GROUPS value, got %v`, result)
+	}
+
+	if result := opts.OIDCRequiredGroups(); !slices.Equal(result, []string{"readers", "admins"}) {
+		t.Fatalf(`Unexpected OAUTH2_OIDC_REQUIRED_GROUPS value, got %v`, result)
+	}
+}
+
+func TestDefaultOAuth2OIDCGroupOptions(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if result := opts.OIDCGroupsClaims(); !slices.Equal(result, []string{defaultOAuth2OidcGroupsClaim}) {
+		t.Fatalf(`Unexpected OAUTH2_OIDC_GROUPS_CLAIMS value, got %v`, result)
+	}
+
+	if len(opts.OIDCAdminGroups()) != 0 || len(opts.OIDCRequiredGroups()) != 0 {
+		t.Fatalf(`The admin and required groups should be empty by default`)
+	}
+
+	if len(opts.OIDCProviders()) != 0 || opts.HasOAuth2Providers() {
+		t.Fatalf(`No OAuth2 provider should be configured by default`)
+	}
+}
+
+func TestOAuth2OIDCProviders(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("BASE_URL", "https://miniflux.example.org/reader")
+	os.Setenv("OAUTH2_OIDC_PROVIDERS", "Keycloak, authentik")
+	os.Setenv("OAUTH2_OIDC_KEYCLOAK_CLIENT_ID", "keycloak-client")
+	os.Setenv("OAUTH2_OIDC_KEYCLOAK_CLIENT_SECRET", "keycloak-secret")
+	os.Setenv("OAUTH2_OIDC_KEYCLOAK_DISCOVERY_ENDPOINT", "https://keycloak.example.org/realms/home")
+	os.Setenv("OAUTH2_OIDC_KEYCLOAK_PROVIDER_NAME", "Keycloak")
+	os.Setenv("OAUTH2_OIDC_KEYCLOAK_GROUPS_CLAIMS", "realm_access.roles")
+	os.Setenv("OAUTH2_OIDC_KEYCLOAK_ADMIN_GROUPS", "admin")
+	os.Setenv("OAUTH2_OIDC_AUTHENTIK_CLIENT_ID", "authentik-client")
+	os.Setenv("OAUTH2_OIDC_AUTHENTIK_DISCOVERY_ENDPOINT", "https://authentik.example.org/application/o/miniflux/")
+	os.Setenv("OAUTH2_OIDC_AUTHENTIK_REDIRECT_URL", "https://reader.example.org/oauth2/authentik/callback")
+	os.Setenv("OAUTH2_OIDC_AUTHENTIK_REQUIRED_GROUPS", "readers")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !opts.HasOAuth2Providers() {
+		t.Fatalf(`OAuth2 providers should be configured`)
+	}
+
+	if names := opts.OIDCProviderNames(); !slices.Equal(names, []string{"keycloak", "authentik"}) {
+		t.Fatalf(`Unexpected provider names: %v`, names)
+	}
+
+	keycloak := opts.OIDCProviders()[0]
+	expectedKeycloak := &OIDCProviderOptions{
+		Name:              "keycloak",
+		DisplayName:       "Keycloak",
+		ClientID:          "keycloak-client",
+		ClientSecret:      "keycloak-secret",
+		RedirectURL:       "https://miniflux.example.org/reader/oauth2/keycloak/callback",
+		DiscoveryEndpoint: "https://keycloak.example.org/realms/home",
+		GroupsClaims:      []string{"realm_access.roles"},
+		AdminGroups:       []string{"admin"},
+		RequiredGroups:    []string{},
+	}
+	if !reflect.DeepEqual(keycloak, expectedKeycloak) {
+		t.Fatalf(`Unexpected provider, got %+v instead of %+v`, keycloak, expectedKeycloak)
+	}
+
+	authentik := opts.OIDCProviders()[1]
+	if authentik.DisplayName != "authentik" || authentik.RedirectURL != "https://reader.example.org/oauth2/authentik/callback" {
+		t.Fatalf(`Unexpected provider: %+v`, authentik)
+	}
+
+	if !slices.Equal(authentik.GroupsClaims, []string{defaultOAuth2OidcGroupsClaim}) || !slices.Equal(authentik.RequiredGroups, []string{"readers"}) {
+		t.Fatalf(`Unexpected provider groups: %+v`, authentik)
+	}
+
+	redacted := opts.SortedOptions(true)
+	i := slices.IndexFunc(redacted, func(opt *option) bool {
+		return opt.Key == "OAUTH2_OIDC_KEYCLOAK_CLIENT_SECRET"
+	})
+	if i == -1 || redacted[i].Value != "<secret>" {
+		t.Fatalf(`The client secret of the provider should be redacted`)
+	}
+}
+
+func TestOAuth2OIDCProvidersWithSecretFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	clientIDFile := filepath.Join(tmpDir, "client_id")
+	clientSecretFile := filepath.Join(tmpDir, "client_secret")
+	os.WriteFile(clientIDFile, []byte("file-client\n"), 0600)
+	os.WriteFile(clientSecretFile, []byte("file-secret\n"), 0600)
+
+	os.Clearenv()
+	os.Setenv("OAUTH2_OIDC_PROVIDERS", "dex")
+	os.Setenv("OAUTH2_OIDC_DEX_CLIENT_ID_FILE", clientIDFile)
+	os.Setenv("OAUTH2_OIDC_DEX_CLIENT_SECRET_FILE", clientSecretFile)
+	os.Setenv("OAUTH2_OIDC_DEX_DISCOVERY_ENDPOINT", "https://dex.example.org")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	provider := opts.OIDCProviders()[0]
+	if provider.ClientID != "file-client" || provider.ClientSecret != "file-secret" {
+		t.Fatalf(`Unexpected provider credentials: %+v`, provider)
+	}
+}
+
+func TestInvalidOAuth2OIDCProviders(t *testing.T) {
+	scenarios := map[string]map[string]string{
+		"invalid name": {
+			"OAUTH2_OIDC_PROVIDERS": "my-provider",
+		},
+		"reserved name": {
+			"OAUTH2_OIDC_PROVIDERS":               "oidc",
+			"OAUTH2_OIDC_OIDC_CLIENT_ID":          "client",
+			"OAUTH2_OIDC_OIDC_DISCOVERY_ENDPOINT": "https://example.org",
+		},
+		"missing client ID": {
+			"OAUTH2_OIDC_PROVIDERS":              "dex",
+			"OAUTH2_OIDC_DEX_DISCOVERY_ENDPOINT": "https://dex.example.org",
+		},
+		"missing discovery endpoint": {
+			"OAUTH2_OIDC_PROVIDERS":     "dex",
+			"OAUTH2_OIDC_DEX_CLIENT_ID": "client",
+		},
+	}
+
+	for name, variables := range scenarios {
+		os.Clearenv()
+		for key, value := range variables {
+			os.Setenv(key, value)
+		}
+
+		parser := NewParser()
+		if _, err := parser.ParseEnvironmentVariables(); err == nil {
+			t.Errorf(`The %s scenario should be rejected`, name)
+		}
+	}
+}
+
 func TestHSTSWhenUnset(t *testing.T) {
 	os.Clearenv()
 
@@ -1777,6 +2001,41 @@ func TestMediaProxyPrivateKey(t *testing.T) {
 	}
 }
 
+func TestEncryptionKey(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ENCRYPTION_KEY", "some secret")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if result := opts.EncryptionKey(); result != "some secret" {
+		t.Fatalf(`Unexpected ENCRYPTION_KEY value, got %q`, result)
+	}
+}
+
+func TestEncryptionKeyFile(t *testing.T) {
+	os.Clearenv()
+
+	keyFile := filepath.Join(t.TempDir(), "encryption.key")
+	if err := os.WriteFile(keyFile, []byte("secret from file\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("ENCRYPTION_KEY_FILE", keyFile)
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if result := opts.EncryptionKey(); result != "secret from file" {
+		t.Fatalf(`Unexpected ENCRYPTION_KEY_FILE value, got %q`, result)
+	}
+}
+
 func TestHTTPSOff(t *testing.T) {
 	os.Clearenv()
 
@@ -2251,6 +2510,68 @@ func TestDefaultHTTPClientProxiesValue(t *testing.T) {
 	}
 }
 
+func TestHTTPClientProxyPools(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_PROXY_POOLS", "eu=http://proxy1.example.com, socks5://proxy2.example.com:1080; us=http://proxy3.example.com")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := map[string][]string{
+		"eu": {"http://proxy1.example.com", "socks5://proxy2.example.com:1080"},
+		"us": {"http://proxy3.example.com"},
+	}
+	result := opts.HTTPClientProxyPools()
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf(`Unexpected HTTP_CLIENT_PROXY_POOLS value, got %v instead of %v`, result, expected)
+	}
+
+	for _, option := range opts.SortedOptions(true) {
+		if option.Key == "HTTP_CLIENT_PROXY_POOLS" && option.Value != "eu=<redacted>,<redacted>;us=<redacted>" {
+			t.Fatalf(`Unexpected redacted HTTP_CLIENT_PROXY_POOLS value, got %v`, option.Value)
+		}
+	}
+}
+
+func TestInvalidHTTPClientProxyPools(t *testing.T) {
+	for _, value := range []string{"http://proxy1.example.com", "eu=", "=http://proxy1.example.com"} {
+		os.Clearenv()
+		os.Setenv("HTTP_CLIENT_PROXY_POOLS", value)
+
+		if _, err := NewParser().ParseEnvironmentVariables(); err == nil {
+			t.Fatalf(`Expected error for invalid HTTP_CLIENT_PROXY_POOLS value %q`, value)
+		}
+	}
+}
+
+func TestHTTPClientProxyFailoverOptions(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_PROXY_HEALTH_CHECK_INTERVAL", "30")
+	os.Setenv("HTTP_CLIENT_PROXY_MAX_FAILURES", "5")
+	os.Setenv("HTTP_CLIENT_PROXY_EJECTION_DURATION", "600")
+
+	opts, err := NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.HTTPClientProxyHealthCheckInterval() != 30*time.Second {
+		t.Errorf(`Unexpected HTTP_CLIENT_PROXY_HEALTH_CHECK_INTERVAL value, got %v`, opts.HTTPClientProxyHealthCheckInterval())
+	}
+
+	if opts.HTTPClientProxyMaxFailures() != 5 {
+		t.Errorf(`Unexpected HTTP_CLIENT_PROXY_MAX_FAILURES value, got %v`, opts.HTTPClientProxyMaxFailures())
+	}
+
+	if opts.HTTPClientProxyEjectionDuration() != 10*time.Minute {
+		t.Errorf(`Unexpected HTTP_CLIENT_PROXY_EJECTION_DURATION value, got %v`, opts.HTTPClientProxyEjectionDuration())
+	}
+}
+
 func TestHTTPClientProxy(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("HTTP_CLIENT_PROXY", "http://proxy.example.com")
@@ -2365,3 +2686,189 @@ func TestDefaultMetricsRefreshInterval(t *testing.T) {
 		t.Fatalf(`Unexpected value in option output, got %q instead of %q`, got, expectedSerialized)
 	}
 }
+
+func TestTOTPRequired(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("TOTP_REQUIRED", "1")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !opts.TOTPRequired() {
+		t.Fatal(`TOTP should be required`)
+	}
+}
+
+func TestTOTPRequiredDefaultValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.TOTPRequired() {
+		t.Fatal(`TOTP should not be required by default`)
+	}
+}
+
+func TestAuthRateLimitOptions(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AUTH_RATE_LIMIT_MAX_ATTEMPTS", "3")
+	os.Setenv("AUTH_RATE_LIMIT_LOCKOUT_DURATION", "30")
+	os.Setenv("AUTH_RATE_LIMIT_MAX_LOCKOUT_DURATION", "600")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.AuthRateLimitMaxAttempts() != 3 {
+		t.Errorf(`Unexpected AUTH_RATE_LIMIT_MAX_ATTEMPTS value, got %v`, opts.AuthRateLimitMaxAttempts())
+	}
+
+	if opts.AuthRateLimitLockoutDuration() != 30*time.Second {
+		t.Errorf(`Unexpected AUTH_RATE_LIMIT_LOCKOUT_DURATION value, got %v`, opts.AuthRateLimitLockoutDuration())
+	}
+
+	if opts.AuthRateLimitMaxLockoutDuration() != 10*time.Minute {
+		t.Errorf(`Unexpected AUTH_RATE_LIMIT_MAX_LOCKOUT_DURATION value, got %v`, opts.AuthRateLimitMaxLockoutDuration())
+	}
+}
+
+func TestAuthRateLimitDefaultValues(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.AuthRateLimitMaxAttempts() != defaultAuthRateLimitMaxAttempts {
+		t.Errorf(`Unexpected AUTH_RATE_LIMIT_MAX_ATTEMPTS value, got %v`, opts.AuthRateLimitMaxAttempts())
+	}
+
+	if opts.AuthRateLimitLockoutDuration() != defaultAuthRateLimitLockoutDuration {
+		t.Errorf(`Unexpected AUTH_RATE_LIMIT_LOCKOUT_DURATION value, got %v`, opts.AuthRateLimitLockoutDuration())
+	}
+
+	if opts.AuthRateLimitMaxLockoutDuration() != defaultAuthRateLimitMaxLockoutDuration {
+		t.Errorf(`Unexpected AUTH_RATE_LIMIT_MAX_LOCKOUT_DURATION value, got %v`, opts.AuthRateLimitMaxLockoutDuration())
+	}
+}
+
+func TestLDAPOptions(t *testing.T) {
+	passwordFile := filepath.Join(t.TempDir(), "ldap_password")
+	if err := os.WriteFile(passwordFile, []byte("secret from file\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	os.Clearenv()
+	os.Setenv("LDAP_URL", "ldaps://ldap.example.org")
+	os.Setenv("LDAP_START_TLS", "1")
+	os.Setenv("LDAP_BIND_DN", "cn=miniflux,dc=example,dc=org")
+	os.Setenv("LDAP_BIND_PASSWORD_FILE", passwordFile)
+	os.Setenv("LDAP_USER_BASE_DN", "ou=people,dc=example,dc=org")
+	os.Setenv("LDAP_USER_FILTER", "(sAMAccountName=%s)")
+	os.Setenv("LDAP_ADMIN_GROUP_DN", "cn=admins,dc=example,dc=org")
+	os.Setenv("LDAP_USER_CREATION", "1")
+	os.Setenv("LDAP_LOCAL_PASSWORD_FALLBACK", "1")
+	os.Setenv("LDAP_LINK_LOCAL_USERS", "1")
+	os.Setenv("LDAP_TIMEOUT", "3")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.LDAPURL() != "ldaps://ldap.example.org" {
+		t.Errorf(`Unexpected LDAP_URL value, got %q`, opts.LDAPURL())
+	}
+
+	if !opts.LDAPStartTLS() {
+		t.Errorf(`Unexpected LDAP_START_TLS value, got %v`, opts.LDAPStartTLS())
+	}
+
+	if opts.LDAPBindDN() != "cn=miniflux,dc=example,dc=org" {
+		t.Errorf(`Unexpected LDAP_BIND_DN value, got %q`, opts.LDAPBindDN())
+	}
+
+	if opts.LDAPBindPassword() != "secret from file" {
+		t.Errorf(`Unexpected LDAP_BIND_PASSWORD_FILE value, got %q`, opts.LDAPBindPassword())
+	}
+
+	if opts.LDAPUserBaseDN() != "ou=people,dc=example,dc=org" {
+		t.Errorf(`Unexpected LDAP_USER_BASE_DN value, got %q`, opts.LDAPUserBaseDN())
+	}
+
+	if opts.LDAPUserFilter() != "(sAMAccountName=%s)" {
+		t.Errorf(`Unexpected LDAP_USER_FILTER value, got %q`, opts.LDAPUserFilter())
+	}
+
+	if opts.LDAPAdminGroupDN() != "cn=admins,dc=example,dc=org" {
+		t.Errorf(`Unexpected LDAP_ADMIN_GROUP_DN value, got %q`, opts.LDAPAdminGroupDN())
+	}
+
+	if !opts.IsLDAPUserCreationAllowed() {
+		t.Errorf(`Unexpected LDAP_USER_CREATION value, got %v`, opts.IsLDAPUserCreationAllowed())
+	}
+
+	if !opts.IsLDAPLocalPasswordFallbackAllowed() {
+		t.Errorf(`Unexpected LDAP_LOCAL_PASSWORD_FALLBACK value, got %v`, opts.IsLDAPLocalPasswordFallbackAllowed())
+	}
+
+	if !opts.IsLDAPLocalUserLinkingAllowed() {
+		t.Errorf(`Unexpected LDAP_LINK_LOCAL_USERS value, got %v`, opts.IsLDAPLocalUserLinkingAllowed())
+	}
+
+	if opts.LDAPTimeout() != 3*time.Second {
+		t.Errorf(`Unexpected LDAP_TIMEOUT value, got %v`, opts.LDAPTimeout())
+	}
+
+	for _, option := range opts.SortedOptions(true) {
+		if option.Key == "LDAP_BIND_PASSWORD" && option.Value != "<secret>" {
+			t.Errorf(`The LDAP bind password should be redacted, got %v`, option.Value)
+		}
+	}
+}
+
+func TestLDAPDefaultValues(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.LDAPURL() != "" {
+		t.Errorf(`LDAP authentication should be disabled by default`)
+	}
+
+	if opts.LDAPUserFilter() != defaultLDAPUserFilter {
+		t.Errorf(`Unexpected LDAP_USER_FILTER value, got %q`, opts.LDAPUserFilter())
+	}
+
+	if opts.IsLDAPUserCreationAllowed() {
+		t.Errorf(`LDAP user creation should be disabled by default`)
+	}
+
+	if opts.IsLDAPLocalPasswordFallbackAllowed() {
+		t.Errorf(`LDAP local password fallback should be disabled by default`)
+	}
+
+	if opts.IsLDAPLocalUserLinkingAllowed() {
+		t.Errorf(`LDAP local user linking should be disabled by default`)
+	}
+
+	if opts.LDAPTimeout() != defaultLDAPTimeout {
+		t.Errorf(`Unexpected LDAP_TIMEOUT value, got %v`, opts.LDAPTimeout())
+	}
+}
@@ -52,6 +52,7 @@ const (
 	defaultCleanupArchiveUnreadInterval       = 180 * 24 * time.Hour
 	defaultCleanupArchiveBatchSize            = 10000
 	defaultCleanupRemoveSessionsInterval      = 30 * 24 * time.Hour
+	defaultCleanupRemoveAuditLogsInterval     = 365 * 24 * time.Hour
 	defaultMediaProxyHTTPClientTimeout        = 120 * time.Second
 	defaultMediaProxyMode                     = "http-only"
 	defaultMediaResourceTypes                 = "image"
@@ -71,6 +72,7 @@ const (
 	defaultOAuth2ClientSecret                 = ""
 	defaultOAuth2RedirectURL                  = ""
 	defaultOAuth2OidcDiscoveryEndpoint        = ""
+	defaultOAuth2OidcGroupsClaim              = "groups"
 	defaultOauth2OidcProviderName             = "OpenID Connect"
 	defaultOAuth2Provider                     = ""
 	defaultDisableLocalAuth                   = false
@@ -90,6 +92,30 @@ const (
 	defaultWatchdog                           = true
 	defaultInvidiousInstance                  = "yewtu.be"
 	defaultWebAuthn                           = false
+	defaultLLMClientTimeout                   = 60 * time.Second
+	defaultLLMMaxConcurrentRequests           = 2
+	defaultLLMFeedProcessingTimeout           = 120 * time.Second
+	defaultEncryptionKey                      = ""
+	defaultHTTPClientProxyHealthCheckInterval = 60 * time.Second
+	defaultHTTPClientProxyMaxFailures         = 3
+	defaultHTTPClientProxyEjectionDuration    = 300 * time.Second
+	defaultEncryptionPreviousKey              = ""
+	defaultTOTPRequired                       = false
+	defaultAuthRateLimitMaxAttempts           = 10
+	defaultAuthRateLimitLockoutDuration       = 60 * time.Second
+	defaultAuthRateLimitMaxLockoutDuration    = 3600 * time.Second
+	defaultLDAPURL                            = ""
+	defaultLDAPStartTLS                       = false
+	defaultLDAPTLSCAFile                      = ""
+	defaultLDAPBindDN                         = ""
+	defaultLDAPBindPassword                   = ""
+	defaultLDAPUserBaseDN                     = ""
+	defaultLDAPUserFilter                     = "(uid=%s)"
+	defaultLDAPAdminGroupDN                   = ""
+	defaultLDAPUserCreation                   = false
+	defaultLDAPLocalPasswordFallback          = false
+	defaultLDAPLinkLocalUsers                 = false
+	defaultLDAPTimeout                        = 10 * time.Second
 )
 
 var defaultHTTPClientUserAgent = "Mozilla/5.0 (compatible; Miniflux/" + version.Version + "; +https://miniflux.app)"
@@ -184,6 +210,49 @@ type options struct {
 	invidiousInstance                  string
 	mediaProxyPrivateKey               []byte
 	webAuthn                           bool
+	llmClientTimeout                   time.Duration
+	llmMaxConcurrentRequests           int
+	llmFeedProcessingTimeout           time.Duration
+	encryptionKey                      string
+	httpClientProxyHealthCheckInterval time.Duration
+	httpClientProxyMaxFailures         int
+	httpClientProxyEjectionDuration    time.Duration
+	httpClientProxyPools               map[string][]string
+	encryptionPreviousKey              string
+	totpRequired                       bool
+	authRateLimitMaxAttempts           int
+	authRateLimitLockoutDuration       time.Duration
+	authRateLimitMaxLockoutDuration    time.Duration
+	cleanupRemoveAuditLogsInterval     time.Duration
+	ldapURL                            string
+	ldapStartTLS                       bool
+	ldapTLSCAFile                      string
+	ldapBindDN                         string
+	ldapBindPassword                   string
+	ldapUserBaseDN                     string
+	ldapUserFilter                     string
+	ldapAdminGroupDN                   string
+	ldapUserCreationAllowed            bool
+	ldapLocalPasswordFallbackAllowed   bool
+	ldapLocalUserLinkingAllowed        bool
+	ldapTimeout                        time.Duration
+	oidcGroupsClaims                   []string
+	oidcAdminGroups                    []string
+	oidcRequiredGroups                 []string
+	oidcProviders                      []*OIDCProviderOptions
+}
+
+// OIDCProviderOptions holds the settings of an additional OpenID Connect provider.
+type OIDCProviderOptions struct {
+	Name              string
+	DisplayName       string
+	ClientID          string
+	ClientSecret      string
+	RedirectURL       string
+	DiscoveryEndpoint string
+	GroupsClaims      []string
+	AdminGroups       []string
+	RequiredGroups    []string
 }
 
 // NewOptions returns Options with default values.
@@ -264,6 +333,35 @@ func NewOptions() *options {
 		invidiousInstance:                  defaultInvidiousInstance,
 		mediaProxyPrivateKey:               crypto.GenerateRandomBytes(16),
 		webAuthn:                           defaultWebAuthn,
+		llmClientTimeout:                   defaultLLMClientTimeout,
+		llmMaxConcurrentRequests:           defaultLLMMaxConcurrentRequests,
+		llmFeedProcessingTimeout:           defaultLLMFeedProcessingTimeout,
+		encryptionKey:                      defaultEncryptionKey,
+		httpClientProxyHealthCheckInterval: defaultHTTPClientProxyHealthCheckInterval,
+		httpClientProxyMaxFailures:         defaultHTTPClientProxyMaxFailures,
+		httpClientProxyEjectionDuration:    defaultHTTPClientProxyEjectionDuration,
+		httpClientProxyPools:               map[string][]string{},
+		encryptionPreviousKey:              defaultEncryptionPreviousKey,
+		totpRequired:                       defaultTOTPRequired,
+		authRateLimitMaxAttempts:           defaultAuthRateLimitMaxAttempts,
+		authRateLimitLockoutDuration:       defaultAuthRateLimitLockoutDuration,
+		authRateLimitMaxLockoutDuration:    defaultAuthRateLimitMaxLockoutDuration,
+		cleanupRemoveAuditLogsInterval:     defaultCleanupRemoveAuditLogsInterval,
+		ldapURL:                            defaultLDAPURL,
+		ldapStartTLS:                       defaultLDAPStartTLS,
+		ldapTLSCAFile:                      defaultLDAPTLSCAFile,
+		ldapBindDN:                         defaultLDAPBindDN,
+		ldapBindPassword:                   defaultLDAPBindPassword,
+		ldapUserBaseDN:                     defaultLDAPUserBaseDN,
+		ldapUserFilter:                     defaultLDAPUserFilter,
+		ldapAdminGroupDN:                   defaultLDAPAdminGroupDN,
+		ldapUserCreationAllowed:            defaultLDAPUserCreation,
+		ldapLocalPasswordFallbackAllowed:   defaultLDAPLocalPasswordFallback,
+		ldapLocalUserLinkingAllowed:        defaultLDAPLinkLocalUsers,
+		ldapTimeout:                        defaultLDAPTimeout,
+		oidcGroupsClaims:                   []string{defaultOAuth2OidcGroupsClaim},
+		oidcAdminGroups:                    []string{},
+		oidcRequiredGroups:                 []string{},
 	}
 }
 
@@ -475,6 +573,25 @@ func (o *options) OIDCProviderName() string {
 	return o.oidcProviderName
 }
 
+// OIDCProviders returns the additional OpenID Connect providers.
+func (o *options) OIDCProviders() []*OIDCProviderOptions {
+	return o.oidcProviders
+}
+
+// OIDCProviderNames returns the names of the additional OpenID Connect providers.
+func (o *options) OIDCProviderNames() []string {
+	names := make([]string, 0, len(o.oidcProviders))
+	for _, provider := range o.oidcProviders {
+		names = append(names, provider.Name)
+	}
+	return names
+}
+
+// HasOAuth2Providers returns true if at least one OAuth2 provider is configured.
+func (o *options) HasOAuth2Providers() bool {
+	return o.oauth2Provider != "" || len(o.oidcProviders) > 0
+}
+
 // OAuth2Provider returns the name of the OAuth2 provider configured.
 func (o *options) OAuth2Provider() string {
 	return o.oauth2Provider
@@ -682,6 +799,154 @@ func (o *options) FilterEntryMaxAgeDays() int {
 	return o.filterEntryMaxAgeDays
 }
 
+// LLMClientTimeout returns the time limit before the HTTP client cancel the requests sent to the language model endpoint.
+func (o *options) LLMClientTimeout() time.Duration {
+	return o.llmClientTimeout
+}
+
+// LLMMaxConcurrentRequests returns the maximum number of requests sent at the same time to language model endpoints.
+func (o *options) LLMMaxConcurrentRequests() int {
+	return o.llmMaxConcurrentRequests
+}
+
+// LLMFeedProcessingTimeout returns the time limit to process the entries of a feed with the language model during a refresh.
+func (o *options) LLMFeedProcessingTimeout() time.Duration {
+	return o.llmFeedProcessingTimeout
+}
+
+// EncryptionKey returns the secret used to encrypt sensitive values stored in the database.
+func (o *options) EncryptionKey() string {
+	return o.encryptionKey
+}
+
+// HTTPClientProxyHealthCheckInterval returns the interval between two health checks of the rotating proxies.
+func (o *options) HTTPClientProxyHealthCheckInterval() time.Duration {
+	return o.httpClientProxyHealthCheckInterval
+}
+
+// HTTPClientProxyMaxFailures returns the number of consecutive connection failures before a rotating proxy is ejected.
+func (o *options) HTTPClientProxyMaxFailures() int {
+	return o.httpClientProxyMaxFailures
+}
+
+// HTTPClientProxyEjectionDuration returns how long a failing rotating proxy is removed from the rotation.
+func (o *options) HTTPClientProxyEjectionDuration() time.Duration {
+	return o.httpClientProxyEjectionDuration
+}
+
+// HTTPClientProxyPools returns the named proxy pools that feeds can select.
+func (o *options) HTTPClientProxyPools() map[string][]string {
+	return o.httpClientProxyPools
+}
+
+// EncryptionPreviousKey returns the former encryption key, used to read the data keys while rotating the encryption key.
+func (o *options) EncryptionPreviousKey() string {
+	return o.encryptionPreviousKey
+}
+
+// TOTPRequired returns true if all users with a password must configure two-factor authentication.
+func (o *options) TOTPRequired() bool {
+	return o.totpRequired
+}
+
+// AuthRateLimitMaxAttempts returns the number of failed authentication attempts allowed before a lockout.
+func (o *options) AuthRateLimitMaxAttempts() int {
+	return o.authRateLimitMaxAttempts
+}
+
+// AuthRateLimitLockoutDuration returns the duration of the first authentication lockout.
+func (o *options) AuthRateLimitLockoutDuration() time.Duration {
+	return o.authRateLimitLockoutDuration
+}
+
+// AuthRateLimitMaxLockoutDuration returns the maximum duration of an authentication lockout.
+func (o *options) AuthRateLimitMaxLockoutDuration() time.Duration {
+	return o.authRateLimitMaxLockoutDuration
+}
+
+// CleanupRemoveAuditLogsInterval returns the interval after which to remove audit log entries.
+// Audit log entries are kept forever when the interval is zero.
+func (o *options) CleanupRemoveAuditLogsInterval() time.Duration {
+	return o.cleanupRemoveAuditLogsInterval
+}
+
+// LDAPURL returns the LDAP server URL, LDAP authentication is disabled when it is empty.
+func (o *options) LDAPURL() string {
+	return o.ldapURL
+}
+
+// LDAPStartTLS returns true if ldap:// connections are upgraded with StartTLS.
+func (o *options) LDAPStartTLS() bool {
+	return o.ldapStartTLS
+}
+
+// LDAPTLSCAFile returns the path of the certificate authorities trusted for the LDAP server.
+func (o *options) LDAPTLSCAFile() string {
+	return o.ldapTLSCAFile
+}
+
+// LDAPBindDN returns the distinguished name of the LDAP service account.
+func (o *options) LDAPBindDN() string {
+	return o.ldapBindDN
+}
+
+// LDAPBindPassword returns the password of the LDAP service account.
+func (o *options) LDAPBindPassword() string {
+	return o.ldapBindPassword
+}
+
+// LDAPUserBaseDN returns the base distinguished name of the LDAP user search.
+func (o *options) LDAPUserBaseDN() string {
+	return o.ldapUserBaseDN
+}
+
+// LDAPUserFilter returns the LDAP user search filter.
+func (o *options) LDAPUserFilter() string {
+	return o.ldapUserFilter
+}
+
+// LDAPAdminGroupDN returns the distinguished name of the LDAP admin group.
+func (o *options) LDAPAdminGroupDN() string {
+	return o.ldapAdminGroupDN
+}
+
+// IsLDAPUserCreationAllowed returns true if accounts are created for the directory users on their first login.
+func (o *options) IsLDAPUserCreationAllowed() bool {
+	return o.ldapUserCreationAllowed
+}
+
+// IsLDAPLocalPasswordFallbackAllowed returns true if the directory users can log in with their local password
+// when the directory is unreachable or does not know them anymore.
+func (o *options) IsLDAPLocalPasswordFallbackAllowed() bool {
+	return o.ldapLocalPasswordFallbackAllowed
+}
+
+// IsLDAPLocalUserLinkingAllowed returns true if the existing local accounts are taken over by the directory users
+// with the same username.
+func (o *options) IsLDAPLocalUserLinkingAllowed() bool {
+	return o.ldapLocalUserLinkingAllowed
+}
+
+// LDAPTimeout returns the time limit of the LDAP authentication.
+func (o *options) LDAPTimeout() time.Duration {
+	return o.ldapTimeout
+}
+
+// OIDCGroupsClaims returns the OpenID Connect claims containing the groups and roles of the users.
+func (o *options) OIDCGroupsClaims() []string {
+	return o.oidcGroupsClaims
+}
+
+// OIDCAdminGroups returns the OpenID Connect groups whose members are administrators.
+func (o *options) OIDCAdminGroups() []string {
+	return o.oidcAdminGroups
+}
+
+// OIDCRequiredGroups returns the OpenID Connect groups allowed to log in.
+func (o *options) OIDCRequiredGroups() []string {
+	return o.oidcRequiredGroups
+}
+
 // SortedOptions returns options as a list of key value pairs, sorted by keys.
 func (o *options) SortedOptions(redactSecret bool) []*option {
 	var clientProxyURLRedacted string
@@ -706,93 +971,145 @@ func (o *options) SortedOptions(redactSecret bool) []*option {
 		}
 	}
 
+	var clientProxyPools []string
+	for _, name := range slices.Sorted(maps.Keys(o.httpClientProxyPools)) {
+		proxyURLs := o.httpClientProxyPools[name]
+		if redactSecret {
+			proxyURLs = slices.Repeat([]string{"<redacted>"}, len(proxyURLs))
+		}
+		clientProxyPools = append(clientProxyPools, name+"="+strings.Join(proxyURLs, ","))
+	}
+	clientProxyPoolsRedacted := strings.Join(clientProxyPools, ";")
+
 	var mediaProxyPrivateKeyValue string
 	if len(o.mediaProxyPrivateKey) > 0 {
 		mediaProxyPrivateKeyValue = "<binary-data>"
 	}
 
 	var keyValues = map[string]any{
-		"ADMIN_PASSWORD":                         redactSecretValue(o.adminPassword, redactSecret),
-		"ADMIN_USERNAME":                         o.adminUsername,
-		"AUTH_PROXY_HEADER":                      o.authProxyHeader,
-		"AUTH_PROXY_USER_CREATION":               o.authProxyUserCreation,
-		"BASE_PATH":                              o.basePath,
-		"BASE_URL":                               o.baseURL,
-		"BATCH_SIZE":                             o.batchSize,
-		"CERT_DOMAIN":                            o.certDomain,
-		"CERT_FILE":                              o.certFile,
-		"CLEANUP_FREQUENCY_HOURS":                int(o.cleanupFrequencyInterval.Hours()),
-		"CLEANUP_ARCHIVE_BATCH_SIZE":             o.cleanupArchiveBatchSize,
-		"CLEANUP_ARCHIVE_READ_DAYS":              int(o.cleanupArchiveReadInterval.Hours() / 24),
-		"CLEANUP_ARCHIVE_UNREAD_DAYS":            int(o.cleanupArchiveUnreadInterval.Hours() / 24),
-		"CLEANUP_REMOVE_SESSIONS_DAYS":           int(o.cleanupRemoveSessionsInterval.Hours() / 24),
-		"CREATE_ADMIN":                           o.createAdmin,
-		"DATABASE_CONNECTION_LIFETIME":           o.databaseConnectionLifetime,
-		"DATABASE_MAX_CONNS":                     o.databaseMaxConns,
-		"DATABASE_MIN_CONNS":                     o.databaseMinConns,
-		"DATABASE_URL":                           redactSecretValue(o.databaseURL, redactSecret),
-		"DISABLE_HSTS":                           !o.hsts,
-		"DISABLE_HTTP_SERVICE":                   !o.httpService,
-		"DISABLE_SCHEDULER_SERVICE":              !o.schedulerService,
-		"FILTER_ENTRY_MAX_AGE_DAYS":              o.filterEntryMaxAgeDays,
-		"FETCH_YOUTUBE_WATCH_TIME":               o.fetchYouTubeWatchTime,
-		"FETCH_NEBULA_WATCH_TIME":                o.fetchNebulaWatchTime,
-		"FETCH_ODYSEE_WATCH_TIME":                o.fetchOdyseeWatchTime,
-		"FETCH_BILIBILI_WATCH_TIME":              o.fetchBilibiliWatchTime,
-		"HTTPS":                                  o.HTTPS,
-		"HTTP_CLIENT_MAX_BODY_SIZE":              o.httpClientMaxBodySize,
-		"HTTP_CLIENT_PROXIES":                    clientProxyURLsRedacted,
-		"HTTP_CLIENT_PROXY":                      clientProxyURLRedacted,
-		"HTTP_CLIENT_TIMEOUT":                    int(o.httpClientTimeout.Seconds()),
-		"HTTP_CLIENT_USER_AGENT":                 o.httpClientUserAgent,
-		"HTTP_SERVER_TIMEOUT":                    int(o.httpServerTimeout.Seconds()),
-		"HTTP_SERVICE":                           o.httpService,
-		"INVIDIOUS_INSTANCE":                     o.invidiousInstance,
-		"KEY_FILE":                               o.certKeyFile,
-		"LISTEN_ADDR":                            strings.Join(o.listenAddr, ","),
-		"LOG_FILE":                               o.logFile,
-		"LOG_DATE_TIME":                          o.logDateTime,
-		"LOG_FORMAT":                             o.logFormat,
-		"LOG_LEVEL":                              o.logLevel,
-		"MAINTENANCE_MESSAGE":                    o.maintenanceMessage,
-		"MAINTENANCE_MODE":                       o.maintenanceMode,
-		"METRICS_ALLOWED_NETWORKS":               strings.Join(o.metricsAllowedNetworks, ","),
-		"METRICS_COLLECTOR":                      o.metricsCollector,
-		"METRICS_PASSWORD":                       redactSecretValue(o.metricsPassword, redactSecret),
-		"METRICS_REFRESH_INTERVAL":               int(o.metricsRefreshInterval.Seconds()),
-		"METRICS_USERNAME":                       o.metricsUsername,
-		"OAUTH2_CLIENT_ID":                       o.oauth2ClientID,
-		"OAUTH2_CLIENT_SECRET":                   redactSecretValue(o.oauth2ClientSecret, redactSecret),
-		"OAUTH2_OIDC_DISCOVERY_ENDPOINT":         o.oidcDiscoveryEndpoint,
-		"OAUTH2_OIDC_PROVIDER_NAME":              o.oidcProviderName,
-		"OAUTH2_PROVIDER":                        o.oauth2Provider,
-		"OAUTH2_REDIRECT_URL":                    o.oauth2RedirectURL,
-		"OAUTH2_USER_CREATION":                   o.oauth2UserCreationAllowed,
-		"DISABLE_LOCAL_AUTH":                     o.disableLocalAuth,
-		"FORCE_REFRESH_INTERVAL":                 int(o.forceRefreshInterval.Minutes()),
-		"POLLING_FREQUENCY":                      int(o.pollingFrequency.Minutes()),
-		"POLLING_LIMIT_PER_HOST":                 o.pollingLimitPerHost,
-		"POLLING_PARSING_ERROR_LIMIT":            o.pollingParsingErrorLimit,
-		"POLLING_SCHEDULER":                      o.pollingScheduler,
-		"MEDIA_PROXY_HTTP_CLIENT_TIMEOUT":        int(o.mediaProxyHTTPClientTimeout.Seconds()),
-		"MEDIA_PROXY_RESOURCE_TYPES":             o.mediaProxyResourceTypes,
-		"MEDIA_PROXY_MODE":                       o.mediaProxyMode,
-		"MEDIA_PROXY_PRIVATE_KEY":                mediaProxyPrivateKeyValue,
-		"MEDIA_PROXY_CUSTOM_URL":                 o.mediaProxyCustomURL,
-		"ROOT_URL":                               o.rootURL,
-		"RUN_MIGRATIONS":                         o.runMigrations,
-		"SCHEDULER_ENTRY_FREQUENCY_MAX_INTERVAL": int(o.schedulerEntryFrequencyMaxInterval.Minutes()),
-		"SCHEDULER_ENTRY_FREQUENCY_MIN_INTERVAL": int(o.schedulerEntryFrequencyMinInterval.Minutes()),
-		"SCHEDULER_ENTRY_FREQUENCY_FACTOR":       o.schedulerEntryFrequencyFactor,
-		"SCHEDULER_ROUND_ROBIN_MIN_INTERVAL":     int(o.schedulerRoundRobinMinInterval.Minutes()),
-		"SCHEDULER_ROUND_ROBIN_MAX_INTERVAL":     int(o.schedulerRoundRobinMaxInterval.Minutes()),
-		"SCHEDULER_SERVICE":                      o.schedulerService,
-		"WATCHDOG":                               o.watchdog,
-		"WORKER_POOL_SIZE":                       o.workerPoolSize,
-		"YOUTUBE_API_KEY":                        redactSecretValue(o.youTubeApiKey, redactSecret),
-		"YOUTUBE_EMBED_URL_OVERRIDE":             o.youTubeEmbedUrlOverride,
-		"WEBAUTHN":                               o.webAuthn,
+		"ADMIN_PASSWORD":                          redactSecretValue(o.adminPassword, redactSecret),
+		"ADMIN_USERNAME":                          o.adminUsername,
+		"AUTH_PROXY_HEADER":                       o.authProxyHeader,
+		"AUTH_PROXY_USER_CREATION":                o.authProxyUserCreation,
+		"AUTH_RATE_LIMIT_LOCKOUT_DURATION":        int(o.authRateLimitLockoutDuration.Seconds()),
+		"AUTH_RATE_LIMIT_MAX_ATTEMPTS":            o.authRateLimitMaxAttempts,
+		"AUTH_RATE_LIMIT_MAX_LOCKOUT_DURATION":    int(o.authRateLimitMaxLockoutDuration.Seconds()),
+		"BASE_PATH":                               o.basePath,
+		"BASE_URL":                                o.baseURL,
+		"BATCH_SIZE":                              o.batchSize,
+		"CERT_DOMAIN":                             o.certDomain,
+		"CERT_FILE":                               o.certFile,
+		"CLEANUP_FREQUENCY_HOURS":                 int(o.cleanupFrequencyInterval.Hours()),
+		"CLEANUP_ARCHIVE_BATCH_SIZE":              o.cleanupArchiveBatchSize,
+		"CLEANUP_ARCHIVE_READ_DAYS":               int(o.cleanupArchiveReadInterval.Hours() / 24),
+		"CLEANUP_ARCHIVE_UNREAD_DAYS":             int(o.cleanupArchiveUnreadInterval.Hours() / 24),
+		"CLEANUP_REMOVE_AUDIT_LOGS_DAYS":          int(o.cleanupRemoveAuditLogsInterval.Hours() / 24),
+		"CLEANUP_REMOVE_SESSIONS_DAYS":            int(o.cleanupRemoveSessionsInterval.Hours() / 24),
+		"CREATE_ADMIN":                            o.createAdmin,
+		"DATABASE_CONNECTION_LIFETIME":            o.databaseConnectionLifetime,
+		"DATABASE_MAX_CONNS":                      o.databaseMaxConns,
+		"DATABASE_MIN_CONNS":                      o.databaseMinConns,
+		"DATABASE_URL":                            redactSecretValue(o.databaseURL, redactSecret),
+		"DISABLE_HSTS":                            !o.hsts,
+		"DISABLE_HTTP_SERVICE":                    !o.httpService,
+		"DISABLE_SCHEDULER_SERVICE":               !o.schedulerService,
+		"ENCRYPTION_KEY":                          redactSecretValue(o.encryptionKey, redactSecret),
+		"ENCRYPTION_PREVIOUS_KEY":                 redactSecretValue(o.encryptionPreviousKey, redactSecret),
+		"FILTER_ENTRY_MAX_AGE_DAYS":               o.filterEntryMaxAgeDays,
+		"FETCH_YOUTUBE_WATCH_TIME":                o.fetchYouTubeWatchTime,
+		"FETCH_NEBULA_WATCH_TIME":                 o.fetchNebulaWatchTime,
+		"FETCH_ODYSEE_WATCH_TIME":                 o.fetchOdyseeWatchTime,
+		"FETCH_BILIBILI_WATCH_TIME":               o.fetchBilibiliWatchTime,
+		"HTTPS":                                   o.HTTPS,
+		"HTTP_CLIENT_MAX_BODY_SIZE":               o.httpClientMaxBodySize,
+		"HTTP_CLIENT_PROXIES":                     clientProxyURLsRedacted,
+		"HTTP_CLIENT_PROXY":                       clientProxyURLRedacted,
+		"HTTP_CLIENT_PROXY_EJECTION_DURATION":     int(o.httpClientProxyEjectionDuration.Seconds()),
+		"HTTP_CLIENT_PROXY_HEALTH_CHECK_INTERVAL": int(o.httpClientProxyHealthCheckInterval.Seconds()),
+		"HTTP_CLIENT_PROXY_MAX_FAILURES":          o.httpClientProxyMaxFailures,
+		"HTTP_CLIENT_PROXY_POOLS":                 clientProxyPoolsRedacted,
+		"HTTP_CLIENT_TIMEOUT":                     int(o.httpClientTimeout.Seconds()),
+		"HTTP_CLIENT_USER_AGENT":                  o.httpClientUserAgent,
+		"HTTP_SERVER_TIMEOUT":                     int(o.httpServerTimeout.Seconds()),
+		"HTTP_SERVICE":                            o.httpService,
+		"INVIDIOUS_INSTANCE":                      o.invidiousInstance,
+		"KEY_FILE":                                o.certKeyFile,
+		"LDAP_ADMIN_GROUP_DN":                     o.ldapAdminGroupDN,
+		"LDAP_BIND_DN":                            o.ldapBindDN,
+		"LDAP_BIND_PASSWORD":                      redactSecretValue(o.ldapBindPassword, redactSecret),
+		"LDAP_START_TLS":                          o.ldapStartTLS,
+		"LDAP_TIMEOUT":                            int(o.ldapTimeout.Seconds()),
+		"LDAP_TLS_CA_FILE":                        o.ldapTLSCAFile,
+		"LDAP_URL":                                o.ldapURL,
+		"LDAP_USER_BASE_DN":                       o.ldapUserBaseDN,
+		"LDAP_USER_CREATION":                      o.ldapUserCreationAllowed,
+		"LDAP_LOCAL_PASSWORD_FALLBACK":            o.ldapLocalPasswordFallbackAllowed,
+		"LDAP_LINK_LOCAL_USERS":                   o.ldapLocalUserLinkingAllowed,
+		"LDAP_USER_FILTER":                        o.ldapUserFilter,
+		"LISTEN_ADDR":                             strings.Join(o.listenAddr, ","),
+		"LLM_CLIENT_TIMEOUT":                      int(o.llmClientTimeout.Seconds()),
+		"LLM_MAX_CONCURRENT_REQUESTS":             o.llmMaxConcurrentRequests,
+		"LLM_FEED_PROCESSING_TIMEOUT":             int(o.llmFeedProcessingTimeout.Seconds()),
+		"LOG_FILE":                                o.logFile,
+		"LOG_DATE_TIME":                           o.logDateTime,
+		"LOG_FORMAT":                              o.logFormat,
+		"LOG_LEVEL":                               o.logLevel,
+		"MAINTENANCE_MESSAGE":                     o.maintenanceMessage,
+		"MAINTENANCE_MODE":                        o.maintenanceMode,
+		"METRICS_ALLOWED_NETWORKS":                strings.Join(o.metricsAllowedNetworks, ","),
+		"METRICS_COLLECTOR":                       o.metricsCollector,
+		"METRICS_PASSWORD":                        redactSecretValue(o.metricsPassword, redactSecret),
+		"METRICS_REFRESH_INTERVAL":                int(o.metricsRefreshInterval.Seconds()),
+		"METRICS_USERNAME":                        o.metricsUsername,
+		"OAUTH2_CLIENT_ID":                        o.oauth2ClientID,
+		"OAUTH2_CLIENT_SECRET":                    redactSecretValue(o.oauth2ClientSecret, redactSecret),
+		"OAUTH2_OIDC_ADMIN_GROUPS":                o.oidcAdminGroups,
+		"OAUTH2_OIDC_DISCOVERY_ENDPOINT":          o.oidcDiscoveryEndpoint,
+		"OAUTH2_OIDC_GROUPS_CLAIMS":               o.oidcGroupsClaims,
+		"OAUTH2_OIDC_PROVIDER_NAME":               o.oidcProviderName,
+		"OAUTH2_OIDC_REQUIRED_GROUPS":             o.oidcRequiredGroups,
+		"OAUTH2_PROVIDER":                         o.oauth2Provider,
+		"OAUTH2_REDIRECT_URL":                     o.oauth2RedirectURL,
+		"OAUTH2_USER_CREATION":                    o.oauth2UserCreationAllowed,
+		"DISABLE_LOCAL_AUTH":                      o.disableLocalAuth,
+		"FORCE_REFRESH_INTERVAL":                  int(o.forceRefreshInterval.Minutes()),
+		"POLLING_FREQUENCY":                       int(o.pollingFrequency.Minutes()),
+		"POLLING_LIMIT_PER_HOST":                  o.pollingLimitPerHost,
+		"POLLING_PARSING_ERROR_LIMIT":             o.pollingParsingErrorLimit,
+		"POLLING_SCHEDULER":                       o.pollingScheduler,
+		"MEDIA_PROXY_HTTP_CLIENT_TIMEOUT":         int(o.mediaProxyHTTPClientTimeout.Seconds()),
+		"MEDIA_PROXY_RESOURCE_TYPES":              o.mediaProxyResourceTypes,
+		"MEDIA_PROXY_MODE":                        o.mediaProxyMode,
+		"MEDIA_PROXY_PRIVATE_KEY":                 mediaProxyPrivateKeyValue,
+		"MEDIA_PROXY_CUSTOM_URL":                  o.mediaProxyCustomURL,
+		"ROOT_URL":                                o.rootURL,
+		"RUN_MIGRATIONS":                          o.runMigrations,
+		"SCHEDULER_ENTRY_FREQUENCY_MAX_INTERVAL":  int(o.schedulerEntryFrequencyMaxInterval.Minutes()),
+		"SCHEDULER_ENTRY_FREQUENCY_MIN_INTERVAL":  int(o.schedulerEntryFrequencyMinInterval.Minutes()),
+		"SCHEDULER_ENTRY_FREQUENCY_FACTOR":        o.schedulerEntryFrequencyFactor,
+		"SCHEDULER_ROUND_ROBIN_MIN_INTERVAL":      int(o.schedulerRoundRobinMinInterval.Minutes()),
+		"SCHEDULER_ROUND_ROBIN_MAX_INTERVAL":      int(o.schedulerRoundRobinMaxInterval.Minutes()),
+		"SCHEDULER_SERVICE":                       o.schedulerService,
+		"TOTP_REQUIRED":                           o.totpRequired,
+		"WATCHDOG":                                o.watchdog,
+		"WORKER_POOL_SIZE":                        o.workerPoolSize,
+		"YOUTUBE_API_KEY":                         redactSecretValue(o.youTubeApiKey, redactSecret),
+		"YOUTUBE_EMBED_URL_OVERRIDE":              o.youTubeEmbedUrlOverride,
+		"WEBAUTHN":                                o.webAuthn,
+	}
+
+	for _, provider := range o.oidcProviders {
+		prefix := "OAUTH2_OIDC_" + strings.ToUpper(provider.Name) + "_"
+		keyValues[prefix+"ADMIN_GROUPS"] = provider.AdminGroups
+		keyValues[prefix+"CLIENT_ID"] = provider.ClientID
+		keyValues[prefix+"CLIENT_SECRET"] = redactSecretValue(provider.ClientSecret, redactSecret)
+		keyValues[prefix+"DISCOVERY_ENDPOINT"] = provider.DiscoveryEndpoint
+		keyValues[prefix+"GROUPS_CLAIMS"] = provider.GroupsClaims
+		keyValues[prefix+"PROVIDER_NAME"] = provider.DisplayName
+		keyValues[prefix+"REDIRECT_URL"] = provider.RedirectURL
+		keyValues[prefix+"REQUIRED_GROUPS"] = provider.RequiredGroups
 	}
+	keyValues["OAUTH2_OIDC_PROVIDERS"] = strings.Join(o.OIDCProviderNames(), ",")
 
 	sortedKeys := slices.Sorted(maps.Keys(keyValues))
 	var sortedOptions = make([]*option, 0, len(sortedKeys))
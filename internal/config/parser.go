@@ -66,6 +66,8 @@ func (p *parser) parseFileContent(r io.Reader) (lines []string) {
 
 func (p *parser) parseLines(lines []string) (err error) {
 	var port string
+	var oidcProviderNames []string
+	oidcProviderValues := make(map[string]string)
 
 	for lineNum, line := range lines {
 		key, value, ok := strings.Cut(line, "=")
@@ -74,6 +76,11 @@ func (p *parser) parseLines(lines []string) (err error) {
 		}
 		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
 
+		// The settings of the additional OpenID Connect providers are read once all the lines are parsed.
+		if strings.HasPrefix(key, "OAUTH2_OIDC_") {
+			oidcProviderValues[key] = value
+		}
+
 		switch key {
 		case "LOG_FILE":
 			p.opts.logFile = parseString(value, defaultLogFile)
@@ -136,6 +143,8 @@ func (p *parser) parseLines(lines []string) (err error) {
 			p.opts.cleanupArchiveBatchSize = parseInt(value, defaultCleanupArchiveBatchSize)
 		case "CLEANUP_REMOVE_SESSIONS_DAYS":
 			p.opts.cleanupRemoveSessionsInterval = parseInterval(value, 24*time.Hour, defaultCleanupRemoveSessionsInterval)
+		case "CLEANUP_REMOVE_AUDIT_LOGS_DAYS":
+			p.opts.cleanupRemoveAuditLogsInterval = parseInterval(value, 24*time.Hour, defaultCleanupRemoveAuditLogsInterval)
 		case "WORKER_POOL_SIZE":
 			p.opts.workerPoolSize = parseInt(value, defaultWorkerPoolSize)
 		case "FORCE_REFRESH_INTERVAL":
@@ -260,6 +269,73 @@ func (p *parser) parseLines(lines []string) (err error) {
 			p.opts.invidiousInstance = parseString(value, defaultInvidiousInstance)
 		case "WEBAUTHN":
 			p.opts.webAuthn = parseBool(value, defaultWebAuthn)
+		case "LLM_CLIENT_TIMEOUT":
+			p.opts.llmClientTimeout = parseInterval(value, time.Second, defaultLLMClientTimeout)
+		case "LLM_MAX_CONCURRENT_REQUESTS":
+			p.opts.llmMaxConcurrentRequests = parseInt(value, defaultLLMMaxConcurrentRequests)
+		case "LLM_FEED_PROCESSING_TIMEOUT":
+			p.opts.llmFeedProcessingTimeout = parseInterval(value, time.Second, defaultLLMFeedProcessingTimeout)
+		case "ENCRYPTION_KEY":
+			p.opts.encryptionKey = parseString(value, defaultEncryptionKey)
+		case "ENCRYPTION_KEY_FILE":
+			p.opts.encryptionKey = readSecretFile(value, defaultEncryptionKey)
+		case "HTTP_CLIENT_PROXY_HEALTH_CHECK_INTERVAL":
+			p.opts.httpClientProxyHealthCheckInterval = parseInterval(value, time.Second, defaultHTTPClientProxyHealthCheckInterval)
+		case "HTTP_CLIENT_PROXY_MAX_FAILURES":
+			p.opts.httpClientProxyMaxFailures = parseInt(value, defaultHTTPClientProxyMaxFailures)
+		case "HTTP_CLIENT_PROXY_EJECTION_DURATION":
+			p.opts.httpClientProxyEjectionDuration = parseInterval(value, time.Second, defaultHTTPClientProxyEjectionDuration)
+		case "HTTP_CLIENT_PROXY_POOLS":
+			p.opts.httpClientProxyPools, err = parseProxyPools(value)
+			if err != nil {
+				return fmt.Errorf("config: invalid HTTP_CLIENT_PROXY_POOLS value: %w", err)
+			}
+		case "ENCRYPTION_PREVIOUS_KEY":
+			p.opts.encryptionPreviousKey = parseString(value, defaultEncryptionPreviousKey)
+		case "ENCRYPTION_PREVIOUS_KEY_FILE":
+			p.opts.encryptionPreviousKey = readSecretFile(value, defaultEncryptionPreviousKey)
+		case "TOTP_REQUIRED":
+			p.opts.totpRequired = parseBool(value, defaultTOTPRequired)
+		case "AUTH_RATE_LIMIT_MAX_ATTEMPTS":
+			p.opts.authRateLimitMaxAttempts = parseInt(value, defaultAuthRateLimitMaxAttempts)
+		case "AUTH_RATE_LIMIT_LOCKOUT_DURATION":
+			p.opts.authRateLimitLockoutDuration = parseInterval(value, time.Second, defaultAuthRateLimitLockoutDuration)
+		case "AUTH_RATE_LIMIT_MAX_LOCKOUT_DURATION":
+			p.opts.authRateLimitMaxLockoutDuration = parseInterval(value, time.Second, defaultAuthRateLimitMaxLockoutDuration)
+		case "LDAP_URL":
+			p.opts.ldapURL = parseString(value, defaultLDAPURL)
+		case "LDAP_START_TLS":
+			p.opts.ldapStartTLS = parseBool(value, defaultLDAPStartTLS)
+		case "LDAP_TLS_CA_FILE":
+			p.opts.ldapTLSCAFile = parseString(value, defaultLDAPTLSCAFile)
+		case "LDAP_BIND_DN":
+			p.opts.ldapBindDN = parseString(value, defaultLDAPBindDN)
+		case "LDAP_BIND_PASSWORD":
+			p.opts.ldapBindPassword = parseString(value, defaultLDAPBindPassword)
+		case "LDAP_BIND_PASSWORD_FILE":
+			p.opts.ldapBindPassword = readSecretFile(value, defaultLDAPBindPassword)
+		case "LDAP_USER_BASE_DN":
+			p.opts.ldapUserBaseDN = parseString(value, defaultLDAPUserBaseDN)
+		case "LDAP_USER_FILTER":
+			p.opts.ldapUserFilter = parseString(value, defaultLDAPUserFilter)
+		case "LDAP_ADMIN_GROUP_DN":
+			p.opts.ldapAdminGroupDN = parseString(value, defaultLDAPAdminGroupDN)
+		case "LDAP_USER_CREATION":
+			p.opts.ldapUserCreationAllowed = parseBool(value, defaultLDAPUserCreation)
+		case "LDAP_LOCAL_PASSWORD_FALLBACK":
+			p.opts.ldapLocalPasswordFallbackAllowed = parseBool(value, defaultLDAPLocalPasswordFallback)
+		case "LDAP_LINK_LOCAL_USERS":
+			p.opts.ldapLocalUserLinkingAllowed = parseBool(value, defaultLDAPLinkLocalUsers)
+		case "LDAP_TIMEOUT":
+			p.opts.ldapTimeout = parseInterval(value, time.Second, defaultLDAPTimeout)
+		case "OAUTH2_OIDC_GROUPS_CLAIMS":
+			p.opts.oidcGroupsClaims = parseStringList(value, []string{defaultOAuth2OidcGroupsClaim})
+		case "OAUTH2_OIDC_ADMIN_GROUPS":
+			p.opts.oidcAdminGroups = parseStringList(value, []string{})
+		case "OAUTH2_OIDC_REQUIRED_GROUPS":
+			p.opts.oidcRequiredGroups = parseStringList(value, []string{})
+		case "OAUTH2_OIDC_PROVIDERS":
+			oidcProviderNames = parseStringList(value, nil)
 		}
 	}
 
@@ -267,6 +343,11 @@ func (p *parser) parseLines(lines []string) (err error) {
 		p.opts.listenAddr = []string{":" + port}
 	}
 
+	p.opts.oidcProviders, err = parseOIDCProviders(oidcProviderNames, oidcProviderValues, p.opts.baseURL)
+	if err != nil {
+		return err
+	}
+
 	youtubeEmbedURL, err := url.Parse(p.opts.youTubeEmbedUrlOverride)
 	if err != nil {
 		return fmt.Errorf("config: invalid YOUTUBE_EMBED_URL_OVERRIDE value: %w", err)
@@ -298,6 +379,52 @@ func parseBaseURL(value string) (string, string, string, error) {
 	return value, parsedURL.String(), basePath, nil
 }
 
+// parseOIDCProviders reads the settings of the additional OpenID Connect providers.
+// Each setting is named OAUTH2_OIDC_<NAME>_<SETTING>, where NAME is the uppercase provider name.
+func parseOIDCProviders(names []string, values map[string]string, baseURL string) ([]*OIDCProviderOptions, error) {
+	providers := make([]*OIDCProviderOptions, 0, len(names))
+
+	for _, name := range names {
+		name = strings.ToLower(name)
+		if strings.Trim(name, "abcdefghijklmnopqrstuvwxyz0123456789") != "" {
+			return nil, fmt.Errorf("config: invalid OpenID Connect provider name %q, only letters and digits are allowed", name)
+		}
+
+		if name == "google" || name == "oidc" {
+			return nil, fmt.Errorf("config: the OpenID Connect provider name %q is reserved", name)
+		}
+
+		prefix := "OAUTH2_OIDC_" + strings.ToUpper(name) + "_"
+		provider := &OIDCProviderOptions{
+			Name:              name,
+			DisplayName:       parseString(values[prefix+"PROVIDER_NAME"], name),
+			ClientID:          values[prefix+"CLIENT_ID"],
+			ClientSecret:      values[prefix+"CLIENT_SECRET"],
+			RedirectURL:       parseString(values[prefix+"REDIRECT_URL"], baseURL+"/oauth2/"+name+"/callback"),
+			DiscoveryEndpoint: values[prefix+"DISCOVERY_ENDPOINT"],
+			GroupsClaims:      parseStringList(values[prefix+"GROUPS_CLAIMS"], []string{defaultOAuth2OidcGroupsClaim}),
+			AdminGroups:       parseStringList(values[prefix+"ADMIN_GROUPS"], []string{}),
+			RequiredGroups:    parseStringList(values[prefix+"REQUIRED_GROUPS"], []string{}),
+		}
+
+		if filename := values[prefix+"CLIENT_ID_FILE"]; filename != "" {
+			provider.ClientID = readSecretFile(filename, "")
+		}
+
+		if filename := values[prefix+"CLIENT_SECRET_FILE"]; filename != "" {
+			provider.ClientSecret = readSecretFile(filename, "")
+		}
+
+		if provider.ClientID == "" || provider.DiscoveryEndpoint == "" {
+			return nil, fmt.Errorf("config: %sCLIENT_ID and %sDISCOVERY_ENDPOINT are required for the OpenID Connect provider %q", prefix, prefix, name)
+		}
+
+		providers = append(providers, provider)
+	}
+
+	return providers, nil
+}
+
 func parseBool(value string, fallback bool) bool {
 	if value == "" {
 		return fallback
@@ -351,6 +478,30 @@ func parseStringList(value string, fallback []string) []string {
 	return strList
 }
 
+// parseProxyPools parses named proxy pools with the format "name1=url1,url2;name2=url3".
+func parseProxyPools(value string) (map[string][]string, error) {
+	pools := make(map[string][]string)
+
+	for pool := range strings.SplitSeq(value, ";") {
+		if strings.TrimSpace(pool) == "" {
+			continue
+		}
+
+		name, proxyURLs, found := strings.Cut(pool, "=")
+		name = strings.TrimSpace(name)
+		if !found || name == "" {
+			return nil, fmt.Errorf("missing name for proxy pool %q", pool)
+		}
+
+		pools[name] = parseStringList(proxyURLs, nil)
+		if len(pools[name]) == 0 {
+			return nil, fmt.Errorf("proxy pool %q has no proxy", name)
+		}
+	}
+
+	return pools, nil
+}
+
 func parseBytes(value string, fallback []byte) []byte {
 	if value == "" {
 		return fallback
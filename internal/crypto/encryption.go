@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package crypto // import "miniflux.app/v2/internal/crypto"
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+// encryptedValuePrefix identifies values encrypted with AES-256-GCM.
+const encryptedValuePrefix = "enc:v1:"
+
+var (
+	ErrMissingEncryptionKey = errors.New("crypto: encryption key is not configured")
+	ErrInvalidEncryptedData = errors.New("crypto: invalid encrypted data")
+)
+
+// Encrypt encrypts the plaintext with a key derived from the given secret.
+// Empty values are returned as-is.
+func Encrypt(secret, plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+
+	aead, err := newAEAD(secret)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	ciphertext := aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return encryptedValuePrefix + base64.RawStdEncoding.EncodeToString(ciphertext), nil
+}
+
+// Decrypt decrypts a value produced by Encrypt with the same secret.
+// Empty values are returned as-is.
+func Decrypt(secret, value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	encoded, found := strings.CutPrefix(value, encryptedValuePrefix)
+	if !found {
+		return "", ErrInvalidEncryptedData
+	}
+
+	aead, err := newAEAD(secret)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil || len(data) < aead.NonceSize() {
+		return "", ErrInvalidEncryptedData
+	}
+
+	plaintext, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], nil)
+	if err != nil {
+		return "", ErrInvalidEncryptedData
+	}
+
+	return string(plaintext), nil
+}
+
+func newAEAD(secret string) (cipher.AEAD, error) {
+	if secret == "" {
+		return nil, ErrMissingEncryptionKey
+	}
+
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
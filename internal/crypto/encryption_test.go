@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package crypto // import "miniflux.app/v2/internal/crypto"
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	encrypted, err := Encrypt("secret", "Authorization: Bearer token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(encrypted, encryptedValuePrefix) || strings.Contains(encrypted, "token") {
+		t.Fatalf(`Unexpected encrypted value: %q`, encrypted)
+	}
+
+	decrypted, err := Decrypt("secret", encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if decrypted != "Authorization: Bearer token" {
+		t.Fatalf(`Unexpected decrypted value: %q`, decrypted)
+	}
+
+	if _, err := Decrypt("another secret", encrypted); !errors.Is(err, ErrInvalidEncryptedData) {
+		t.Fatalf(`Decrypting with another key should fail, got %v`, err)
+	}
+}
+
+func TestEncryptEmptyValue(t *testing.T) {
+	encrypted, err := Encrypt("", "")
+	if err != nil || encrypted != "" {
+		t.Fatalf(`Empty values should not be encrypted, got %q (%v)`, encrypted, err)
+	}
+
+	if _, err := Encrypt("", "value"); !errors.Is(err, ErrMissingEncryptionKey) {
+		t.Fatalf(`Encrypting without key should fail, got %v`, err)
+	}
+}
+
+func TestDecryptInvalidValue(t *testing.T) {
+	for _, value := range []string{"plaintext", encryptedValuePrefix + "!!!", encryptedValuePrefix + "YWJj"} {
+		if _, err := Decrypt("secret", value); !errors.Is(err, ErrInvalidEncryptedData) {
+			t.Errorf(`Decrypting %q should fail, got %v`, value, err)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package crypto // import "miniflux.app/v2/internal/crypto"
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// envelopeValuePrefix identifies values encrypted with a data key, followed by the key ID.
+const envelopeValuePrefix = "enc:v2:"
+
+const dataKeySize = 32
+
+var ErrUnknownDataKey = errors.New("crypto: unknown data encryption key")
+
+// GenerateDataKey returns a random AES-256 data encryption key.
+func GenerateDataKey() ([]byte, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	return dataKey, nil
+}
+
+// WrapDataKey encrypts a data key with the master key.
+func WrapDataKey(masterKey string, dataKey []byte) (string, error) {
+	return Encrypt(masterKey, base64.RawStdEncoding.EncodeToString(dataKey))
+}
+
+// UnwrapDataKey decrypts a data key encrypted with WrapDataKey.
+func UnwrapDataKey(masterKey, wrappedKey string) ([]byte, error) {
+	encodedKey, err := Decrypt(masterKey, wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey, err := base64.RawStdEncoding.DecodeString(encodedKey)
+	if err != nil || len(dataKey) != dataKeySize {
+		return nil, ErrInvalidEncryptedData
+	}
+
+	return dataKey, nil
+}
+
+// IsEncrypted returns true if the value has been encrypted by this package.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, encryptedValuePrefix) || strings.HasPrefix(value, envelopeValuePrefix)
+}
+
+// Keyring encrypts values with the active data key and decrypts values encrypted with any known data key.
+// Values encrypted directly with a master key, and values never encrypted, are also readable.
+type Keyring struct {
+	masterKeys  []string
+	dataKeys    map[int64]cipher.AEAD
+	activeKeyID int64
+}
+
+// NewKeyring returns an empty Keyring, the master keys are used to read values encrypted without data key.
+func NewKeyring(masterKeys ...string) *Keyring {
+	return &Keyring{
+		masterKeys: masterKeys,
+		dataKeys:   make(map[int64]cipher.AEAD),
+	}
+}
+
+// AddDataKey registers a data key, the active key is used to encrypt new values.
+func (k *Keyring) AddDataKey(keyID int64, dataKey []byte, active bool) error {
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+
+	k.dataKeys[keyID] = aead
+	if active {
+		k.activeKeyID = keyID
+	}
+
+	return nil
+}
+
+// HasActiveDataKey returns true if new values can be encrypted.
+func (k *Keyring) HasActiveDataKey() bool {
+	_, found := k.dataKeys[k.activeKeyID]
+	return found
+}
+
+// Encrypt encrypts the plaintext with the active data key.
+// Empty values are returned as-is.
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+
+	aead, found := k.dataKeys[k.activeKeyID]
+	if !found {
+		return "", ErrMissingEncryptionKey
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	ciphertext := aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return fmt.Sprintf("%s%d:%s", envelopeValuePrefix, k.activeKeyID, base64.RawStdEncoding.EncodeToString(ciphertext)), nil
+}
+
+// Decrypt decrypts a value, values which are not encrypted are returned as-is.
+func (k *Keyring) Decrypt(value string) (string, error) {
+	if strings.HasPrefix(value, encryptedValuePrefix) {
+		for _, masterKey := range k.masterKeys {
+			if plaintext, err := Decrypt(masterKey, value); err == nil {
+				return plaintext, nil
+			}
+		}
+		return "", ErrInvalidEncryptedData
+	}
+
+	payload, found := strings.CutPrefix(value, envelopeValuePrefix)
+	if !found {
+		return value, nil
+	}
+
+	encodedKeyID, encoded, found := strings.Cut(payload, ":")
+	if !found {
+		return "", ErrInvalidEncryptedData
+	}
+
+	keyID, err := strconv.ParseInt(encodedKeyID, 10, 64)
+	if err != nil {
+		return "", ErrInvalidEncryptedData
+	}
+
+	aead, found := k.dataKeys[keyID]
+	if !found {
+		return "", ErrUnknownDataKey
+	}
+
+	data, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil || len(data) < aead.NonceSize() {
+		return "", ErrInvalidEncryptedData
+	}
+
+	plaintext, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], nil)
+	if err != nil {
+		return "", ErrInvalidEncryptedData
+	}
+
+	return string(plaintext), nil
+}
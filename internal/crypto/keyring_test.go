@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package crypto // import "miniflux.app/v2/internal/crypto"
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestWrapDataKey(t *testing.T) {
+	dataKey, err := GenerateDataKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wrappedKey, err := WrapDataKey("master", dataKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unwrappedKey, err := UnwrapDataKey("master", wrappedKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(dataKey, unwrappedKey) {
+		t.Fatal(`The unwrapped key should be the original data key`)
+	}
+
+	if _, err := UnwrapDataKey("another master", wrappedKey); !errors.Is(err, ErrInvalidEncryptedData) {
+		t.Fatalf(`Unwrapping with another master key should fail, got %v`, err)
+	}
+}
+
+func TestKeyringEncryptDecrypt(t *testing.T) {
+	oldKey, _ := GenerateDataKey()
+	newKey, _ := GenerateDataKey()
+
+	keyring := NewKeyring("master")
+	if err := keyring.AddDataKey(1, oldKey, true); err != nil {
+		t.Fatal(err)
+	}
+
+	oldValue, err := keyring.Encrypt("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(oldValue, envelopeValuePrefix+"1:") || !IsEncrypted(oldValue) {
+		t.Fatalf(`Unexpected encrypted value: %q`, oldValue)
+	}
+
+	if err := keyring.AddDataKey(2, newKey, true); err != nil {
+		t.Fatal(err)
+	}
+
+	newValue, err := keyring.Encrypt("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(newValue, envelopeValuePrefix+"2:") {
+		t.Fatalf(`New values should be encrypted with the active key, got %q`, newValue)
+	}
+
+	legacyValue, _ := Encrypt("master", "password")
+
+	for _, value := range []string{oldValue, newValue, legacyValue, "password"} {
+		if plaintext, err := keyring.Decrypt(value); err != nil || plaintext != "password" {
+			t.Errorf(`Unable to decrypt %q, got %q (%v)`, value, plaintext, err)
+		}
+	}
+}
+
+func TestKeyringDecryptInvalidValue(t *testing.T) {
+	keyring := NewKeyring("master")
+	if _, err := keyring.Encrypt("value"); !errors.Is(err, ErrMissingEncryptionKey) {
+		t.Fatalf(`Encrypting without data key should fail, got %v`, err)
+	}
+
+	if _, err := keyring.Decrypt(envelopeValuePrefix + "3:YWJj"); !errors.Is(err, ErrUnknownDataKey) {
+		t.Fatalf(`Decrypting with an unknown key should fail, got %v`, err)
+	}
+
+	legacyValue, _ := Encrypt("another master", "value")
+	for _, value := range []string{legacyValue, envelopeValuePrefix + "abc", envelopeValuePrefix + "x:YWJj"} {
+		if _, err := keyring.Decrypt(value); !errors.Is(err, ErrInvalidEncryptedData) {
+			t.Errorf(`Decrypting %q should fail, got %v`, value, err)
+		}
+	}
+}
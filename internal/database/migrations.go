@@ -1151,4 +1151,340 @@ var migrations = []func(tx *sql.Tx) error{
 		_, err = tx.Exec(sql)
 		return err
 	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE entries ADD COLUMN thumbnail_url text not null default '';
+			ALTER TABLE users ADD COLUMN entry_list_layout text not null default 'list';
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`ALTER TABLE entries ADD COLUMN language text not null default ''`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		// Entries without a detected language are indexed without stemming, like new entries in textSearchConfiguration.
+		sql := `
+			ALTER TABLE entries ADD COLUMN search_configuration text not null default 'simple';
+
+			UPDATE
+				entries
+			SET
+				search_configuration = CASE language
+					WHEN 'da' THEN 'danish'
+					WHEN 'de' THEN 'german'
+					WHEN 'en' THEN 'english'
+					WHEN 'es' THEN 'spanish'
+					WHEN 'fi' THEN 'finnish'
+					WHEN 'fr' THEN 'french'
+					WHEN 'hu' THEN 'hungarian'
+					WHEN 'it' THEN 'italian'
+					WHEN 'nb' THEN 'norwegian'
+					WHEN 'nl' THEN 'dutch'
+					WHEN 'nn' THEN 'norwegian'
+					WHEN 'no' THEN 'norwegian'
+					WHEN 'pt' THEN 'portuguese'
+					WHEN 'ro' THEN 'romanian'
+					WHEN 'ru' THEN 'russian'
+					WHEN 'sv' THEN 'swedish'
+					WHEN 'tr' THEN 'turkish'
+					ELSE 'simple'
+				END;
+
+			UPDATE
+				entries
+			SET
+				document_vectors = setweight(to_tsvector(search_configuration::regconfig, substring(coalesce(title, '') for 200000)), 'A') ||
+					setweight(to_tsvector(search_configuration::regconfig, substring(coalesce(content, '') for 500000)), 'B')
+			WHERE
+				document_vectors IS NOT NULL;
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`ALTER TABLE entries ADD COLUMN summary text not null default ''`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE integrations
+				ADD COLUMN llm_enabled bool default 'f',
+				ADD COLUMN llm_url text default '',
+				ADD COLUMN llm_api_key text default '',
+				ADD COLUMN llm_model text default '',
+				ADD COLUMN llm_summarize bool default 't',
+				ADD COLUMN llm_tagging bool default 'f';
+			ALTER TABLE feeds ADD COLUMN llm_processing bool default 'f';
+			ALTER TABLE entries
+				ADD COLUMN llm_cache_key text not null default '',
+				ADD COLUMN llm_summary text not null default '',
+				ADD COLUMN llm_tags text[] not null default '{}';
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE categories ADD COLUMN parent_id int REFERENCES categories(id) ON DELETE SET NULL;
+			CREATE INDEX categories_parent_id_idx ON categories(parent_id);
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			CREATE TABLE labels (
+				id bigserial not null,
+				user_id int not null,
+				title text not null,
+				primary key (id),
+				unique (user_id, title),
+				foreign key (user_id) references users(id) on delete cascade
+			);
+			CREATE TABLE entry_labels (
+				entry_id bigint not null,
+				label_id bigint not null,
+				created_at timestamp with time zone not null default now(),
+				primary key (entry_id, label_id),
+				foreign key (entry_id) references entries(id) on delete cascade,
+				foreign key (label_id) references labels(id) on delete cascade
+			);
+			CREATE INDEX entry_labels_label_id_idx ON entry_labels(label_id);
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			CREATE TABLE entry_highlights (
+				id bigserial not null,
+				user_id int not null,
+				entry_id bigint not null,
+				quote text not null,
+				note text not null default '',
+				start_offset int not null default 0,
+				end_offset int not null default 0,
+				created_at timestamp with time zone not null default now(),
+				changed_at timestamp with time zone not null default now(),
+				primary key (id),
+				foreign key (user_id) references users(id) on delete cascade,
+				foreign key (entry_id) references entries(id) on delete cascade
+			);
+			CREATE INDEX entry_highlights_entry_id_idx ON entry_highlights(entry_id);
+			CREATE INDEX entry_highlights_user_id_created_at_idx ON entry_highlights(user_id, created_at);
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE entries ADD COLUMN read_later bool not null default false;
+			CREATE INDEX entries_user_read_later_idx ON entries(user_id) WHERE read_later is true;
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE entries ADD COLUMN snoozed_until timestamp with time zone;
+			CREATE INDEX entries_snoozed_until_idx ON entries(snoozed_until) WHERE snoozed_until is not null;
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE feeds ADD COLUMN paused_until timestamp with time zone;
+			ALTER TABLE feeds ADD COLUMN hide_entries_while_paused bool not null default false;
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE users ADD COLUMN retention_policy text not null default '';
+			ALTER TABLE users ADD COLUMN retention_value int not null default 0;
+			ALTER TABLE categories ADD COLUMN retention_policy text not null default '';
+			ALTER TABLE categories ADD COLUMN retention_value int not null default 0;
+			ALTER TABLE feeds ADD COLUMN retention_policy text not null default '';
+			ALTER TABLE feeds ADD COLUMN retention_value int not null default 0;
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE categories
+				ADD COLUMN scraper_rules text not null default '',
+				ADD COLUMN rewrite_rules text not null default '',
+				ADD COLUMN url_rewrite_rules text not null default '',
+				ADD COLUMN blocklist_rules text not null default '',
+				ADD COLUMN keeplist_rules text not null default '',
+				ADD COLUMN block_filter_entry_rules text not null default '',
+				ADD COLUMN keep_filter_entry_rules text not null default '',
+				ADD COLUMN user_agent text not null default '',
+				ADD COLUMN cookie text not null default '',
+				ADD COLUMN proxy_url text not null default '',
+				ADD COLUMN fetch_via_proxy bool not null default false,
+				ADD COLUMN crawler bool not null default false,
+				ADD COLUMN apprise_service_urls text not null default '',
+				ADD COLUMN webhook_url text not null default '',
+				ADD COLUMN ntfy_enabled bool not null default false,
+				ADD COLUMN ntfy_priority int not null default 3,
+				ADD COLUMN ntfy_topic text not null default '',
+				ADD COLUMN pushover_enabled bool not null default false,
+				ADD COLUMN pushover_priority int not null default 0
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`ALTER TABLE feeds ADD COLUMN http_headers text not null default ''`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE feeds
+				ADD COLUMN client_certificate text not null default '',
+				ADD COLUMN client_key text not null default '',
+				ADD COLUMN ca_certificates text not null default ''
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`ALTER TABLE feeds ADD COLUMN proxy_pool text not null default ''`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			ALTER TABLE api_keys
+				ADD COLUMN token_hash text not null default '',
+				ADD COLUMN fever_token_hash text not null default '',
+				ADD COLUMN scopes text[] not null default '{full}',
+				ADD COLUMN expires_at timestamp with time zone
+		`)
+		if err != nil {
+			return err
+		}
+
+		// Same hexadecimal SHA-256 digest as crypto.SHA256.
+		_, err = tx.Exec(`
+			UPDATE api_keys SET token_hash=encode(sha256(convert_to(token, 'UTF8')), 'hex');
+			ALTER TABLE api_keys DROP COLUMN token;
+			CREATE UNIQUE INDEX api_keys_token_hash_idx ON api_keys(token_hash);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			CREATE TABLE encryption_keys (
+				id bigserial not null,
+				wrapped_key text not null,
+				is_active bool not null default 'f',
+				created_at timestamp with time zone not null default now(),
+				primary key (id)
+			);
+			CREATE UNIQUE INDEX encryption_keys_active_idx ON encryption_keys(is_active) WHERE is_active;
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE users ADD COLUMN totp_enabled bool not null default 'f';
+			ALTER TABLE users ADD COLUMN totp_required bool not null default 'f';
+			ALTER TABLE users ADD COLUMN totp_secret text not null default '';
+			ALTER TABLE users ADD COLUMN totp_recovery_codes text[] not null default '{}';
+			ALTER TABLE users ADD COLUMN totp_last_used_step bigint not null default 0;
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			CREATE TABLE audit_logs (
+				id bigserial not null,
+				actor_id bigint not null default 0,
+				actor_username text not null default '',
+				action text not null,
+				target text not null default '',
+				client_ip text not null default '',
+				created_at timestamp with time zone not null default now(),
+				primary key (id)
+			);
+			CREATE INDEX audit_logs_created_at_idx ON audit_logs(created_at);
+			CREATE INDEX audit_logs_action_idx ON audit_logs(action);
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			CREATE TABLE invitations (
+				id bigserial not null,
+				token_hash text not null,
+				description text not null default '',
+				language text not null default '',
+				categories text[] not null default '{}',
+				max_uses int not null default 1,
+				use_count int not null default 0,
+				expires_at timestamp with time zone,
+				created_at timestamp with time zone not null default now(),
+				primary key (id)
+			);
+			CREATE UNIQUE INDEX invitations_token_hash_idx ON invitations(token_hash);
+
+			CREATE TABLE registration_requests (
+				id bigserial not null,
+				username text not null,
+				password text not null,
+				created_at timestamp with time zone not null default now(),
+				primary key (id)
+			);
+			CREATE UNIQUE INDEX registration_requests_username_idx ON registration_requests(lower(username));
+
+			CREATE TABLE registration_settings (
+				id int not null default 1 check (id = 1),
+				open_registration bool not null default 'f',
+				primary key (id)
+			);
+			INSERT INTO registration_settings DEFAULT VALUES;
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		// A NULL flag inherits the category setting, false explicitly disables it for the feed.
+		sql := `
+			ALTER TABLE feeds
+				ALTER COLUMN crawler DROP DEFAULT,
+				ALTER COLUMN fetch_via_proxy DROP DEFAULT,
+				ALTER COLUMN ntfy_enabled DROP DEFAULT,
+				ALTER COLUMN pushover_enabled DROP DEFAULT;
+
+			UPDATE feeds SET crawler=NULL WHERE crawler='f';
+			UPDATE feeds SET fetch_via_proxy=NULL WHERE fetch_via_proxy='f';
+			UPDATE feeds SET ntfy_enabled=NULL WHERE ntfy_enabled='f';
+			UPDATE feeds SET pushover_enabled=NULL WHERE pushover_enabled='f';
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		// Accounts created or authenticated through LDAP only use their local password when the fallback is enabled.
+		_, err = tx.Exec(`ALTER TABLE users ADD COLUMN ldap_provisioned bool not null default 'f'`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		// Subcategories of different parents can have the same title.
+		sql := `
+			ALTER TABLE categories DROP CONSTRAINT categories_user_id_title_key;
+			CREATE UNIQUE INDEX categories_user_id_parent_id_title_idx ON categories(user_id, COALESCE(parent_id, 0), title);
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
 }
@@ -421,6 +421,7 @@ func (h *handler) handleWriteItems(w http.ResponseWriter, r *http.Request) {
 	builder := h.store.NewEntryQueryBuilder(userID)
 	builder.WithEntryID(entryID)
 	builder.WithoutStatus(model.EntryStatusRemoved)
+	builder.WithHighlights()
 
 	entry, err := builder.GetEntry()
 	if err != nil {
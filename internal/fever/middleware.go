@@ -10,6 +10,8 @@ import (
 
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response/json"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ratelimit"
 	"miniflux.app/v2/internal/storage"
 )
 
@@ -35,7 +37,21 @@ func (m *middleware) serve(next http.Handler) http.Handler {
 			return
 		}
 
+		limiter := ratelimit.AuthenticationLimiterInstance
+		if !limiter.Allow(clientIP, "") {
+			slog.Warn("[Fever] Authentication attempt refused because of too many failed authentication attempts",
+				slog.Bool("authentication_failed", true),
+				slog.String("client_ip", clientIP),
+				slog.String("user_agent", r.UserAgent()),
+			)
+			json.OK(w, r, newAuthFailureResponse())
+			return
+		}
+
 		user, err := m.store.UserByFeverToken(apiKey)
+		if err == nil && user == nil {
+			user, err = m.userByAPIKey(apiKey)
+		}
 		if err != nil {
 			slog.Error("[Fever] Unable to fetch user by API key",
 				slog.Bool("authentication_failed", true),
@@ -48,6 +64,7 @@ func (m *middleware) serve(next http.Handler) http.Handler {
 		}
 
 		if user == nil {
+			limiter.RecordFailure(ratelimit.MethodFever, clientIP, "")
 			slog.Warn("[Fever] No user found with the API key provided",
 				slog.Bool("authentication_failed", true),
 				slog.String("client_ip", clientIP),
@@ -76,3 +93,21 @@ func (m *middleware) serve(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+// userByAPIKey returns the owner of the API key matching the Fever token, if the key is valid for the Fever API.
+func (m *middleware) userByAPIKey(feverToken string) (*model.User, error) {
+	apiKey, err := m.store.APIKeyByFeverToken(feverToken)
+	if err != nil || apiKey == nil {
+		return nil, err
+	}
+
+	if apiKey.IsExpired() || !apiKey.Allows(model.APIKeyScopeFever) {
+		return nil, nil
+	}
+
+	if err := m.store.SetAPIKeyUsedTimestamp(apiKey.UserID, apiKey.ID); err != nil {
+		return nil, err
+	}
+
+	return m.store.UserByID(apiKey.UserID)
+}
@@ -4,11 +4,14 @@
 package googlereader // import "miniflux.app/v2/internal/googlereader"
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
+	"html"
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"miniflux.app/v2/internal/config"
@@ -17,9 +20,11 @@ import (
 	"miniflux.app/v2/internal/http/response/json"
 	"miniflux.app/v2/internal/http/route"
 	"miniflux.app/v2/internal/integration"
+	"miniflux.app/v2/internal/ldap"
 	"miniflux.app/v2/internal/mediaproxy"
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/proxyrotator"
+	"miniflux.app/v2/internal/ratelimit"
 	"miniflux.app/v2/internal/reader/fetcher"
 	mff "miniflux.app/v2/internal/reader/handler"
 	mfs "miniflux.app/v2/internal/reader/subscription"
@@ -81,8 +86,12 @@ func checkAndSimplifyTags(addTags []Stream, removeTags []Stream) (map[StreamType
 			tags[ReadStream] = false
 		case StarredStream:
 			tags[StarredStream] = true
+		case ReadLaterStream:
+			tags[ReadLaterStream] = true
 		case BroadcastStream, LikeStream:
 			slog.Debug("Broadcast & Like tags are not implemented!")
+		case LabelStream:
+			// User labels are applied separately by editEntryLabels.
 		default:
 			return nil, fmt.Errorf("googlereader: unsupported tag type: %s", s.Type)
 		}
@@ -104,8 +113,15 @@ func checkAndSimplifyTags(addTags []Stream, removeTags []Stream) (map[StreamType
 				return nil, fmt.Errorf("googlereader: %s should not be supplied for add and remove simultaneously", starredStreamSuffix)
 			}
 			tags[StarredStream] = false
+		case ReadLaterStream:
+			if _, ok := tags[ReadLaterStream]; ok {
+				return nil, fmt.Errorf("googlereader: %s should not be supplied for add and remove simultaneously", readLaterStreamSuffix)
+			}
+			tags[ReadLaterStream] = false
 		case BroadcastStream, LikeStream:
 			slog.Debug("Broadcast & Like tags are not implemented!")
+		case LabelStream:
+			// User labels are applied separately by editEntryLabels.
 		default:
 			return nil, fmt.Errorf("googlereader: unsupported tag type: %s", s.Type)
 		}
@@ -166,7 +182,21 @@ func (h *handler) clientLoginHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.store.GoogleReaderUserCheckPassword(username, password); err != nil {
+	limiter := ratelimit.AuthenticationLimiterInstance
+	if lockedUntil := limiter.LockedUntil(clientIP, username); !lockedUntil.IsZero() {
+		slog.Warn("[GoogleReader] Login attempt refused because of too many failed authentication attempts",
+			slog.Bool("authentication_failed", true),
+			slog.String("client_ip", clientIP),
+			slog.String("user_agent", r.UserAgent()),
+			slog.String("username", username),
+		)
+		json.TooManyRequests(w, r, time.Until(lockedUntil))
+		return
+	}
+
+	integration, token, err := h.authenticate(username, password, clientIP)
+	if err != nil {
+		limiter.RecordFailure(ratelimit.MethodGoogleReader, clientIP, username)
 		slog.Warn("[GoogleReader] Invalid username or password",
 			slog.Bool("authentication_failed", true),
 			slog.String("client_ip", clientIP),
@@ -185,15 +215,9 @@ func (h *handler) clientLoginHandler(w http.ResponseWriter, r *http.Request) {
 		slog.String("username", username),
 	)
 
-	integration, err := h.store.GoogleReaderUserGetIntegration(username)
-	if err != nil {
-		json.ServerError(w, r, err)
-		return
-	}
-
+	limiter.RecordSuccess(username)
 	h.store.SetLastLogin(integration.UserID)
 
-	token := getAuthToken(integration.GoogleReaderUsername, integration.GoogleReaderPassword)
 	slog.Debug("[GoogleReader] Created token",
 		slog.String("client_ip", clientIP),
 		slog.String("user_agent", r.UserAgent()),
@@ -212,6 +236,46 @@ func (h *handler) clientLoginHandler(w http.ResponseWriter, r *http.Request) {
 	builder.Write()
 }
 
+// authenticate accepts the Google Reader integration credentials, an API key with the Google Reader scope
+// instead of the integration password, or the directory credentials when LDAP authentication is enabled.
+// It returns the token given to the client.
+func (h *handler) authenticate(username, password, clientIP string) (*model.Integration, string, error) {
+	integration, err := h.store.GoogleReaderUserGetIntegration(username)
+	if err == nil {
+		if err = h.store.GoogleReaderUserCheckPassword(username, password); err == nil {
+			return integration, getAuthToken(integration.GoogleReaderUsername, integration.GoogleReaderPassword), nil
+		}
+
+		if validAPIKey(h.store, integration.UserID, password) {
+			return integration, integration.GoogleReaderUsername + "/" + password, nil
+		}
+	}
+
+	user, ldapErr := ldap.AuthenticatorInstance.Login(h.store, username, password, clientIP)
+	if errors.Is(ldapErr, ldap.ErrUserNotFound) {
+		return nil, "", err
+	}
+	if ldapErr != nil {
+		return nil, "", ldapErr
+	}
+
+	// The directory password is a first factor only, the integration password or an API key must be used instead.
+	if user.RequiresTOTP(config.Opts.TOTPRequired()) {
+		return nil, "", errors.New("googlereader: the directory password cannot be used when two-factor authentication is enabled or required")
+	}
+
+	integration, err = h.store.Integration(user.ID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !integration.GoogleReaderEnabled {
+		return nil, "", errors.New("googlereader: the integration is disabled")
+	}
+
+	return integration, getAuthToken(integration.GoogleReaderUsername, integration.GoogleReaderPassword), nil
+}
+
 func (h *handler) tokenHandler(w http.ResponseWriter, r *http.Request) {
 	clientIP := request.ClientIP(r)
 
@@ -305,8 +369,14 @@ func (h *handler) editTagHandler(w http.ResponseWriter, r *http.Request) {
 		slog.Any("tags", tags),
 	)
 
+	if err := h.editEntryLabels(userID, itemIDs, addTags, removeTags); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
 	builder := h.store.NewEntryQueryBuilder(userID)
 	builder.WithEntryIDs(itemIDs)
+	builder.WithHighlights()
 	builder.WithoutStatus(model.EntryStatusRemoved)
 
 	entries, err := builder.GetEntries()
@@ -320,7 +390,16 @@ func (h *handler) editTagHandler(w http.ResponseWriter, r *http.Request) {
 	unreadEntryIDs := make([]int64, 0)
 	starredEntryIDs := make([]int64, 0)
 	unstarredEntryIDs := make([]int64, 0)
+	readLaterEntryIDs := make([]int64, 0)
+	notReadLaterEntryIDs := make([]int64, 0)
 	for _, entry := range entries {
+		if readLater, exists := tags[ReadLaterStream]; exists {
+			if readLater && !entry.ReadLater {
+				readLaterEntryIDs = append(readLaterEntryIDs, entry.ID)
+			} else if !readLater && entry.ReadLater {
+				notReadLaterEntryIDs = append(notReadLaterEntryIDs, entry.ID)
+			}
+		}
 		if read, exists := tags[ReadStream]; exists {
 			if read && entry.Status == model.EntryStatusUnread {
 				readEntryIDs = append(readEntryIDs, entry.ID)
@@ -356,6 +435,20 @@ func (h *handler) editTagHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if len(readLaterEntryIDs) > 0 {
+		if err := h.store.SetEntriesReadLaterState(userID, readLaterEntryIDs, true); err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+	}
+
+	if len(notReadLaterEntryIDs) > 0 {
+		if err := h.store.SetEntriesReadLaterState(userID, notReadLaterEntryIDs, false); err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+	}
+
 	if len(unstarredEntryIDs) > 0 {
 		err = h.store.SetEntriesStarredState(userID, unstarredEntryIDs, false)
 		if err != nil {
@@ -390,6 +483,53 @@ func (h *handler) editTagHandler(w http.ResponseWriter, r *http.Request) {
 	sendOkayResponse(w)
 }
 
+// editEntryLabels attaches and detaches the user labels given as label streams.
+// Labels are created on the fly when a client adds an unknown label to an entry.
+func (h *handler) editEntryLabels(userID int64, entryIDs []int64, addTags, removeTags []Stream) error {
+	for _, stream := range addTags {
+		if stream.Type != LabelStream || stream.ID == "" {
+			continue
+		}
+
+		label, err := h.store.LabelByTitle(userID, stream.ID)
+		if err != nil {
+			return err
+		}
+
+		if label == nil {
+			label, err = h.store.CreateLabel(userID, &model.LabelCreationRequest{Title: stream.ID})
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := h.store.AddLabelToEntries(userID, label.ID, entryIDs); err != nil {
+			return err
+		}
+	}
+
+	for _, stream := range removeTags {
+		if stream.Type != LabelStream {
+			continue
+		}
+
+		label, err := h.store.LabelByTitle(userID, stream.ID)
+		if err != nil {
+			return err
+		}
+
+		if label == nil {
+			continue
+		}
+
+		if err := h.store.RemoveLabelFromEntries(userID, label.ID, entryIDs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (h *handler) quickAddHandler(w http.ResponseWriter, r *http.Request) {
 	userID := request.UserID(r)
 	clientIP := request.ClientIP(r)
@@ -469,17 +609,69 @@ func getFeed(stream Stream, store *storage.Storage, userID int64) (*model.Feed,
 	return store.FeedByID(userID, feedID)
 }
 
+// getCategoryByLabel returns the category matching the label name.
+// Subcategories are identified by their full path, e.g. "News/World", since their titles are only unique under a parent.
+func getCategoryByLabel(label string, store *storage.Storage, userID int64) (*model.Category, error) {
+	category, err := store.CategoryByTitle(userID, 0, label)
+	if err != nil || category != nil || !strings.Contains(label, model.CategoryPathSeparator) {
+		return category, err
+	}
+
+	categories, err := store.Categories(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, category := range categories {
+		if categories.PathTitle(category.ID) == label {
+			return &category, nil
+		}
+	}
+
+	return nil, nil
+}
+
 func getOrCreateCategory(streamCategory Stream, store *storage.Storage, userID int64) (*model.Category, error) {
-	switch {
-	case streamCategory.ID == "":
+	if streamCategory.ID == "" {
 		return store.FirstCategory(userID)
-	case store.CategoryTitleExists(userID, streamCategory.ID):
-		return store.CategoryByTitle(userID, streamCategory.ID)
-	default:
-		return store.CreateCategory(userID, &model.CategoryCreationRequest{
-			Title: streamCategory.ID,
-		})
 	}
+
+	category, err := getCategoryByLabel(streamCategory.ID, store, userID)
+	if err != nil || category != nil {
+		return category, err
+	}
+
+	// Each segment of the label path is a nested category.
+	for title := range strings.SplitSeq(streamCategory.ID, model.CategoryPathSeparator) {
+		if title == "" {
+			continue
+		}
+
+		parentID := int64(0)
+		if category != nil {
+			parentID = category.ID
+		}
+
+		if category, err = store.CategoryByTitle(userID, parentID, title); err != nil {
+			return nil, err
+		}
+
+		if category == nil {
+			category, err = store.CreateCategory(userID, &model.CategoryCreationRequest{
+				Title:    title,
+				ParentID: parentID,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if category == nil {
+		return store.FirstCategory(userID)
+	}
+
+	return category, nil
 }
 
 func subscribe(newFeed Stream, category Stream, title string, store *storage.Storage, userID int64) (*model.Feed, error) {
@@ -489,8 +681,10 @@ func subscribe(newFeed Stream, category Stream, title string, store *storage.Sto
 	}
 
 	feedRequest := model.FeedCreationRequest{
-		FeedURL:    newFeed.ID,
-		CategoryID: destCategory.ID,
+		FeedURL:              newFeed.ID,
+		CategoryID:           destCategory.ID,
+		InheritCrawler:       true,
+		InheritFetchViaProxy: true,
 	}
 	verr := validator.ValidateFeedCreation(store, userID, &feedRequest)
 	if verr != nil {
@@ -704,6 +898,7 @@ func (h *handler) streamItemContentsHandler(w http.ResponseWriter, r *http.Reque
 	userReadingList := fmt.Sprintf(userStreamPrefix, userID) + readingListStreamSuffix
 	userRead := fmt.Sprintf(userStreamPrefix, userID) + readStreamSuffix
 	userStarred := fmt.Sprintf(userStreamPrefix, userID) + starredStreamSuffix
+	userReadLater := fmt.Sprintf(userStreamPrefix, userID) + readLaterStreamSuffix
 
 	itemIDs, err := parseItemIDsFromRequest(r)
 	if err != nil {
@@ -731,6 +926,12 @@ func (h *handler) streamItemContentsHandler(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	categories, err := h.store.Categories(userID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
 	result := streamContentItemsResponse{
 		Direction: "ltr",
 		ID:        "user/-/state/com.google/reading-list",
@@ -748,17 +949,24 @@ func (h *handler) streamItemContentsHandler(w http.ResponseWriter, r *http.Reque
 		for _, enclosure := range entry.Enclosures {
 			enclosures = append(enclosures, contentItemEnclosure{URL: enclosure.URL, Type: enclosure.MimeType})
 		}
-		categories := make([]string, 0)
-		categories = append(categories, userReadingList)
+		entryCategories := make([]string, 0)
+		entryCategories = append(entryCategories, userReadingList)
 		if entry.Feed.Category.Title != "" {
-			categories = append(categories, fmt.Sprintf(userLabelPrefix, userID)+entry.Feed.Category.Title)
+			entryCategories = append(entryCategories, fmt.Sprintf(userLabelPrefix, userID)+cmp.Or(categories.PathTitle(entry.Feed.Category.ID), entry.Feed.Category.Title))
+		}
+		for _, label := range entry.Labels {
+			entryCategories = append(entryCategories, fmt.Sprintf(userLabelPrefix, userID)+label.Title)
 		}
 		if entry.Status == model.EntryStatusRead {
-			categories = append(categories, userRead)
+			entryCategories = append(entryCategories, userRead)
 		}
 
 		if entry.Starred {
-			categories = append(categories, userStarred)
+			entryCategories = append(entryCategories, userStarred)
+		}
+
+		if entry.ReadLater {
+			entryCategories = append(entryCategories, userReadLater)
 		}
 
 		entry.Content = mediaproxy.RewriteDocumentWithAbsoluteProxyURL(h.router, entry.Content)
@@ -772,7 +980,7 @@ func (h *handler) streamItemContentsHandler(w http.ResponseWriter, r *http.Reque
 			CrawlTimeMsec: strconv.FormatInt(entry.CreatedAt.UnixMilli(), 10),
 			Published:     entry.Date.Unix(),
 			Updated:       entry.ChangedAt.Unix(),
-			Categories:    categories,
+			Categories:    entryCategories,
 			Canonical: []contentHREF{
 				{
 					HREF: entry.URL,
@@ -788,10 +996,7 @@ func (h *handler) streamItemContentsHandler(w http.ResponseWriter, r *http.Reque
 				Direction: "ltr",
 				Content:   entry.Content,
 			},
-			Summary: contentItemContent{
-				Direction: "ltr",
-				Content:   entry.Content,
-			},
+			Summary: entrySummary(entry),
 			Origin: contentItemOrigin{
 				StreamID: fmt.Sprintf("feed/%d", entry.FeedID),
 				Title:    entry.Feed.Title,
@@ -827,19 +1032,49 @@ func (h *handler) disableTagHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	titles := make([]string, len(streams))
-	for i, stream := range streams {
+	categoryIDs := make([]int64, 0, len(streams))
+	for _, stream := range streams {
 		if stream.Type != LabelStream {
 			json.BadRequest(w, r, errors.New("googlereader: only labels are supported"))
 			return
 		}
-		titles[i] = stream.ID
+
+		category, err := getCategoryByLabel(stream.ID, h.store, userID)
+		if err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		if category != nil {
+			categoryIDs = append(categoryIDs, category.ID)
+			continue
+		}
+
+		label, err := h.store.LabelByTitle(userID, stream.ID)
+		if err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		if label == nil {
+			continue
+		}
+
+		if err := h.store.RemoveLabel(userID, label.ID); err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
 	}
 
-	err = h.store.RemoveAndReplaceCategoriesByName(userID, titles)
-	if err != nil {
-		json.ServerError(w, r, err)
-		return
+	if len(categoryIDs) > 0 {
+		if err := h.store.RemoveAndReplaceCategories(userID, categoryIDs); err != nil {
+			if errors.Is(err, storage.ErrSubcategoryTitleConflict) {
+				json.BadRequest(w, r, err)
+				return
+			}
+			json.ServerError(w, r, err)
+			return
+		}
 	}
 
 	sendOkayResponse(w)
@@ -883,7 +1118,7 @@ func (h *handler) renameTagHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	category, err := h.store.CategoryByTitle(userID, source.ID)
+	category, err := getCategoryByLabel(source.ID, h.store, userID)
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -893,11 +1128,25 @@ func (h *handler) renameTagHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The destination label is a path, the last segment is the new title and the others designate the new parent.
 	categoryModificationRequest := model.CategoryModificationRequest{
 		Title: model.SetOptionalField(destination.ID),
 	}
 
-	if validationError := validator.ValidateCategoryModification(h.store, userID, category.ID, &categoryModificationRequest); validationError != nil {
+	if index := strings.LastIndex(destination.ID, model.CategoryPathSeparator); index > 0 && !h.store.CategoryTitleExists(userID, 0, destination.ID) {
+		parent, err := getCategoryByLabel(destination.ID[:index], h.store, userID)
+		if err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		if parent != nil {
+			categoryModificationRequest.Title = model.SetOptionalField(destination.ID[index+1:])
+			categoryModificationRequest.ParentID = model.SetOptionalField(parent.ID)
+		}
+	}
+
+	if validationError := validator.ValidateCategoryModification(h.store, userID, category, &categoryModificationRequest); validationError != nil {
 		json.BadRequest(w, r, validationError.Error())
 		return
 	}
@@ -937,13 +1186,29 @@ func (h *handler) tagListHandler(w http.ResponseWriter, r *http.Request) {
 	result.Tags = append(result.Tags, subscriptionCategoryResponse{
 		ID: fmt.Sprintf(userStreamPrefix, userID) + starredStreamSuffix,
 	})
+	result.Tags = append(result.Tags, subscriptionCategoryResponse{
+		ID: fmt.Sprintf(userStreamPrefix, userID) + readLaterStreamSuffix,
+	})
 	for _, category := range categories {
 		result.Tags = append(result.Tags, subscriptionCategoryResponse{
-			ID:    fmt.Sprintf(userLabelPrefix, userID) + category.Title,
-			Label: category.Title,
+			ID:    fmt.Sprintf(userLabelPrefix, userID) + categories.PathTitle(category.ID),
+			Label: categories.PathTitle(category.ID),
 			Type:  "folder",
 		})
 	}
+
+	labels, err := h.store.Labels(userID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+	for _, label := range labels {
+		result.Tags = append(result.Tags, subscriptionCategoryResponse{
+			ID:    fmt.Sprintf(userLabelPrefix, userID) + label.Title,
+			Label: label.Title,
+			Type:  "tag",
+		})
+	}
 	json.OK(w, r, result)
 }
 
@@ -969,13 +1234,20 @@ func (h *handler) subscriptionListHandler(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	categories, err := h.store.Categories(userID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
 	result.Subscriptions = make([]subscriptionResponse, 0)
 	for _, feed := range feeds {
+		label := cmp.Or(categories.PathTitle(feed.Category.ID), feed.Category.Title)
 		result.Subscriptions = append(result.Subscriptions, subscriptionResponse{
 			ID:         fmt.Sprintf(feedPrefix+"%d", feed.ID),
 			Title:      feed.Title,
 			URL:        feed.FeedURL,
-			Categories: []subscriptionCategoryResponse{{fmt.Sprintf(userLabelPrefix, userID) + feed.Category.Title, feed.Category.Title, "folder"}},
+			Categories: []subscriptionCategoryResponse{{fmt.Sprintf(userLabelPrefix, userID) + label, label, "folder"}},
 			HTMLURL:    feed.SiteURL,
 			IconURL:    h.feedIconURL(feed),
 		})
@@ -1056,10 +1328,14 @@ func (h *handler) streamItemIDsHandler(w http.ResponseWriter, r *http.Request) {
 		h.handleReadingListStreamHandler(w, r, rm)
 	case StarredStream:
 		h.handleStarredStreamHandler(w, r, rm)
+	case ReadLaterStream:
+		h.handleReadLaterStreamHandler(w, r, rm)
 	case ReadStream:
 		h.handleReadStreamHandler(w, r, rm)
 	case FeedStream:
 		h.handleFeedStreamHandler(w, r, rm)
+	case LabelStream:
+		h.handleLabelStreamHandler(w, r, rm)
 	default:
 		slog.Warn("[GoogleReader] Unknown Stream",
 			slog.String("handler", "streamItemIDsHandler"),
@@ -1168,6 +1444,45 @@ func (h *handler) handleStarredStreamHandler(w http.ResponseWriter, r *http.Requ
 	json.OK(w, r, streamIDResponse{itemRefs, continuation})
 }
 
+// handleReadLaterStreamHandler returns the entries of the read later queue.
+func (h *handler) handleReadLaterStreamHandler(w http.ResponseWriter, r *http.Request, rm RequestModifiers) {
+	builder := h.store.NewEntryQueryBuilder(rm.UserID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+	builder.WithReadLater(true)
+	builder.WithLimit(rm.Count)
+	builder.WithOffset(rm.Offset)
+	builder.WithSorting(model.DefaultSortingOrder, rm.SortDirection)
+	if rm.StartTime > 0 {
+		builder.AfterPublishedDate(time.Unix(rm.StartTime, 0))
+	}
+	if rm.StopTime > 0 {
+		builder.BeforePublishedDate(time.Unix(rm.StopTime, 0))
+	}
+
+	rawEntryIDs, err := builder.GetEntryIDs()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+	var itemRefs = make([]itemRef, 0)
+	for _, entryID := range rawEntryIDs {
+		formattedID := strconv.FormatInt(entryID, 10)
+		itemRefs = append(itemRefs, itemRef{ID: formattedID})
+	}
+
+	totalEntries, err := builder.CountEntries()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+	continuation := 0
+	if len(itemRefs)+rm.Offset < totalEntries {
+		continuation = len(itemRefs) + rm.Offset
+	}
+
+	json.OK(w, r, streamIDResponse{itemRefs, continuation})
+}
+
 func (h *handler) handleReadStreamHandler(w http.ResponseWriter, r *http.Request, rm RequestModifiers) {
 	builder := h.store.NewEntryQueryBuilder(rm.UserID)
 	builder.WithoutStatus(model.EntryStatusRemoved)
@@ -1262,6 +1577,79 @@ func (h *handler) handleFeedStreamHandler(w http.ResponseWriter, r *http.Request
 	json.OK(w, r, streamIDResponse{itemRefs, continuation})
 }
 
+// handleLabelStreamHandler returns the entries of a category, or of a user label when no category matches.
+func (h *handler) handleLabelStreamHandler(w http.ResponseWriter, r *http.Request, rm RequestModifiers) {
+	builder := h.store.NewEntryQueryBuilder(rm.UserID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+	builder.WithLimit(rm.Count)
+	builder.WithOffset(rm.Offset)
+	builder.WithSorting(model.DefaultSortingOrder, rm.SortDirection)
+
+	category, err := getCategoryByLabel(rm.Streams[0].ID, h.store, rm.UserID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if category != nil {
+		builder.WithCategoryID(category.ID)
+	} else {
+		label, err := h.store.LabelByTitle(rm.UserID, rm.Streams[0].ID)
+		if err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		if label == nil {
+			json.OK(w, r, streamIDResponse{make([]itemRef, 0), 0})
+			return
+		}
+
+		builder.WithLabelID(label.ID)
+	}
+
+	if rm.StartTime > 0 {
+		builder.AfterPublishedDate(time.Unix(rm.StartTime, 0))
+	}
+
+	if rm.StopTime > 0 {
+		builder.BeforePublishedDate(time.Unix(rm.StopTime, 0))
+	}
+
+	if len(rm.ExcludeTargets) > 0 {
+		for _, s := range rm.ExcludeTargets {
+			if s.Type == ReadStream {
+				builder.WithoutStatus(model.EntryStatusRead)
+			}
+		}
+	}
+
+	rawEntryIDs, err := builder.GetEntryIDs()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	var itemRefs = make([]itemRef, 0)
+	for _, entryID := range rawEntryIDs {
+		formattedID := strconv.FormatInt(entryID, 10)
+		itemRefs = append(itemRefs, itemRef{ID: formattedID})
+	}
+
+	totalEntries, err := builder.CountEntries()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	continuation := 0
+	if len(itemRefs)+rm.Offset < totalEntries {
+		continuation = len(itemRefs) + rm.Offset
+	}
+
+	json.OK(w, r, streamIDResponse{itemRefs, continuation})
+}
+
 func (h *handler) markAllAsReadHandler(w http.ResponseWriter, r *http.Request) {
 	userID := request.UserID(r)
 	clientIP := request.ClientIP(r)
@@ -1318,7 +1706,7 @@ func (h *handler) markAllAsReadHandler(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	case LabelStream:
-		category, err := h.store.CategoryByTitle(userID, stream.ID)
+		category, err := getCategoryByLabel(stream.ID, h.store, userID)
 		if err != nil {
 			json.ServerError(w, r, err)
 			return
@@ -1340,3 +1728,13 @@ func (h *handler) markAllAsReadHandler(w http.ResponseWriter, r *http.Request) {
 
 	sendOkayResponse(w)
 }
+
+// entrySummary returns the plain text summary of an entry escaped as HTML, since clients render the summary as markup.
+// The entry content is used when the entry has no summary.
+func entrySummary(entry *model.Entry) contentItemContent {
+	if entry.Summary == "" {
+		return contentItemContent{Direction: "ltr", Content: entry.Content}
+	}
+
+	return contentItemContent{Direction: "ltr", Content: html.EscapeString(entry.Summary)}
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package googlereader // import "miniflux.app/v2/internal/googlereader"
+
+import (
+	"maps"
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestCheckAndSimplifyTags(t *testing.T) {
+	scenarios := []struct {
+		addTags    []Stream
+		removeTags []Stream
+		expected   map[StreamType]bool
+	}{
+		{[]Stream{{Type: KeptUnreadStream}}, nil, map[StreamType]bool{ReadStream: false}},
+		{nil, []Stream{{Type: KeptUnreadStream}}, map[StreamType]bool{ReadStream: true}},
+		{[]Stream{{Type: ReadLaterStream}}, nil, map[StreamType]bool{ReadLaterStream: true}},
+		{[]Stream{{Type: ReadStream}}, []Stream{{Type: ReadLaterStream}}, map[StreamType]bool{ReadStream: true, ReadLaterStream: false}},
+		{[]Stream{{Type: ReadLaterStream}, {Type: StarredStream}}, nil, map[StreamType]bool{ReadLaterStream: true, StarredStream: true}},
+	}
+
+	for _, scenario := range scenarios {
+		tags, err := checkAndSimplifyTags(scenario.addTags, scenario.removeTags)
+		if err != nil {
+			t.Fatalf(`Unexpected error for %v/%v: %v`, scenario.addTags, scenario.removeTags, err)
+		}
+
+		if !maps.Equal(tags, scenario.expected) {
+			t.Errorf(`Unexpected tags for %v/%v, got %v instead of %v`, scenario.addTags, scenario.removeTags, tags, scenario.expected)
+		}
+	}
+}
+
+func TestCheckAndSimplifyTagsWithConflictingReadLater(t *testing.T) {
+	if _, err := checkAndSimplifyTags([]Stream{{Type: ReadLaterStream}}, []Stream{{Type: ReadLaterStream}}); err == nil {
+		t.Error(`Adding and removing the read later state simultaneously should fail`)
+	}
+}
+
+func TestEntrySummary(t *testing.T) {
+	scenarios := []struct {
+		entry    *model.Entry
+		expected string
+	}{
+		{&model.Entry{Content: `<p>Content</p>`}, `<p>Content</p>`},
+		{&model.Entry{Content: `<p>Content</p>`, Summary: `Summary`}, `Summary`},
+		{&model.Entry{Content: `<p>Content</p>`, Summary: `<img src=x onerror=alert(1)>`}, `&lt;img src=x onerror=alert(1)&gt;`},
+	}
+
+	for _, scenario := range scenarios {
+		summary := entrySummary(scenario.entry)
+		if summary.Content != scenario.expected {
+			t.Errorf(`Unexpected summary content, got %q instead of %q`, summary.Content, scenario.expected)
+		}
+		if summary.Direction != "ltr" {
+			t.Errorf(`Unexpected summary direction, got %q`, summary.Direction)
+		}
+	}
+}
@@ -143,7 +143,7 @@ func (m *middleware) apiKeyAuth(next http.Handler) http.Handler {
 			return
 		}
 		expectedToken := getAuthToken(integration.GoogleReaderUsername, integration.GoogleReaderPassword)
-		if expectedToken != token {
+		if expectedToken != token && !validAPIKey(m.store, integration.UserID, parts[1]) {
 			slog.Warn("[GoogleReader] Token does not match",
 				slog.Bool("authentication_failed", true),
 				slog.String("client_ip", clientIP),
@@ -187,6 +187,21 @@ func (m *middleware) apiKeyAuth(next http.Handler) http.Handler {
 	})
 }
 
+// validAPIKey returns true if the token is an API key of the given user, valid for the Google Reader API.
+func validAPIKey(store *storage.Storage, userID int64, token string) bool {
+	apiKey, err := store.APIKeyByToken(token)
+	if err != nil || apiKey == nil {
+		return false
+	}
+
+	if apiKey.UserID != userID || apiKey.IsExpired() || !apiKey.Allows(model.APIKeyScopeGoogleReader) {
+		return false
+	}
+
+	store.SetAPIKeyUsedTimestamp(apiKey.UserID, apiKey.ID)
+	return true
+}
+
 func getAuthToken(username, password string) string {
 	token := hex.EncodeToString(hmac.New(sha1.New, []byte(username+password)).Sum(nil))
 	token = username + "/" + token
@@ -28,4 +28,6 @@ const (
 	broadcastFriendsStreamSuffix = "broadcast-friends"
 	// likeStreamSuffix is the suffix for like stream
 	likeStreamSuffix = "like"
+	// readLaterStreamSuffix is the suffix for the read later stream, a Miniflux extension
+	readLaterStreamSuffix = "read-later"
 )
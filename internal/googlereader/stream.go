@@ -31,6 +31,8 @@ const (
 	FeedStream
 	// LikeStream - like stream type
 	LikeStream
+	// ReadLaterStream - read later stream type
+	ReadLaterStream
 )
 
 // Stream defines a stream type and its ID.
@@ -65,6 +67,8 @@ func (st StreamType) String() string {
 		return "FeedStream"
 	case LikeStream:
 		return "LikeStream"
+	case ReadLaterStream:
+		return "ReadLaterStream"
 	default:
 		return st.String()
 	}
@@ -92,6 +96,8 @@ func getStream(streamID string, userID int64) (Stream, error) {
 			return Stream{BroadcastFriendsStream, ""}, nil
 		case likeStreamSuffix:
 			return Stream{LikeStream, ""}, nil
+		case readLaterStreamSuffix:
+			return Stream{ReadLaterStream, ""}, nil
 		default:
 			return Stream{NoStream, ""}, fmt.Errorf("googlereader: unknown stream with id: %s", id)
 		}
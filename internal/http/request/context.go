@@ -6,6 +6,7 @@ package request // import "miniflux.app/v2/internal/http/request"
 import (
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"miniflux.app/v2/internal/model"
@@ -34,6 +35,9 @@ const (
 	ClientIPContextKey
 	GoogleReaderTokenKey
 	WebAuthnDataContextKey
+	TOTPPendingLoginContextKey
+	FeedCredentialsHiddenContextKey
+	TOTPEnrollmentRequiredContextKey
 )
 
 func WebAuthnSessionData(r *http.Request) *model.WebAuthnSession {
@@ -50,6 +54,16 @@ func GoogleReaderToken(r *http.Request) string {
 	return getContextStringValue(r, GoogleReaderTokenKey)
 }
 
+// FeedCredentialsHidden returns true if the API key used for the request is not allowed to read feed credentials.
+func FeedCredentialsHidden(r *http.Request) bool {
+	return getContextBoolValue(r, FeedCredentialsHiddenContextKey)
+}
+
+// TOTPEnrollmentRequired returns true if the logged user has to configure two-factor authentication.
+func TOTPEnrollmentRequired(r *http.Request) bool {
+	return getContextBoolValue(r, TOTPEnrollmentRequiredContextKey)
+}
+
 // IsAdminUser checks if the logged user is administrator.
 func IsAdminUser(r *http.Request) bool {
 	return getContextBoolValue(r, IsAdminUserContextKey)
@@ -145,6 +159,26 @@ func LastForceRefresh(r *http.Request) time.Time {
 	return time.Unix(timestamp, 0)
 }
 
+// TOTPPendingLogin returns the user waiting for the second authentication step, and when the password has been checked.
+func TOTPPendingLogin(r *http.Request) (int64, time.Time) {
+	userIDValue, timestampValue, found := strings.Cut(getContextStringValue(r, TOTPPendingLoginContextKey), ":")
+	if !found {
+		return 0, time.Time{}
+	}
+
+	userID, err := strconv.ParseInt(userIDValue, 10, 64)
+	if err != nil {
+		return 0, time.Time{}
+	}
+
+	timestamp, err := strconv.ParseInt(timestampValue, 10, 64)
+	if err != nil {
+		return 0, time.Time{}
+	}
+
+	return userID, time.Unix(timestamp, 0)
+}
+
 // ClientIP returns the client IP address stored in the context.
 func ClientIP(r *http.Request) string {
 	return getContextStringValue(r, ClientIPContextKey)
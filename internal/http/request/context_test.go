@@ -411,3 +411,20 @@ func TestClientIP(t *testing.T) {
 		t.Errorf(`Unexpected context value, got %q instead of %q`, result, expected)
 	}
 }
+
+func TestTOTPPendingLogin(t *testing.T) {
+	r, _ := http.NewRequest("GET", "http://example.org", nil)
+	r = r.WithContext(context.WithValue(r.Context(), TOTPPendingLoginContextKey, "42:1700000000"))
+
+	userID, createdAt := TOTPPendingLogin(r)
+	if userID != 42 || createdAt.Unix() != 1700000000 {
+		t.Errorf(`Unexpected pending login, got %d at %v`, userID, createdAt)
+	}
+
+	for _, value := range []string{"", "42", "abc:1700000000", "42:abc"} {
+		r = r.WithContext(context.WithValue(r.Context(), TOTPPendingLoginContextKey, value))
+		if userID, _ := TOTPPendingLogin(r); userID != 0 {
+			t.Errorf(`The value %q should not be a pending login, got user %d`, value, userID)
+		}
+	}
+}
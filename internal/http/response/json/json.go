@@ -7,7 +7,10 @@ import (
 	"encoding/json"
 	"errors"
 	"log/slog"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response"
@@ -117,6 +120,34 @@ func BadRequest(w http.ResponseWriter, r *http.Request, err error) {
 	builder.Write()
 }
 
+// BadRequestWithBody sends a bad request error to the client with a custom body, for example to detail validation errors.
+func BadRequestWithBody(w http.ResponseWriter, r *http.Request, err error, body any) {
+	responseBody, jsonErr := json.Marshal(body)
+	if jsonErr != nil {
+		ServerError(w, r, jsonErr)
+		return
+	}
+
+	slog.Warn(http.StatusText(http.StatusBadRequest),
+		slog.Any("error", err),
+		slog.String("client_ip", request.ClientIP(r)),
+		slog.Group("request",
+			slog.String("method", r.Method),
+			slog.String("uri", r.RequestURI),
+			slog.String("user_agent", r.UserAgent()),
+		),
+		slog.Group("response",
+			slog.Int("status_code", http.StatusBadRequest),
+		),
+	)
+
+	builder := response.New(w, r)
+	builder.WithStatus(http.StatusBadRequest)
+	builder.WithHeader("Content-Type", contentTypeHeader)
+	builder.WithBody(responseBody)
+	builder.Write()
+}
+
 // Unauthorized sends a not authorized error to the client.
 func Unauthorized(w http.ResponseWriter, r *http.Request) {
 	slog.Warn(http.StatusText(http.StatusUnauthorized),
@@ -201,6 +232,35 @@ func NotFound(w http.ResponseWriter, r *http.Request) {
 	builder.Write()
 }
 
+// TooManyRequests sends a too many requests error to the client with the number of seconds to wait.
+func TooManyRequests(w http.ResponseWriter, r *http.Request, retryAfter time.Duration) {
+	slog.Warn(http.StatusText(http.StatusTooManyRequests),
+		slog.String("client_ip", request.ClientIP(r)),
+		slog.Group("request",
+			slog.String("method", r.Method),
+			slog.String("uri", r.RequestURI),
+			slog.String("user_agent", r.UserAgent()),
+		),
+		slog.Group("response",
+			slog.Int("status_code", http.StatusTooManyRequests),
+		),
+	)
+
+	responseBody, jsonErr := generateJSONError(errors.New("too many requests"))
+	if jsonErr != nil {
+		slog.Error("Unable to generate JSON error", slog.Any("error", jsonErr))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	builder := response.New(w, r)
+	builder.WithStatus(http.StatusTooManyRequests)
+	builder.WithHeader("Content-Type", contentTypeHeader)
+	builder.WithHeader("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	builder.WithBody(responseBody)
+	builder.Write()
+}
+
 func generateJSONError(err error) ([]byte, error) {
 	type errorMsg struct {
 		ErrorMessage string `json:"error_message"`
@@ -142,7 +142,7 @@ func SendEntry(entry *model.Entry, userIntegrations *model.Integration) {
 			userIntegrations.NotionToken,
 			userIntegrations.NotionPageID,
 		)
-		if err := client.UpdateDocument(entry.URL, entry.Title); err != nil {
+		if err := client.UpdateDocument(entry.URL, entry.Title, entry.Highlights); err != nil {
 			slog.Error("Unable to send entry to Notion",
 				slog.Int64("user_id", userIntegrations.UserID),
 				slog.Int64("entry_id", entry.ID),
@@ -329,6 +329,15 @@ func SendEntry(entry *model.Entry, userIntegrations *model.Integration) {
 				slog.Any("error", err),
 			)
 		}
+
+		if err := client.CreateHighlights(entry); err != nil {
+			slog.Error("Unable to send entry highlights to Readwise",
+				slog.Int64("user_id", userIntegrations.UserID),
+				slog.Int64("entry_id", entry.ID),
+				slog.String("entry_url", entry.URL),
+				slog.Any("error", err),
+			)
+		}
 	}
 
 	if userIntegrations.CuboxEnabled {
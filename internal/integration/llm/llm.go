@@ -0,0 +1,264 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package llm summarizes and tags entries with a language model exposed through an OpenAI-compatible
+// chat completions endpoint (OpenAI, Ollama, llama.cpp, vLLM, etc.).
+package llm // import "miniflux.app/v2/internal/integration/llm"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/version"
+)
+
+const (
+	// maxInputLength is the number of characters of the article sent to the model.
+	maxInputLength = 8000
+
+	// maxTags is the maximum number of tags kept from the model response.
+	maxTags = 5
+
+	// maxTagLength is the maximum number of characters of a tag.
+	maxTagLength = 40
+
+	// unavailabilityPeriod is the time during which an endpoint is not called after a failure.
+	unavailabilityPeriod = 5 * time.Minute
+)
+
+// ErrEndpointUnavailable is returned when the endpoint failed recently and is not called again yet.
+var ErrEndpointUnavailable = errors.New("llm: the endpoint is temporarily unavailable")
+
+var (
+	semaphoreOnce sync.Once
+	semaphore     chan struct{}
+
+	// unavailableEndpoints is keyed by endpoint URL and API key, a key rejected by the endpoint
+	// must not prevent the other users of the same endpoint from calling it.
+	unavailableEndpoints sync.Map
+)
+
+// serverError is returned when the endpoint cannot be reached or answers with a server error.
+// Only these errors make the endpoint unavailable, client errors depend on the request or the API key.
+type serverError struct {
+	err error
+}
+
+func (e *serverError) Error() string { return e.err.Error() }
+func (e *serverError) Unwrap() error { return e.err }
+
+// Result is the output of the language model for one entry.
+type Result struct {
+	Summary string
+	Tags    []string
+}
+
+type Client struct {
+	endpointURL string
+	apiKey      string
+	model       string
+	summarize   bool
+	tagging     bool
+}
+
+func NewClient(endpointURL, apiKey, model string, summarize, tagging bool) *Client {
+	return &Client{endpointURL: endpointURL, apiKey: apiKey, model: model, summarize: summarize, tagging: tagging}
+}
+
+// CacheKey returns a key identifying the result of the model for the given entry title and content.
+// The result of a previous call can be reused as long as the key is the same.
+func (c *Client) CacheKey(title, content string) string {
+	return crypto.SHA256(fmt.Sprintf("%s\x00%s\x00%t\x00%t\x00%s\x00%s", c.endpointURL, c.model, c.summarize, c.tagging, title, content))
+}
+
+// Analyze asks the model to summarize and/or tag the given entry. The content must be plain text.
+// Waiting for a free slot and the request itself are cancelled with the context.
+func (c *Client) Analyze(ctx context.Context, title, content string) (*Result, error) {
+	if c.endpointURL == "" || c.model == "" {
+		return nil, errors.New("llm: missing endpoint URL or model")
+	}
+
+	if !c.summarize && !c.tagging {
+		return &Result{}, nil
+	}
+
+	if err := acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// The check happens after waiting for a slot to skip the requests queued while the endpoint was failing.
+	availabilityKey := c.endpointURL + "\x00" + c.apiKey
+	if until, found := unavailableEndpoints.Load(availabilityKey); found && time.Now().Before(until.(time.Time)) {
+		return nil, ErrEndpointUnavailable
+	}
+
+	result, err := c.sendRequest(ctx, title, content)
+	if err != nil {
+		// A request cancelled by the caller says nothing about the endpoint.
+		var srvErr *serverError
+		if errors.As(err, &srvErr) && ctx.Err() == nil {
+			unavailableEndpoints.Store(availabilityKey, time.Now().Add(unavailabilityPeriod))
+		}
+		return nil, err
+	}
+
+	unavailableEndpoints.Delete(availabilityKey)
+	return result, nil
+}
+
+func (c *Client) sendRequest(ctx context.Context, title, content string) (*Result, error) {
+	if utf8.RuneCountInString(content) > maxInputLength {
+		content = string([]rune(content)[:maxInputLength])
+	}
+
+	requestBody, err := json.Marshal(&chatCompletionRequest{
+		Model: c.model,
+		Messages: []chatMessage{
+			{Role: "system", Content: c.instructions()},
+			{Role: "user", Content: "Title: " + title + "\n\n" + content},
+		},
+		ResponseFormat: &responseFormat{Type: "json_object"},
+		Temperature:    0.2,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("llm: unable to encode request body: %v", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpointURL, bytes.NewReader(requestBody))
+	if err != nil {
+		return nil, fmt.Errorf("llm: unable to create request: %v", err)
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("User-Agent", "Miniflux/"+version.Version)
+	if c.apiKey != "" {
+		request.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	httpClient := &http.Client{Timeout: config.Opts.LLMClientTimeout()}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, &serverError{fmt.Errorf("llm: unable to send request: %v", err)}
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 500 {
+		return nil, &serverError{fmt.Errorf("llm: unable to get completion: url=%s status=%d", c.endpointURL, response.StatusCode)}
+	}
+
+	if response.StatusCode >= 400 {
+		return nil, fmt.Errorf("llm: unable to get completion: url=%s status=%d", c.endpointURL, response.StatusCode)
+	}
+
+	var completion chatCompletionResponse
+	if err := json.NewDecoder(response.Body).Decode(&completion); err != nil {
+		return nil, fmt.Errorf("llm: unable to decode response: %v", err)
+	}
+
+	if len(completion.Choices) == 0 {
+		return nil, errors.New("llm: the response does not contain any choice")
+	}
+
+	return parseResult(completion.Choices[0].Message.Content, c.summarize, c.tagging)
+}
+
+func (c *Client) instructions() string {
+	var tasks []string
+	if c.summarize {
+		tasks = append(tasks, `"summary": a neutral summary of the article in at most three sentences, written in the language of the article`)
+	}
+	if c.tagging {
+		tasks = append(tasks, fmt.Sprintf(`"tags": a list of at most %d short lowercase topics describing the article`, maxTags))
+	}
+
+	return "You help readers triage their feeds. Read the article sent by the user and reply only with a JSON object containing:\n- " +
+		strings.Join(tasks, "\n- ")
+}
+
+func parseResult(content string, summarize, tagging bool) (*Result, error) {
+	// Some models wrap the JSON document in a Markdown code block.
+	content = strings.TrimSpace(content)
+	content = strings.TrimPrefix(content, "```json")
+	content = strings.TrimPrefix(content, "```")
+	content = strings.TrimSuffix(content, "```")
+
+	var output struct {
+		Summary string   `json:"summary"`
+		Tags    []string `json:"tags"`
+	}
+	if err := json.Unmarshal([]byte(content), &output); err != nil {
+		return nil, fmt.Errorf("llm: the completion is not a valid JSON object: %v", err)
+	}
+
+	result := &Result{}
+	if summarize {
+		result.Summary = strings.TrimSpace(output.Summary)
+	}
+
+	if tagging {
+		for _, tag := range output.Tags {
+			tag = strings.ToLower(strings.TrimSpace(tag))
+			if tag == "" || utf8.RuneCountInString(tag) > maxTagLength || slices.Contains(result.Tags, tag) {
+				continue
+			}
+			result.Tags = append(result.Tags, tag)
+			if len(result.Tags) == maxTags {
+				break
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func acquire(ctx context.Context) error {
+	semaphoreOnce.Do(func() {
+		semaphore = make(chan struct{}, max(config.Opts.LLMMaxConcurrentRequests(), 1))
+	})
+
+	select {
+	case semaphore <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func release() {
+	<-semaphore
+}
+
+type chatCompletionRequest struct {
+	Model          string          `json:"model"`
+	Messages       []chatMessage   `json:"messages"`
+	ResponseFormat *responseFormat `json:"response_format,omitempty"`
+	Temperature    float64         `json:"temperature"`
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type responseFormat struct {
+	Type string `json:"type"`
+}
+
+type chatCompletionResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+}
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package llm // import "miniflux.app/v2/internal/integration/llm"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+)
+
+func TestAnalyze(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			t.Errorf(`Unexpected Authorization header: %q`, r.Header.Get("Authorization"))
+		}
+
+		var request chatCompletionRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatalf(`Unable to decode request: %v`, err)
+		}
+
+		if request.Model != "llama3" || len(request.Messages) != 2 {
+			t.Errorf(`Unexpected request: %+v`, request)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"` +
+			"```json\\n{\\\"summary\\\": \\\" A short summary. \\\", \\\"tags\\\": [\\\"Go\\\", \\\"go\\\", \\\"\\\", \\\"Databases\\\"]}\\n```" +
+			`"}}]}`))
+	}))
+	defer server.Close()
+
+	result, err := NewClient(server.URL, "secret", "llama3", true, true).Analyze(context.Background(), "Title", "Content")
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if result.Summary != "A short summary." {
+		t.Errorf(`Unexpected summary: %q`, result.Summary)
+	}
+
+	if len(result.Tags) != 2 || result.Tags[0] != "go" || result.Tags[1] != "databases" {
+		t.Errorf(`Unexpected tags: %q`, result.Tags)
+	}
+}
+
+func TestAnalyzeWithUnavailableEndpoint(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "", "llama3", true, false)
+	if _, err := client.Analyze(context.Background(), "Title", "Content"); err == nil {
+		t.Fatal(`An error should be returned when the endpoint fails`)
+	}
+
+	if _, err := client.Analyze(context.Background(), "Title", "Content"); !errors.Is(err, ErrEndpointUnavailable) {
+		t.Fatalf(`The endpoint should not be called again after a failure, got %v`, err)
+	}
+
+	if calls != 1 {
+		t.Errorf(`The endpoint was called %d times instead of once`, calls)
+	}
+}
+
+func TestAnalyzeWithRejectedAPIKey(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer valid" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]any{
+			"choices": []map[string]any{
+				{"message": map[string]string{"content": `{"summary": "Summary"}`}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	invalidClient := NewClient(server.URL, "invalid", "llama3", true, false)
+	for range 2 {
+		if _, err := invalidClient.Analyze(context.Background(), "Title", "Content"); err == nil || errors.Is(err, ErrEndpointUnavailable) {
+			t.Fatalf(`A rejected API key should not make the endpoint unavailable, got %v`, err)
+		}
+	}
+
+	validClient := NewClient(server.URL, "valid", "llama3", true, false)
+	if _, err := validClient.Analyze(context.Background(), "Title", "Content"); err != nil {
+		t.Fatalf(`The endpoint should remain available for other API keys, got %v`, err)
+	}
+}
+
+func TestAnalyzeWithCancelledContext(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	// Occupy every slot to make the next call wait.
+	if err := acquire(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+	for len(semaphore) < cap(semaphore) {
+		acquire(context.Background())
+		defer release()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	client := NewClient("http://127.0.0.1:0", "", "llama3", true, false)
+	if _, err := client.Analyze(ctx, "Title", "Content"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf(`Waiting for a slot should stop with the context, got %v`, err)
+	}
+}
@@ -9,11 +9,18 @@ import (
 	"fmt"
 	"net/http"
 	"time"
+	"unicode/utf8"
 
+	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/version"
 )
 
-const defaultClientTimeout = 10 * time.Second
+const (
+	defaultClientTimeout = 10 * time.Second
+
+	// maxRichTextLength is the maximum number of characters of a rich text object accepted by Notion.
+	maxRichTextLength = 2000
+)
 
 type Client struct {
 	apiToken string
@@ -24,24 +31,41 @@ func NewClient(apiToken, pageID string) *Client {
 	return &Client{apiToken, pageID}
 }
 
-func (c *Client) UpdateDocument(entryURL string, entryTitle string) error {
+// UpdateDocument appends a bookmark to the entry to the page, followed by the highlights of the entry.
+func (c *Client) UpdateDocument(entryURL string, entryTitle string, highlights model.Highlights) error {
 	if c.apiToken == "" || c.pageID == "" {
 		return fmt.Errorf("notion: missing API token or page ID")
 	}
 
-	apiEndpoint := "https://api.notion.com/v1/blocks/" + c.pageID + "/children"
-	requestBody, err := json.Marshal(&notionDocument{
-		Children: []block{
-			{
-				Object: "block",
-				Type:   "bookmark",
-				Bookmark: bookmarkObject{
-					Caption: []any{},
-					URL:     entryURL,
-				},
+	children := []block{
+		{
+			Object: "block",
+			Type:   "bookmark",
+			Bookmark: &bookmarkObject{
+				Caption: []any{},
+				URL:     entryURL,
 			},
 		},
-	})
+	}
+
+	for _, highlight := range highlights {
+		children = append(children, block{
+			Object: "block",
+			Type:   "quote",
+			Quote:  &textObject{RichText: newRichText(highlight.Quote)},
+		})
+
+		if highlight.Note != "" {
+			children = append(children, block{
+				Object:    "block",
+				Type:      "paragraph",
+				Paragraph: &textObject{RichText: newRichText(highlight.Note)},
+			})
+		}
+	}
+
+	apiEndpoint := "https://api.notion.com/v1/blocks/" + c.pageID + "/children"
+	requestBody, err := json.Marshal(&notionDocument{Children: children})
 	if err != nil {
 		return fmt.Errorf("notion: unable to encode request body: %v", err)
 	}
@@ -75,12 +99,35 @@ type notionDocument struct {
 }
 
 type block struct {
-	Object   string         `json:"object"`
-	Type     string         `json:"type"`
-	Bookmark bookmarkObject `json:"bookmark"`
+	Object    string          `json:"object"`
+	Type      string          `json:"type"`
+	Bookmark  *bookmarkObject `json:"bookmark,omitempty"`
+	Quote     *textObject     `json:"quote,omitempty"`
+	Paragraph *textObject     `json:"paragraph,omitempty"`
 }
 
 type bookmarkObject struct {
 	Caption []any  `json:"caption"`
 	URL     string `json:"url"`
 }
+
+type textObject struct {
+	RichText []richText `json:"rich_text"`
+}
+
+type richText struct {
+	Type string      `json:"type"`
+	Text textContent `json:"text"`
+}
+
+type textContent struct {
+	Content string `json:"content"`
+}
+
+func newRichText(content string) []richText {
+	if utf8.RuneCountInString(content) > maxRichTextLength {
+		content = string([]rune(content)[:maxRichTextLength-1]) + "…"
+	}
+
+	return []richText{{Type: "text", Text: textContent{Content: content}}}
+}
@@ -12,20 +12,23 @@ import (
 	"net/http"
 	"time"
 
+	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/version"
 )
 
 const (
-	readwiseApiEndpoint  = "https://readwise.io/api/v3/save/"
-	defaultClientTimeout = 10 * time.Second
+	readwiseApiEndpoint           = "https://readwise.io/api/v3/save/"
+	readwiseHighlightsApiEndpoint = "https://readwise.io/api/v2/highlights/"
+	defaultClientTimeout          = 10 * time.Second
 )
 
 type Client struct {
-	apiKey string
+	apiKey        string
+	highlightsURL string
 }
 
 func NewClient(apiKey string) *Client {
-	return &Client{apiKey: apiKey}
+	return &Client{apiKey: apiKey, highlightsURL: readwiseHighlightsApiEndpoint}
 }
 
 func (c *Client) CreateDocument(entryURL string) error {
@@ -33,15 +36,47 @@ func (c *Client) CreateDocument(entryURL string) error {
 		return fmt.Errorf("readwise: missing API key")
 	}
 
-	requestBody, err := json.Marshal(&readwiseDocument{
+	return c.post(readwiseApiEndpoint, &readwiseDocument{
 		URL: entryURL,
 	})
+}
+
+// CreateHighlights sends the highlights of the entry to Readwise.
+func (c *Client) CreateHighlights(entry *model.Entry) error {
+	if c.apiKey == "" {
+		return fmt.Errorf("readwise: missing API key")
+	}
+
+	if len(entry.Highlights) == 0 {
+		return nil
+	}
 
+	highlights := make([]readwiseHighlight, 0, len(entry.Highlights))
+	for _, highlight := range entry.Highlights {
+		highlights = append(highlights, readwiseHighlight{
+			Text:          highlight.Quote,
+			Note:          highlight.Note,
+			Title:         entry.Title,
+			Author:        entry.Author,
+			SourceURL:     entry.URL,
+			SourceType:    "miniflux",
+			Category:      "articles",
+			Location:      highlight.StartOffset,
+			LocationType:  "offset",
+			HighlightedAt: highlight.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return c.post(c.highlightsURL, &readwiseHighlights{Highlights: highlights})
+}
+
+func (c *Client) post(apiEndpoint string, payload any) error {
+	requestBody, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("readwise: unable to encode request body: %v", err)
 	}
 
-	request, err := http.NewRequest(http.MethodPost, readwiseApiEndpoint, bytes.NewReader(requestBody))
+	request, err := http.NewRequest(http.MethodPost, apiEndpoint, bytes.NewReader(requestBody))
 	if err != nil {
 		return fmt.Errorf("readwise: unable to create request: %v", err)
 	}
@@ -58,7 +93,7 @@ func (c *Client) CreateDocument(entryURL string) error {
 	defer response.Body.Close()
 
 	if response.StatusCode >= 400 {
-		return fmt.Errorf("readwise: unable to create document: url=%s status=%d", readwiseApiEndpoint, response.StatusCode)
+		return fmt.Errorf("readwise: unable to send request: url=%s status=%d", apiEndpoint, response.StatusCode)
 	}
 
 	return nil
@@ -67,3 +102,20 @@ func (c *Client) CreateDocument(entryURL string) error {
 type readwiseDocument struct {
 	URL string `json:"url"`
 }
+
+type readwiseHighlights struct {
+	Highlights []readwiseHighlight `json:"highlights"`
+}
+
+type readwiseHighlight struct {
+	Text          string `json:"text"`
+	Note          string `json:"note,omitempty"`
+	Title         string `json:"title"`
+	Author        string `json:"author,omitempty"`
+	SourceURL     string `json:"source_url"`
+	SourceType    string `json:"source_type"`
+	Category      string `json:"category"`
+	Location      int    `json:"location"`
+	LocationType  string `json:"location_type"`
+	HighlightedAt string `json:"highlighted_at"`
+}
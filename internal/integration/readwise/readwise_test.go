@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package readwise // import "miniflux.app/v2/internal/integration/readwise"
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestCreateHighlights(t *testing.T) {
+	var payload readwiseHighlights
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Token secret" {
+			t.Errorf(`Unexpected Authorization header: %q`, r.Header.Get("Authorization"))
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf(`Unable to decode request body: %v`, err)
+		}
+	}))
+	defer server.Close()
+
+	createdAt := time.Date(2026, time.March, 1, 10, 0, 0, 0, time.UTC)
+	entry := &model.Entry{
+		Title:  "Entry title",
+		Author: "Jane",
+		URL:    "https://example.org/article",
+		Highlights: model.Highlights{
+			{Quote: "First quote", Note: "A note", StartOffset: 42, CreatedAt: createdAt},
+			{Quote: "Second quote", StartOffset: 128, CreatedAt: createdAt},
+		},
+	}
+
+	client := &Client{apiKey: "secret", highlightsURL: server.URL}
+	if err := client.CreateHighlights(entry); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []readwiseHighlight{
+		{Text: "First quote", Note: "A note", Title: "Entry title", Author: "Jane", SourceURL: "https://example.org/article", SourceType: "miniflux", Category: "articles", Location: 42, LocationType: "offset", HighlightedAt: "2026-03-01T10:00:00Z"},
+		{Text: "Second quote", Title: "Entry title", Author: "Jane", SourceURL: "https://example.org/article", SourceType: "miniflux", Category: "articles", Location: 128, LocationType: "offset", HighlightedAt: "2026-03-01T10:00:00Z"},
+	}
+
+	if len(payload.Highlights) != len(expected) {
+		t.Fatalf(`Unexpected number of highlights, got %d instead of %d`, len(payload.Highlights), len(expected))
+	}
+
+	for i := range expected {
+		if payload.Highlights[i] != expected[i] {
+			t.Errorf(`Unexpected highlight #%d, got %+v instead of %+v`, i, payload.Highlights[i], expected[i])
+		}
+	}
+}
+
+func TestCreateHighlightsWithoutHighlights(t *testing.T) {
+	client := &Client{apiKey: "secret", highlightsURL: "http://127.0.0.1:1"}
+	if err := client.CreateHighlights(&model.Entry{}); err != nil {
+		t.Fatalf(`No request should be sent for an entry without highlights, got %v`, err)
+	}
+}
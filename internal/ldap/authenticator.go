@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ldap // import "miniflux.app/v2/internal/ldap"
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrUserNotFound is returned when no directory entry matches the username.
+	ErrUserNotFound = errors.New("ldap: user not found in the directory")
+
+	// ErrInvalidCredentials is returned when the directory refuses the password of the user.
+	ErrInvalidCredentials = errors.New("ldap: invalid credentials")
+)
+
+// AuthenticatorInstance is shared by all the authentication endpoints.
+// It is nil when LDAP authentication is disabled.
+var AuthenticatorInstance *Authenticator
+
+// Settings holds the configuration of the LDAP authenticator.
+type Settings struct {
+	URL      string
+	StartTLS bool
+
+	// CAFile is an optional PEM file containing the certificate authorities trusted for the server certificate.
+	CAFile string
+
+	// BindDN and BindPassword are the credentials of the service account used to search the users.
+	// The search is anonymous when BindDN is empty.
+	BindDN       string
+	BindPassword string
+
+	// UserFilter is a search filter where each %s is replaced by the escaped username.
+	UserBaseDN string
+	UserFilter string
+
+	// Members of AdminGroupDN are administrators. The admin flag is not managed when it is empty.
+	AdminGroupDN string
+
+	// UserCreationAllowed creates local accounts for the directory users on their first login.
+	UserCreationAllowed bool
+
+	// LocalPasswordFallback lets the directory users log in with their local password
+	// when the directory is unreachable or does not know them anymore.
+	LocalPasswordFallback bool
+
+	// LinkLocalUsers lets the directory users take over the existing local accounts with the same username,
+	// including their admin flag. The local accounts are left alone otherwise.
+	LinkLocalUsers bool
+
+	Timeout time.Duration
+}
+
+// Profile is the directory information of an authenticated user.
+type Profile struct {
+	DN      string
+	IsAdmin bool
+}
+
+// Authenticator validates credentials by binding to an LDAP server.
+type Authenticator struct {
+	settings  Settings
+	tlsConfig *tls.Config
+}
+
+// NewAuthenticator returns a new Authenticator.
+func NewAuthenticator(settings Settings) (*Authenticator, error) {
+	if settings.UserBaseDN == "" {
+		return nil, errors.New("ldap: the user base DN is required")
+	}
+
+	if !strings.Contains(settings.UserFilter, "%s") {
+		return nil, fmt.Errorf("ldap: the user filter %q does not contain the %%s placeholder", settings.UserFilter)
+	}
+
+	if _, err := compileFilter(strings.ReplaceAll(settings.UserFilter, "%s", "username")); err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if settings.CAFile != "" {
+		data, err := os.ReadFile(settings.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("ldap: unable to read the CA file: %v", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("ldap: no certificate found in %s", settings.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return &Authenticator{settings: settings, tlsConfig: tlsConfig}, nil
+}
+
+// ManagesAdminFlag returns true when the admin flag of the users is derived from the admin group.
+func (a *Authenticator) ManagesAdminFlag() bool {
+	return a.settings.AdminGroupDN != ""
+}
+
+// Authenticate searches the user in the directory and binds with the given password.
+func (a *Authenticator) Authenticate(username, password string) (*Profile, error) {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return nil, ErrUserNotFound
+	}
+
+	c, err := dial(a.settings.URL, a.settings.StartTLS, a.tlsConfig, time.Now().Add(a.settings.Timeout))
+	if err != nil {
+		return nil, err
+	}
+	defer c.close()
+
+	if a.settings.BindDN != "" {
+		if err := c.bind(a.settings.BindDN, a.settings.BindPassword); err != nil {
+			return nil, fmt.Errorf("ldap: unable to bind with the service account: %w", err)
+		}
+	}
+
+	filter := strings.ReplaceAll(a.settings.UserFilter, "%s", EscapeFilter(username))
+	dns, err := c.search(a.settings.UserBaseDN, scopeWholeSubtree, filter)
+	if err != nil {
+		return nil, fmt.Errorf("ldap: unable to search the user: %w", err)
+	}
+
+	switch len(dns) {
+	case 0:
+		return nil, ErrUserNotFound
+	case 1:
+	default:
+		return nil, fmt.Errorf("ldap: %d directory entries match the username %q", len(dns), username)
+	}
+
+	profile := &Profile{DN: dns[0]}
+
+	// The group is checked before binding as the user, who may not be allowed to read it.
+	if a.settings.AdminGroupDN != "" {
+		if profile.IsAdmin, err = a.isGroupMember(c, profile.DN, username); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.bind(profile.DN, password); err != nil {
+		var resultErr *ResultError
+		if errors.As(err, &resultErr) && resultErr.Code == resultInvalidCredentials {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("ldap: unable to bind as the user: %w", err)
+	}
+
+	return profile, nil
+}
+
+// isGroupMember supports the groupOfNames, groupOfUniqueNames and posixGroup object classes.
+func (a *Authenticator) isGroupMember(c *conn, dn, username string) (bool, error) {
+	filter := fmt.Sprintf("(|(member=%[1]s)(uniqueMember=%[1]s)(memberUid=%[2]s))", EscapeFilter(dn), EscapeFilter(username))
+
+	dns, err := c.search(a.settings.AdminGroupDN, scopeBaseObject, filter)
+	if err != nil {
+		var resultErr *ResultError
+		if errors.As(err, &resultErr) && resultErr.Code == resultNoSuchObject {
+			return false, fmt.Errorf("ldap: the admin group %q does not exist", a.settings.AdminGroupDN)
+		}
+		return false, fmt.Errorf("ldap: unable to search the admin group: %w", err)
+	}
+
+	return len(dns) > 0, nil
+}
@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ldap // import "miniflux.app/v2/internal/ldap"
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"testing"
+	"time"
+)
+
+const (
+	testServiceDN       = "cn=miniflux,dc=example,dc=org"
+	testServicePassword = "service-password"
+	testUserBaseDN      = "ou=people,dc=example,dc=org"
+	testAdminGroupDN    = "cn=admins,ou=groups,dc=example,dc=org"
+)
+
+// testDirectory contains the user passwords by uid. Only "alice" is a member of the admin group.
+var testDirectory = map[string]string{
+	"alice": "alice-password",
+	"bob":   "bob-password",
+}
+
+// startTestServer starts a minimal LDAP server answering the requests sent by the Authenticator.
+func startTestServer(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			netConn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveTestConnection(netConn)
+		}
+	}()
+
+	return "ldap://" + listener.Addr().String()
+}
+
+func serveTestConnection(netConn net.Conn) {
+	defer netConn.Close()
+	reader := bufio.NewReader(netConn)
+
+	reply := func(messageID int64, op *packet) {
+		netConn.Write(newSequence(newInteger(messageID), op).bytes())
+	}
+
+	result := func(tag byte, code int64) *packet {
+		return newConstructed(classApplication, tag, newEnumerated(code), newOctetString(""), newOctetString(""))
+	}
+
+	for {
+		message, err := readPacket(reader)
+		if err != nil {
+			return
+		}
+
+		messageID, _ := message.children[0].int64()
+		op := message.children[1]
+
+		switch {
+		case op.is(classApplication, opBindRequest):
+			dn, password := op.children[1].string(), op.children[2].string()
+			code := int64(resultInvalidCredentials)
+			if dn == testServiceDN && password == testServicePassword {
+				code = resultSuccess
+			}
+			for uid, userPassword := range testDirectory {
+				if dn == "uid="+uid+","+testUserBaseDN && password == userPassword {
+					code = resultSuccess
+				}
+			}
+			reply(messageID, result(opBindResponse, code))
+		case op.is(classApplication, opSearchRequest):
+			baseDN := op.children[0].string()
+			filter := op.children[6]
+
+			switch baseDN {
+			case testUserBaseDN:
+				// The user filter is (&(objectClass=person)(uid=<username>)).
+				uid := filter.children[1].children[1].string()
+				if _, found := testDirectory[uid]; found {
+					reply(messageID, newConstructed(classApplication, opSearchResultEntry, newOctetString("uid="+uid+","+testUserBaseDN), newSequence()))
+				}
+				reply(messageID, result(opSearchResultDone, resultSuccess))
+			case testAdminGroupDN:
+				// The membership filter ends with (memberUid=<username>).
+				if filter.children[2].children[1].string() == "alice" {
+					reply(messageID, newConstructed(classApplication, opSearchResultEntry, newOctetString(testAdminGroupDN), newSequence()))
+				}
+				reply(messageID, result(opSearchResultDone, resultSuccess))
+			default:
+				reply(messageID, result(opSearchResultDone, resultNoSuchObject))
+			}
+		default:
+			return
+		}
+	}
+}
+
+func newTestAuthenticator(t *testing.T, serverURL string) *Authenticator {
+	authenticator, err := NewAuthenticator(Settings{
+		URL:          serverURL,
+		BindDN:       testServiceDN,
+		BindPassword: testServicePassword,
+		UserBaseDN:   testUserBaseDN,
+		UserFilter:   "(&(objectClass=person)(uid=%s))",
+		AdminGroupDN: testAdminGroupDN,
+		Timeout:      5 * time.Second,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return authenticator
+}
+
+func TestAuthenticate(t *testing.T) {
+	authenticator := newTestAuthenticator(t, startTestServer(t))
+
+	profile, err := authenticator.Authenticate("alice", "alice-password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if profile.DN != "uid=alice,"+testUserBaseDN || !profile.IsAdmin {
+		t.Errorf(`Unexpected profile: %+v`, profile)
+	}
+
+	profile, err = authenticator.Authenticate("bob", "bob-password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if profile.IsAdmin {
+		t.Errorf(`Bob should not be an administrator`)
+	}
+}
+
+func TestAuthenticateWithInvalidCredentials(t *testing.T) {
+	authenticator := newTestAuthenticator(t, startTestServer(t))
+
+	scenarios := []struct {
+		username string
+		password string
+		expected error
+	}{
+		{"alice", "wrong-password", ErrInvalidCredentials},
+		{"alice", "", ErrInvalidCredentials},
+		{"carol", "carol-password", ErrUserNotFound},
+		{"*", "alice-password", ErrUserNotFound},
+		{"", "password", ErrUserNotFound},
+	}
+
+	for _, scenario := range scenarios {
+		if _, err := authenticator.Authenticate(scenario.username, scenario.password); !errors.Is(err, scenario.expected) {
+			t.Errorf(`Unexpected error for %q, got %v instead of %v`, scenario.username, err, scenario.expected)
+		}
+	}
+}
+
+func TestAuthenticateWithInvalidServiceAccount(t *testing.T) {
+	serverURL := startTestServer(t)
+
+	authenticator, err := NewAuthenticator(Settings{
+		URL:          serverURL,
+		BindDN:       testServiceDN,
+		BindPassword: "wrong-password",
+		UserBaseDN:   testUserBaseDN,
+		UserFilter:   "(uid=%s)",
+		Timeout:      5 * time.Second,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = authenticator.Authenticate("alice", "alice-password")
+	if err == nil || errors.Is(err, ErrInvalidCredentials) || errors.Is(err, ErrUserNotFound) {
+		t.Fatalf(`A service account failure should not be reported as invalid user credentials: %v`, err)
+	}
+}
+
+func TestNewAuthenticatorWithInvalidSettings(t *testing.T) {
+	scenarios := []Settings{
+		{URL: "ldap://localhost", UserFilter: "(uid=%s)"},
+		{URL: "ldap://localhost", UserBaseDN: testUserBaseDN, UserFilter: "(uid=john)"},
+		{URL: "ldap://localhost", UserBaseDN: testUserBaseDN, UserFilter: "(uid=%s"},
+		{URL: "ldap://localhost", UserBaseDN: testUserBaseDN, UserFilter: "(uid=%s)", CAFile: "/does/not/exist.pem"},
+	}
+
+	for _, settings := range scenarios {
+		if _, err := NewAuthenticator(settings); err == nil {
+			t.Errorf(`The settings %+v should be rejected`, settings)
+		}
+	}
+}
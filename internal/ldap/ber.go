@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ldap // import "miniflux.app/v2/internal/ldap"
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Only the subset of the Basic Encoding Rules used by LDAPv3 (RFC 4511 section 5.1) is implemented:
+// low tag numbers and definite lengths.
+
+const (
+	classUniversal   byte = 0x00
+	classApplication byte = 0x40
+	classContext     byte = 0x80
+
+	constructedBit byte = 0x20
+
+	tagBoolean     = 0x01
+	tagInteger     = 0x02
+	tagOctetString = 0x04
+	tagEnumerated  = 0x0a
+	tagSequence    = 0x10
+	tagSet         = 0x11
+
+	// maxPacketSize protects against a malicious or broken server sending huge lengths.
+	maxPacketSize = 16 * 1024 * 1024
+)
+
+type packet struct {
+	class       byte
+	constructed bool
+	tag         byte
+	value       []byte
+	children    []*packet
+}
+
+func newPrimitive(class, tag byte, value []byte) *packet {
+	return &packet{class: class, tag: tag, value: value}
+}
+
+func newConstructed(class, tag byte, children ...*packet) *packet {
+	return &packet{class: class, constructed: true, tag: tag, children: children}
+}
+
+func newSequence(children ...*packet) *packet {
+	return newConstructed(classUniversal, tagSequence, children...)
+}
+
+func newOctetString(value string) *packet {
+	return newPrimitive(classUniversal, tagOctetString, []byte(value))
+}
+
+func newBoolean(value bool) *packet {
+	if value {
+		return newPrimitive(classUniversal, tagBoolean, []byte{0xff})
+	}
+	return newPrimitive(classUniversal, tagBoolean, []byte{0x00})
+}
+
+func newInteger(value int64) *packet {
+	return newPrimitive(classUniversal, tagInteger, encodeInteger(value))
+}
+
+func newEnumerated(value int64) *packet {
+	return newPrimitive(classUniversal, tagEnumerated, encodeInteger(value))
+}
+
+func (p *packet) is(class, tag byte) bool {
+	return p.class == class && p.tag == tag
+}
+
+func (p *packet) child(index int) (*packet, error) {
+	if index >= len(p.children) {
+		return nil, fmt.Errorf("ldap: missing element %d in packet with tag %d", index, p.tag)
+	}
+	return p.children[index], nil
+}
+
+func (p *packet) int64() (int64, error) {
+	return decodeInteger(p.value)
+}
+
+func (p *packet) string() string {
+	return string(p.value)
+}
+
+func (p *packet) bytes() []byte {
+	var content []byte
+	if p.constructed {
+		for _, child := range p.children {
+			content = append(content, child.bytes()...)
+		}
+	} else {
+		content = p.value
+	}
+
+	identifier := p.class | p.tag
+	if p.constructed {
+		identifier |= constructedBit
+	}
+
+	result := append([]byte{identifier}, encodeLength(len(content))...)
+	return append(result, content...)
+}
+
+func encodeLength(length int) []byte {
+	if length < 0x80 {
+		return []byte{byte(length)}
+	}
+
+	var encoded []byte
+	for length > 0 {
+		encoded = append([]byte{byte(length)}, encoded...)
+		length >>= 8
+	}
+	return append([]byte{0x80 | byte(len(encoded))}, encoded...)
+}
+
+func encodeInteger(value int64) []byte {
+	var encoded []byte
+	for {
+		encoded = append([]byte{byte(value)}, encoded...)
+		value >>= 8
+		// Stop once the remaining bits are only the sign extension of the last byte written.
+		if (value == 0 && encoded[0]&0x80 == 0) || (value == -1 && encoded[0]&0x80 != 0) {
+			return encoded
+		}
+	}
+}
+
+func decodeInteger(encoded []byte) (int64, error) {
+	if len(encoded) == 0 || len(encoded) > 8 {
+		return 0, fmt.Errorf("ldap: invalid integer length %d", len(encoded))
+	}
+
+	var value int64
+	if encoded[0]&0x80 != 0 {
+		value = -1
+	}
+	for _, b := range encoded {
+		value = value<<8 | int64(b)
+	}
+	return value, nil
+}
+
+// readPacket reads a single BER element from the reader.
+func readPacket(reader io.Reader) (*packet, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(reader, header[:]); err != nil {
+		return nil, err
+	}
+
+	if header[0]&0x1f == 0x1f {
+		return nil, errors.New("ldap: high tag numbers are not supported")
+	}
+
+	length := int(header[1])
+	if length&0x80 != 0 {
+		size := length & 0x7f
+		if size == 0 || size > 4 {
+			return nil, fmt.Errorf("ldap: unsupported length encoding (%d bytes)", size)
+		}
+
+		encoded := make([]byte, size)
+		if _, err := io.ReadFull(reader, encoded); err != nil {
+			return nil, err
+		}
+
+		length = 0
+		for _, b := range encoded {
+			length = length<<8 | int(b)
+		}
+	}
+
+	if length > maxPacketSize {
+		return nil, fmt.Errorf("ldap: packet too large (%d bytes)", length)
+	}
+
+	content := make([]byte, length)
+	if _, err := io.ReadFull(reader, content); err != nil {
+		return nil, err
+	}
+
+	p := &packet{
+		class:       header[0] & 0xc0,
+		constructed: header[0]&constructedBit != 0,
+		tag:         header[0] & 0x1f,
+	}
+
+	if !p.constructed {
+		p.value = content
+		return p, nil
+	}
+
+	contentReader := bytes.NewReader(content)
+	for contentReader.Len() > 0 {
+		child, err := readPacket(contentReader)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		p.children = append(p.children, child)
+	}
+
+	return p, nil
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ldap // import "miniflux.app/v2/internal/ldap"
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestIntegerEncoding(t *testing.T) {
+	scenarios := map[int64][]byte{
+		0:      {0x00},
+		1:      {0x01},
+		127:    {0x7f},
+		128:    {0x00, 0x80},
+		256:    {0x01, 0x00},
+		-1:     {0xff},
+		-128:   {0x80},
+		-129:   {0xff, 0x7f},
+		100000: {0x01, 0x86, 0xa0},
+	}
+
+	for value, expected := range scenarios {
+		encoded := encodeInteger(value)
+		if !bytes.Equal(encoded, expected) {
+			t.Errorf(`Unexpected encoding of %d, got %x instead of %x`, value, encoded, expected)
+		}
+
+		decoded, err := decodeInteger(encoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if decoded != value {
+			t.Errorf(`Unexpected decoded value, got %d instead of %d`, decoded, value)
+		}
+	}
+}
+
+func TestLengthEncoding(t *testing.T) {
+	scenarios := map[int][]byte{
+		0:     {0x00},
+		127:   {0x7f},
+		128:   {0x81, 0x80},
+		300:   {0x82, 0x01, 0x2c},
+		70000: {0x83, 0x01, 0x11, 0x70},
+	}
+
+	for length, expected := range scenarios {
+		if encoded := encodeLength(length); !bytes.Equal(encoded, expected) {
+			t.Errorf(`Unexpected encoding of length %d, got %x instead of %x`, length, encoded, expected)
+		}
+	}
+}
+
+func TestPacketRoundTrip(t *testing.T) {
+	longValue := strings.Repeat("a", 300)
+	original := newSequence(
+		newInteger(42),
+		newConstructed(classApplication, opBindRequest,
+			newInteger(protocolVersion),
+			newOctetString(longValue),
+			newPrimitive(classContext, 0, []byte("secret")),
+		),
+	)
+
+	decoded, err := readPacket(bytes.NewReader(original.bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(decoded.bytes(), original.bytes()) {
+		t.Fatal(`The decoded packet should be identical to the original one`)
+	}
+
+	if id, _ := decoded.children[0].int64(); id != 42 {
+		t.Errorf(`Unexpected message ID: %d`, id)
+	}
+
+	bindRequest := decoded.children[1]
+	if !bindRequest.is(classApplication, opBindRequest) || !bindRequest.constructed {
+		t.Fatalf(`Unexpected bind request packet: %+v`, bindRequest)
+	}
+
+	if name := bindRequest.children[1].string(); name != longValue {
+		t.Errorf(`Unexpected bind DN with %d characters`, len(name))
+	}
+
+	if password := bindRequest.children[2]; !password.is(classContext, 0) || password.string() != "secret" {
+		t.Errorf(`Unexpected password packet: %+v`, password)
+	}
+}
+
+func TestReadTruncatedPacket(t *testing.T) {
+	encoded := newSequence(newOctetString("value")).bytes()
+
+	if _, err := readPacket(bytes.NewReader(encoded[:len(encoded)-2])); err == nil {
+		t.Fatal(`A truncated packet should be rejected`)
+	}
+
+	if _, err := readPacket(bytes.NewReader([]byte{0x30, 0x84, 0x7f, 0xff, 0xff, 0xff})); err == nil {
+		t.Fatal(`A huge length should be rejected`)
+	}
+}
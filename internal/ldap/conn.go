@@ -0,0 +1,299 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ldap // import "miniflux.app/v2/internal/ldap"
+
+import (
+	"bufio"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Protocol operations defined in RFC 4511 section 4.2 to 4.12.
+const (
+	opBindRequest            = 0
+	opBindResponse           = 1
+	opUnbindRequest          = 2
+	opSearchRequest          = 3
+	opSearchResultEntry      = 4
+	opSearchResultDone       = 5
+	opSearchResultRef        = 19
+	opExtendedRequest        = 23
+	opExtendedResponse       = 24
+	protocolVersion          = 3
+	startTLSOID              = "1.3.6.1.4.1.1466.20037"
+	scopeBaseObject          = 0
+	scopeWholeSubtree        = 2
+	derefAliasesNever        = 0
+	noAttributes             = "1.1"
+	resultSuccess            = 0
+	resultNoSuchObject       = 32
+	resultInvalidCredentials = 49
+)
+
+// ResultError is returned when the server answers an operation with a result code other than success.
+type ResultError struct {
+	Code    int64
+	Message string
+}
+
+func (e *ResultError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("ldap: operation failed with result code %d", e.Code)
+	}
+	return fmt.Sprintf("ldap: operation failed with result code %d: %s", e.Code, e.Message)
+}
+
+type conn struct {
+	netConn   net.Conn
+	reader    *bufio.Reader
+	messageID int64
+}
+
+// dial opens a connection to an ldap:// or ldaps:// URL. The deadline applies to the whole connection.
+func dial(serverURL string, startTLS bool, tlsConfig *tls.Config, deadline time.Time) (*conn, error) {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, fmt.Errorf("ldap: invalid server URL: %v", err)
+	}
+
+	host := u.Hostname()
+	port := u.Port()
+
+	dialer := &net.Dialer{Deadline: deadline}
+	var netConn net.Conn
+
+	switch strings.ToLower(u.Scheme) {
+	case "ldap":
+		if port == "" {
+			port = "389"
+		}
+		netConn, err = dialer.Dial("tcp", net.JoinHostPort(host, port))
+	case "ldaps":
+		if port == "" {
+			port = "636"
+		}
+		netConn, err = tls.DialWithDialer(dialer, "tcp", net.JoinHostPort(host, port), serverTLSConfig(tlsConfig, host))
+	default:
+		return nil, fmt.Errorf("ldap: unsupported URL scheme %q", u.Scheme)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("ldap: unable to connect to %s: %v", u.Host, err)
+	}
+
+	if err := netConn.SetDeadline(deadline); err != nil {
+		netConn.Close()
+		return nil, fmt.Errorf("ldap: unable to set connection deadline: %v", err)
+	}
+
+	c := &conn{netConn: netConn, reader: bufio.NewReader(netConn)}
+
+	if startTLS && strings.EqualFold(u.Scheme, "ldap") {
+		if err := c.startTLS(serverTLSConfig(tlsConfig, host)); err != nil {
+			c.netConn.Close()
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+func serverTLSConfig(tlsConfig *tls.Config, host string) *tls.Config {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if tlsConfig != nil {
+		config = tlsConfig.Clone()
+	}
+
+	if config.ServerName == "" {
+		config.ServerName = host
+	}
+
+	return config
+}
+
+func (c *conn) startTLS(tlsConfig *tls.Config) error {
+	request := newConstructed(classApplication, opExtendedRequest,
+		newPrimitive(classContext, 0, []byte(startTLSOID)),
+	)
+
+	if _, err := c.roundTrip(request, opExtendedResponse); err != nil {
+		return fmt.Errorf("ldap: StartTLS failed: %w", err)
+	}
+
+	tlsConn := tls.Client(c.netConn, tlsConfig)
+	if err := tlsConn.Handshake(); err != nil {
+		return fmt.Errorf("ldap: TLS handshake failed: %v", err)
+	}
+
+	c.netConn = tlsConn
+	c.reader = bufio.NewReader(tlsConn)
+	return nil
+}
+
+// bind performs a simple bind. Empty passwords are refused because servers treat them as unauthenticated binds (RFC 4513 section 5.1.2).
+func (c *conn) bind(dn, password string) error {
+	if password == "" {
+		return &ResultError{Code: resultInvalidCredentials, Message: "empty password"}
+	}
+
+	request := newConstructed(classApplication, opBindRequest,
+		newInteger(protocolVersion),
+		newOctetString(dn),
+		newPrimitive(classContext, 0, []byte(password)),
+	)
+
+	_, err := c.roundTrip(request, opBindResponse)
+	return err
+}
+
+// search returns the distinguished names of the entries matching the filter. Attribute values are not requested.
+func (c *conn) search(baseDN string, scope int64, filter string) ([]string, error) {
+	compiledFilter, err := compileFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	request := newConstructed(classApplication, opSearchRequest,
+		newOctetString(baseDN),
+		newEnumerated(scope),
+		newEnumerated(derefAliasesNever),
+		newInteger(0),
+		newInteger(0),
+		newBoolean(false),
+		compiledFilter,
+		newSequence(newOctetString(noAttributes)),
+	)
+
+	messageID, err := c.send(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var dns []string
+	for {
+		op, err := c.receive(messageID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case op.is(classApplication, opSearchResultEntry):
+			dn, err := op.child(0)
+			if err != nil {
+				return nil, err
+			}
+			dns = append(dns, dn.string())
+		case op.is(classApplication, opSearchResultRef):
+			// Referrals are not followed.
+		case op.is(classApplication, opSearchResultDone):
+			if err := checkResult(op); err != nil {
+				return nil, err
+			}
+			return dns, nil
+		default:
+			return nil, fmt.Errorf("ldap: unexpected response with tag %d to a search request", op.tag)
+		}
+	}
+}
+
+// close sends an unbind request and closes the connection.
+func (c *conn) close() {
+	c.send(newPrimitive(classApplication, opUnbindRequest, nil))
+	c.netConn.Close()
+}
+
+func (c *conn) roundTrip(request *packet, responseTag byte) (*packet, error) {
+	messageID, err := c.send(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.receive(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !response.is(classApplication, responseTag) {
+		return nil, fmt.Errorf("ldap: unexpected response with tag %d, expected %d", response.tag, responseTag)
+	}
+
+	if err := checkResult(response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (c *conn) send(request *packet) (int64, error) {
+	c.messageID++
+	message := newSequence(newInteger(c.messageID), request)
+
+	if _, err := c.netConn.Write(message.bytes()); err != nil {
+		return 0, fmt.Errorf("ldap: unable to send request: %v", err)
+	}
+
+	return c.messageID, nil
+}
+
+// receive returns the protocol operation of the next message with the given ID.
+func (c *conn) receive(messageID int64) (*packet, error) {
+	for {
+		message, err := readPacket(c.reader)
+		if err != nil {
+			return nil, fmt.Errorf("ldap: unable to read response: %v", err)
+		}
+
+		if !message.is(classUniversal, tagSequence) || len(message.children) < 2 {
+			return nil, errors.New("ldap: malformed response message")
+		}
+
+		id, err := message.children[0].int64()
+		if err != nil {
+			return nil, err
+		}
+
+		op := message.children[1]
+
+		// Unsolicited notifications use the message ID zero, the server is about to close the connection.
+		if id == 0 {
+			if err := checkResult(op); err != nil {
+				return nil, err
+			}
+			return nil, errors.New("ldap: the server closed the connection")
+		}
+
+		if id == messageID {
+			return op, nil
+		}
+	}
+}
+
+// checkResult returns a ResultError when the LDAPResult contained in the operation is not a success.
+func checkResult(op *packet) error {
+	codePacket, err := op.child(0)
+	if err != nil {
+		return err
+	}
+
+	code, err := codePacket.int64()
+	if err != nil {
+		return err
+	}
+
+	if code == resultSuccess {
+		return nil
+	}
+
+	var message string
+	if len(op.children) > 2 {
+		message = op.children[2].string()
+	}
+
+	return &ResultError{Code: code, Message: message}
+}
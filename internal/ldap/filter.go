@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ldap // import "miniflux.app/v2/internal/ldap"
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Filter choices defined in RFC 4511 section 4.5.1.
+const (
+	filterAnd            = 0
+	filterOr             = 1
+	filterNot            = 2
+	filterEqualityMatch  = 3
+	filterSubstrings     = 4
+	filterGreaterOrEqual = 5
+	filterLessOrEqual    = 6
+	filterPresent        = 7
+	filterApproxMatch    = 8
+
+	substringInitial = 0
+	substringAny     = 1
+	substringFinal   = 2
+)
+
+// EscapeFilter escapes the special characters of a value inserted in a search filter (RFC 4515 section 3).
+func EscapeFilter(value string) string {
+	var builder strings.Builder
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; c {
+		case '\\', '*', '(', ')', 0:
+			fmt.Fprintf(&builder, `\%02x`, c)
+		default:
+			builder.WriteByte(c)
+		}
+	}
+	return builder.String()
+}
+
+// compileFilter converts the string representation of a search filter to its BER encoding.
+// Extensible match filters are not supported.
+func compileFilter(filter string) (*packet, error) {
+	filter = strings.TrimSpace(filter)
+	if !strings.HasPrefix(filter, "(") {
+		filter = "(" + filter + ")"
+	}
+
+	compiled, end, err := parseFilter(filter, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if end != len(filter) {
+		return nil, fmt.Errorf("ldap: unexpected characters at the end of the filter %q", filter)
+	}
+
+	return compiled, nil
+}
+
+// parseFilter parses the filter starting at the given position and returns the position after its closing parenthesis.
+func parseFilter(filter string, pos int) (*packet, int, error) {
+	if pos >= len(filter) || filter[pos] != '(' {
+		return nil, 0, fmt.Errorf("ldap: missing opening parenthesis at position %d in filter %q", pos, filter)
+	}
+	pos++
+
+	if pos >= len(filter) {
+		return nil, 0, fmt.Errorf("ldap: unterminated filter %q", filter)
+	}
+
+	switch filter[pos] {
+	case '&', '|':
+		tag := byte(filterAnd)
+		if filter[pos] == '|' {
+			tag = filterOr
+		}
+		pos++
+
+		set := newConstructed(classContext, tag)
+		for pos < len(filter) && filter[pos] == '(' {
+			child, next, err := parseFilter(filter, pos)
+			if err != nil {
+				return nil, 0, err
+			}
+			set.children = append(set.children, child)
+			pos = next
+		}
+
+		if pos >= len(filter) || filter[pos] != ')' {
+			return nil, 0, fmt.Errorf("ldap: missing closing parenthesis in filter %q", filter)
+		}
+		return set, pos + 1, nil
+	case '!':
+		child, next, err := parseFilter(filter, pos+1)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if next >= len(filter) || filter[next] != ')' {
+			return nil, 0, fmt.Errorf("ldap: missing closing parenthesis in filter %q", filter)
+		}
+		return newConstructed(classContext, filterNot, child), next + 1, nil
+	}
+
+	end := strings.IndexByte(filter[pos:], ')')
+	if end < 0 {
+		return nil, 0, fmt.Errorf("ldap: missing closing parenthesis in filter %q", filter)
+	}
+
+	item, err := parseFilterItem(filter[pos : pos+end])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return item, pos + end + 1, nil
+}
+
+func parseFilterItem(item string) (*packet, error) {
+	separator := strings.IndexByte(item, '=')
+	if separator <= 0 {
+		return nil, fmt.Errorf("ldap: invalid filter item %q", item)
+	}
+
+	attribute := item[:separator]
+	value := item[separator+1:]
+
+	tag := byte(filterEqualityMatch)
+	switch attribute[len(attribute)-1] {
+	case '~':
+		tag = filterApproxMatch
+	case '>':
+		tag = filterGreaterOrEqual
+	case '<':
+		tag = filterLessOrEqual
+	case ':':
+		return nil, fmt.Errorf("ldap: extensible match filters are not supported: %q", item)
+	}
+
+	if tag != filterEqualityMatch {
+		attribute = attribute[:len(attribute)-1]
+	}
+
+	if attribute == "" {
+		return nil, fmt.Errorf("ldap: missing attribute in filter item %q", item)
+	}
+
+	if tag == filterEqualityMatch && value == "*" {
+		return newPrimitive(classContext, filterPresent, []byte(attribute)), nil
+	}
+
+	if tag == filterEqualityMatch && strings.Contains(value, "*") {
+		return parseSubstrings(attribute, value)
+	}
+
+	unescaped, err := unescapeFilterValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return newConstructed(classContext, tag, newOctetString(attribute), newOctetString(unescaped)), nil
+}
+
+func parseSubstrings(attribute, value string) (*packet, error) {
+	parts := strings.Split(value, "*")
+	substrings := newSequence()
+
+	for i, part := range parts {
+		if part == "" {
+			continue
+		}
+
+		unescaped, err := unescapeFilterValue(part)
+		if err != nil {
+			return nil, err
+		}
+
+		tag := byte(substringAny)
+		switch i {
+		case 0:
+			tag = substringInitial
+		case len(parts) - 1:
+			tag = substringFinal
+		}
+
+		substrings.children = append(substrings.children, newPrimitive(classContext, tag, []byte(unescaped)))
+	}
+
+	return newConstructed(classContext, filterSubstrings, newOctetString(attribute), substrings), nil
+}
+
+func unescapeFilterValue(value string) (string, error) {
+	if !strings.Contains(value, `\`) {
+		return value, nil
+	}
+
+	var builder strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' {
+			builder.WriteByte(value[i])
+			continue
+		}
+
+		if i+3 > len(value) {
+			return "", fmt.Errorf("ldap: invalid escape sequence in filter value %q", value)
+		}
+
+		decoded, err := hex.DecodeString(value[i+1 : i+3])
+		if err != nil {
+			return "", fmt.Errorf("ldap: invalid escape sequence in filter value %q", value)
+		}
+
+		builder.Write(decoded)
+		i += 2
+	}
+
+	return builder.String(), nil
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ldap // import "miniflux.app/v2/internal/ldap"
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEscapeFilter(t *testing.T) {
+	scenarios := map[string]string{
+		"john":        "john",
+		"*":           `\2a`,
+		"a(b)c":       `a\28b\29c`,
+		`back\slash`:  `back\5cslash`,
+		"null\x00end": `null\00end`,
+	}
+
+	for input, expected := range scenarios {
+		if result := EscapeFilter(input); result != expected {
+			t.Errorf(`Unexpected escaped value for %q, got %q instead of %q`, input, result, expected)
+		}
+	}
+}
+
+func TestCompileFilter(t *testing.T) {
+	scenarios := map[string]*packet{
+		"(uid=john)": newConstructed(classContext, filterEqualityMatch, newOctetString("uid"), newOctetString("john")),
+		"uid=john":   newConstructed(classContext, filterEqualityMatch, newOctetString("uid"), newOctetString("john")),
+		"(mail=*)":   newPrimitive(classContext, filterPresent, []byte("mail")),
+		`(cn=a\2ab)`: newConstructed(classContext, filterEqualityMatch, newOctetString("cn"), newOctetString("a*b")),
+		"(age>=21)":  newConstructed(classContext, filterGreaterOrEqual, newOctetString("age"), newOctetString("21")),
+		"(age<=65)":  newConstructed(classContext, filterLessOrEqual, newOctetString("age"), newOctetString("65")),
+		"(cn~=jon)":  newConstructed(classContext, filterApproxMatch, newOctetString("cn"), newOctetString("jon")),
+		"(cn=jo*h*n)": newConstructed(classContext, filterSubstrings,
+			newOctetString("cn"),
+			newSequence(
+				newPrimitive(classContext, substringInitial, []byte("jo")),
+				newPrimitive(classContext, substringAny, []byte("h")),
+				newPrimitive(classContext, substringFinal, []byte("n")),
+			),
+		),
+		"(cn=*doe)": newConstructed(classContext, filterSubstrings,
+			newOctetString("cn"),
+			newSequence(newPrimitive(classContext, substringFinal, []byte("doe"))),
+		),
+		"(&(objectClass=person)(|(uid=john)(!(mail=*))))": newConstructed(classContext, filterAnd,
+			newConstructed(classContext, filterEqualityMatch, newOctetString("objectClass"), newOctetString("person")),
+			newConstructed(classContext, filterOr,
+				newConstructed(classContext, filterEqualityMatch, newOctetString("uid"), newOctetString("john")),
+				newConstructed(classContext, filterNot, newPrimitive(classContext, filterPresent, []byte("mail"))),
+			),
+		),
+	}
+
+	for filter, expected := range scenarios {
+		compiled, err := compileFilter(filter)
+		if err != nil {
+			t.Errorf(`Unable to compile filter %q: %v`, filter, err)
+			continue
+		}
+
+		if !bytes.Equal(compiled.bytes(), expected.bytes()) {
+			t.Errorf(`Unexpected encoding of filter %q: %x instead of %x`, filter, compiled.bytes(), expected.bytes())
+		}
+	}
+}
+
+func TestCompileInvalidFilter(t *testing.T) {
+	filters := []string{
+		"",
+		"(uid=john",
+		"(&(uid=john)",
+		"(uid=john))",
+		"(=john)",
+		"(uid)",
+		`(uid=\zz)`,
+		`(uid=john\2)`,
+		"(uid:dn:=john)",
+	}
+
+	for _, filter := range filters {
+		if _, err := compileFilter(filter); err == nil {
+			t.Errorf(`The filter %q should be rejected`, filter)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ldap // import "miniflux.app/v2/internal/ldap"
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+)
+
+// ErrUserCreationNotAllowed is returned when a directory user without local account logs in and user creation is disabled.
+var ErrUserCreationNotAllowed = errors.New("ldap: the user does not have an account and user creation is disabled")
+
+// Login authenticates the user against the directory and returns the matching local user.
+// The account is created when user creation is allowed, and the admin flag is synchronized with the admin group.
+// ErrUserNotFound is returned when LDAP authentication is disabled, and for the local accounts not linked to the directory
+// unless linking is allowed, those accounts keep using their local password.
+func (a *Authenticator) Login(store *storage.Storage, username, password, clientIP string) (*model.User, error) {
+	if a == nil {
+		return nil, ErrUserNotFound
+	}
+
+	username = strings.TrimSpace(username)
+	user, err := store.UserByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+
+	if user != nil && !a.settings.LinkLocalUsers {
+		provisioned, err := store.IsUserLDAPProvisioned(user.Username)
+		if err != nil {
+			return nil, err
+		}
+
+		if !provisioned {
+			return nil, ErrUserNotFound
+		}
+	}
+
+	profile, err := a.Authenticate(username, password)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		if !a.settings.UserCreationAllowed {
+			return nil, ErrUserCreationNotAllowed
+		}
+
+		user, err = store.CreateUser(&model.UserCreationRequest{Username: username, IsAdmin: profile.IsAdmin})
+		if err != nil {
+			return nil, fmt.Errorf("ldap: unable to create the user: %w", err)
+		}
+
+		if err := store.SetUserLDAPProvisioned(user.ID); err != nil {
+			return nil, err
+		}
+
+		slog.Info("User created from the LDAP directory",
+			slog.Int64("user_id", user.ID),
+			slog.String("username", user.Username),
+			slog.String("dn", profile.DN),
+			slog.Bool("is_admin", user.IsAdmin),
+		)
+		store.Audit(0, model.AuditActionUserCreate, user.Username, clientIP)
+		if user.IsAdmin {
+			store.Audit(0, model.AuditActionUserAdminGrant, user.Username, clientIP)
+		}
+		return user, nil
+	}
+
+	if err := store.SetUserLDAPProvisioned(user.ID); err != nil {
+		return nil, err
+	}
+
+	if a.ManagesAdminFlag() && user.IsAdmin != profile.IsAdmin {
+		if err := store.SetUserAdmin(user.ID, profile.IsAdmin); err != nil {
+			return nil, err
+		}
+
+		slog.Info("Admin flag updated from the LDAP admin group",
+			slog.Int64("user_id", user.ID),
+			slog.String("username", user.Username),
+			slog.Bool("is_admin", profile.IsAdmin),
+		)
+		if profile.IsAdmin {
+			store.Audit(0, model.AuditActionUserAdminGrant, user.Username, clientIP)
+		} else {
+			store.Audit(0, model.AuditActionUserAdminRevoke, user.Username, clientIP)
+		}
+		user.IsAdmin = profile.IsAdmin
+	}
+
+	return user, nil
+}
+
+// CheckPassword validates the credentials against the directory when LDAP authentication is enabled.
+// The local password is checked instead for the users unknown to the directory, and when the directory cannot be reached,
+// so that local accounts keep working. The directory users only use their local password when the fallback is enabled.
+func CheckPassword(store *storage.Storage, username, password, clientIP string) error {
+	if AuthenticatorInstance == nil {
+		return store.CheckPassword(username, password)
+	}
+
+	_, err := AuthenticatorInstance.Login(store, username, password, clientIP)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ErrInvalidCredentials), errors.Is(err, ErrUserCreationNotAllowed):
+		return err
+	case errors.Is(err, ErrUserNotFound):
+		return AuthenticatorInstance.checkLocalPassword(store, username, password, err)
+	default:
+		slog.Error("Unable to authenticate the user against the LDAP directory",
+			slog.String("username", username),
+			slog.Any("error", err),
+		)
+		return AuthenticatorInstance.checkLocalPassword(store, username, password, err)
+	}
+}
+
+// checkLocalPassword checks the local password unless the user is managed by the directory and the fallback is disabled,
+// in which case the directory error is returned.
+func (a *Authenticator) checkLocalPassword(store *storage.Storage, username, password string, ldapErr error) error {
+	if !a.settings.LocalPasswordFallback {
+		provisioned, err := store.IsUserLDAPProvisioned(strings.TrimSpace(username))
+		if err != nil {
+			return err
+		}
+
+		if provisioned {
+			return ldapErr
+		}
+	}
+
+	return store.CheckPassword(username, password)
+}
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"time"
 
+	"miniflux.app/v2/internal/proxyrotator"
 	"miniflux.app/v2/internal/storage"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -44,6 +45,24 @@ var (
 		[]string{"status"},
 	)
 
+	AuthenticationFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "miniflux",
+			Name:      "authentication_failures_total",
+			Help:      "Number of failed authentication attempts by authentication method",
+		},
+		[]string{"method"},
+	)
+
+	AuthenticationLockouts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "miniflux",
+			Name:      "authentication_lockouts_total",
+			Help:      "Number of client IPs and usernames locked out after too many failed authentication attempts",
+		},
+		[]string{"method"},
+	)
+
 	usersGauge = prometheus.NewGauge(
 		prometheus.GaugeOpts{
 			Namespace: "miniflux",
@@ -78,6 +97,42 @@ var (
 		[]string{"status"},
 	)
 
+	proxyRequestsGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "miniflux",
+			Name:      "proxy_requests",
+			Help:      "Number of requests sent through each rotating proxy",
+		},
+		[]string{"pool", "proxy"},
+	)
+
+	proxyFailuresGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "miniflux",
+			Name:      "proxy_failures",
+			Help:      "Number of connection failures of each rotating proxy",
+		},
+		[]string{"pool", "proxy"},
+	)
+
+	proxyEjectionsGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "miniflux",
+			Name:      "proxy_ejections",
+			Help:      "Number of times each rotating proxy was removed from the rotation",
+		},
+		[]string{"pool", "proxy"},
+	)
+
+	proxyHealthyGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "miniflux",
+			Name:      "proxy_healthy",
+			Help:      "Whether each rotating proxy is part of the rotation (1) or ejected (0)",
+		},
+		[]string{"pool", "proxy"},
+	)
+
 	dbOpenConnectionsGauge = prometheus.NewGauge(
 		prometheus.GaugeOpts{
 			Namespace: "miniflux",
@@ -146,10 +201,16 @@ func NewCollector(store *storage.Storage, refreshInterval time.Duration) *collec
 	prometheus.MustRegister(BackgroundFeedRefreshDuration)
 	prometheus.MustRegister(ScraperRequestDuration)
 	prometheus.MustRegister(ArchiveEntriesDuration)
+	prometheus.MustRegister(AuthenticationFailures)
+	prometheus.MustRegister(AuthenticationLockouts)
 	prometheus.MustRegister(usersGauge)
 	prometheus.MustRegister(feedsGauge)
 	prometheus.MustRegister(brokenFeedsGauge)
 	prometheus.MustRegister(entriesGauge)
+	prometheus.MustRegister(proxyRequestsGauge)
+	prometheus.MustRegister(proxyFailuresGauge)
+	prometheus.MustRegister(proxyEjectionsGauge)
+	prometheus.MustRegister(proxyHealthyGauge)
 	prometheus.MustRegister(dbOpenConnectionsGauge)
 	prometheus.MustRegister(dbConnectionsInUseGauge)
 	prometheus.MustRegister(dbConnectionsIdleGauge)
@@ -179,6 +240,15 @@ func (c *collector) GatherStorageMetrics() {
 			entriesGauge.WithLabelValues(status).Set(float64(count))
 		}
 
+		for _, rotator := range proxyrotator.Rotators() {
+			for _, stats := range rotator.Stats() {
+				proxyRequestsGauge.WithLabelValues(stats.Pool, stats.URL).Set(float64(stats.Requests))
+				proxyFailuresGauge.WithLabelValues(stats.Pool, stats.URL).Set(float64(stats.Failures))
+				proxyEjectionsGauge.WithLabelValues(stats.Pool, stats.URL).Set(float64(stats.Ejections))
+				proxyHealthyGauge.WithLabelValues(stats.Pool, stats.URL).Set(boolToFloat(stats.Healthy))
+			}
+		}
+
 		dbStats := c.store.DBStats()
 		dbOpenConnectionsGauge.Set(float64(dbStats.OpenConnections))
 		dbConnectionsInUseGauge.Set(float64(dbStats.InUse))
@@ -189,3 +259,10 @@ func (c *collector) GatherStorageMetrics() {
 		dbConnectionsMaxLifetimeClosedGauge.Set(float64(dbStats.MaxLifetimeClosed))
 	}
 }
+
+func boolToFloat(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
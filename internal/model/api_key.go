@@ -4,25 +4,86 @@
 package model // import "miniflux.app/v2/internal/model"
 
 import (
+	"slices"
 	"time"
 )
 
+// Scopes granted to API keys.
+//
+// The Miniflux API scopes are cumulative: entries_write includes read_only,
+// feeds_admin includes entries_write, and full includes everything.
+// The Google Reader and Fever scopes only grant access to their own API.
+const (
+	APIKeyScopeReadOnly     = "read_only"
+	APIKeyScopeEntriesWrite = "entries_write"
+	APIKeyScopeFeedsAdmin   = "feeds_admin"
+	APIKeyScopeFull         = "full"
+	APIKeyScopeGoogleReader = "google_reader"
+	APIKeyScopeFever        = "fever"
+)
+
+var apiKeyScopeLevels = map[string]int{
+	APIKeyScopeReadOnly:     1,
+	APIKeyScopeEntriesWrite: 2,
+	APIKeyScopeFeedsAdmin:   3,
+	APIKeyScopeFull:         4,
+}
+
+// APIKeyScopes returns the list of API key scopes with their translation key.
+func APIKeyScopes() map[string]string {
+	return map[string]string{
+		APIKeyScopeReadOnly:     "form.api_key.scope.read_only",
+		APIKeyScopeEntriesWrite: "form.api_key.scope.entries_write",
+		APIKeyScopeFeedsAdmin:   "form.api_key.scope.feeds_admin",
+		APIKeyScopeFull:         "form.api_key.scope.full",
+		APIKeyScopeGoogleReader: "form.api_key.scope.google_reader",
+		APIKeyScopeFever:        "form.api_key.scope.fever",
+	}
+}
+
 // APIKey represents an application API key.
 // We need to use a pointer for LastUsedAt,
 // as the value obtained from the database might sometimes be nil.
+//
+// Only a hash of the token is stored, the token itself is returned once when the key is created.
 type APIKey struct {
 	ID          int64      `json:"id"`
 	UserID      int64      `json:"user_id"`
-	Token       string     `json:"token"`
+	Token       string     `json:"token,omitempty"`
 	Description string     `json:"description"`
+	Scopes      []string   `json:"scopes"`
+	ExpiresAt   *time.Time `json:"expires_at"`
 	LastUsedAt  *time.Time `json:"last_used_at"`
 	CreatedAt   time.Time  `json:"created_at"`
 }
 
+// IsExpired returns true if the API key has an expiration date in the past.
+func (k *APIKey) IsExpired() bool {
+	return k.ExpiresAt != nil && !k.ExpiresAt.After(time.Now())
+}
+
+// Allows returns true if the API key grants the given scope.
+func (k *APIKey) Allows(scope string) bool {
+	requiredLevel, isAPIScope := apiKeyScopeLevels[scope]
+	if !isAPIScope {
+		return slices.Contains(k.Scopes, scope)
+	}
+
+	for _, keyScope := range k.Scopes {
+		if apiKeyScopeLevels[keyScope] >= requiredLevel {
+			return true
+		}
+	}
+
+	return false
+}
+
 // APIKeys represents a collection of API Key.
 type APIKeys []APIKey
 
 // APIKeyCreationRequest represents the request to create a new API Key.
 type APIKeyCreationRequest struct {
-	Description string `json:"description"`
+	Description string     `json:"description"`
+	Scopes      []string   `json:"scopes"`
+	ExpiresAt   *time.Time `json:"expires_at"`
 }
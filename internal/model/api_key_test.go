@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAPIKeyAllows(t *testing.T) {
+	scenarios := []struct {
+		scopes   []string
+		scope    string
+		expected bool
+	}{
+		{[]string{APIKeyScopeFull}, APIKeyScopeFeedsAdmin, true},
+		{[]string{APIKeyScopeFull}, APIKeyScopeGoogleReader, false},
+		{[]string{APIKeyScopeReadOnly}, APIKeyScopeReadOnly, true},
+		{[]string{APIKeyScopeReadOnly}, APIKeyScopeEntriesWrite, false},
+		{[]string{APIKeyScopeEntriesWrite}, APIKeyScopeReadOnly, true},
+		{[]string{APIKeyScopeEntriesWrite}, APIKeyScopeFeedsAdmin, false},
+		{[]string{APIKeyScopeFeedsAdmin}, APIKeyScopeEntriesWrite, true},
+		{[]string{APIKeyScopeFeedsAdmin}, APIKeyScopeFull, false},
+		{[]string{APIKeyScopeGoogleReader}, APIKeyScopeReadOnly, false},
+		{[]string{APIKeyScopeGoogleReader, APIKeyScopeFever}, APIKeyScopeFever, true},
+		{nil, APIKeyScopeReadOnly, false},
+	}
+
+	for _, scenario := range scenarios {
+		apiKey := &APIKey{Scopes: scenario.scopes}
+		if result := apiKey.Allows(scenario.scope); result != scenario.expected {
+			t.Errorf(`Unexpected result for scopes %v and scope %q, got %v instead of %v`, scenario.scopes, scenario.scope, result, scenario.expected)
+		}
+	}
+}
+
+func TestAPIKeyIsExpired(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	if (&APIKey{}).IsExpired() {
+		t.Error(`An API key without expiration date should not be expired`)
+	}
+
+	if !(&APIKey{ExpiresAt: &past}).IsExpired() {
+		t.Error(`An API key with an expiration date in the past should be expired`)
+	}
+
+	if (&APIKey{ExpiresAt: &future}).IsExpired() {
+		t.Error(`An API key with an expiration date in the future should not be expired`)
+	}
+}
@@ -21,10 +21,11 @@ type SessionData struct {
 	Theme               string          `json:"theme"`
 	LastForceRefresh    string          `json:"last_force_refresh"`
 	WebAuthnSessionData WebAuthnSession `json:"webauthn_session_data"`
+	TOTPPendingLogin    string          `json:"totp_pending_login"`
 }
 
 func (s *SessionData) String() string {
-	return fmt.Sprintf(`CSRF=%q, OAuth2State=%q, OAuth2CodeVerifier=%q, FlashMsg=%q, FlashErrMsg=%q, Lang=%q, Theme=%q, LastForceRefresh=%s, WebAuthnSession=%q`,
+	return fmt.Sprintf(`CSRF=%q, OAuth2State=%q, OAuth2CodeVerifier=%q, FlashMsg=%q, FlashErrMsg=%q, Lang=%q, Theme=%q, LastForceRefresh=%s, WebAuthnSession=%q, TOTPPendingLogin=%q`,
 		s.CSRF,
 		s.OAuth2State,
 		s.OAuth2CodeVerifier,
@@ -34,6 +35,7 @@ func (s *SessionData) String() string {
 		s.Theme,
 		s.LastForceRefresh,
 		s.WebAuthnSessionData,
+		s.TOTPPendingLogin,
 	)
 }
 
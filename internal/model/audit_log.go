@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"time"
+
+	"miniflux.app/v2/internal/timezone"
+)
+
+// Audit log actions.
+const (
+	AuditActionUserCreate        = "user.create"
+	AuditActionUserUpdate        = "user.update"
+	AuditActionUserRemove        = "user.remove"
+	AuditActionUserAdminGrant    = "user.admin_grant"
+	AuditActionUserAdminRevoke   = "user.admin_revoke"
+	AuditActionAPIKeyCreate      = "api_key.create"
+	AuditActionAPIKeyRemove      = "api_key.remove"
+	AuditActionHistoryFlush      = "history.flush"
+	AuditActionIntegrationUpdate = "integration.update"
+
+	AuditActionAuthenticationLockout = "authentication.lockout"
+
+	AuditActionTOTPEnable                = "totp.enable"
+	AuditActionTOTPDisable               = "totp.disable"
+	AuditActionTOTPReset                 = "totp.reset"
+	AuditActionTOTPRecoveryCodesGenerate = "totp.recovery_codes_generate"
+
+	AuditActionInvitationCreate     = "invitation.create"
+	AuditActionInvitationRemove     = "invitation.remove"
+	AuditActionInvitationAccept     = "invitation.accept"
+	AuditActionRegistrationRequest  = "registration.request"
+	AuditActionRegistrationApprove  = "registration.approve"
+	AuditActionRegistrationReject   = "registration.reject"
+	AuditActionRegistrationSettings = "registration.settings_update"
+)
+
+// AuditLog represents an administrative or security-sensitive action performed by a user.
+// The actor ID is 0 for actions performed by the system or from the command line.
+type AuditLog struct {
+	ID            int64     `json:"id"`
+	ActorID       int64     `json:"actor_id"`
+	ActorUsername string    `json:"actor_username"`
+	Action        string    `json:"action"`
+	Target        string    `json:"target"`
+	ClientIP      string    `json:"client_ip"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// NewAuditLog returns a new AuditLog for an action performed by the given user.
+func NewAuditLog(actorID int64, action, target, clientIP string) *AuditLog {
+	return &AuditLog{
+		ActorID:  actorID,
+		Action:   action,
+		Target:   target,
+		ClientIP: clientIP,
+	}
+}
+
+// AuditLogs represents a list of audit log entries.
+type AuditLogs []*AuditLog
+
+// UseTimezone converts the creation date of all entries to the given timezone.
+func (a AuditLogs) UseTimezone(tz string) {
+	for _, auditLog := range a {
+		auditLog.CreatedAt = timezone.Convert(tz, auditLog.CreatedAt)
+	}
+}
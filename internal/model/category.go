@@ -3,7 +3,14 @@
 
 package model // import "miniflux.app/v2/internal/model"
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// CategoryPathSeparator separates the titles of nested categories in a category path.
+const CategoryPathSeparator = "/"
 
 // Category represents a feed category.
 type Category struct {
@@ -11,9 +18,45 @@ type Category struct {
 	Title        string `json:"title"`
 	UserID       int64  `json:"user_id"`
 	HideGlobally bool   `json:"hide_globally"`
+	ParentID     int64  `json:"parent_id"`
+	// Retention policy of the entries, see RetentionPolicies.
+	RetentionPolicy string `json:"retention_policy"`
+	RetentionValue  int    `json:"retention_value"`
+	// Default feed settings, see Feed.InheritCategorySettings.
+	ScraperRules          string `json:"scraper_rules,omitempty"`
+	RewriteRules          string `json:"rewrite_rules,omitempty"`
+	UrlRewriteRules       string `json:"urlrewrite_rules,omitempty"`
+	BlocklistRules        string `json:"blocklist_rules,omitempty"`
+	KeeplistRules         string `json:"keeplist_rules,omitempty"`
+	BlockFilterEntryRules string `json:"block_filter_entry_rules,omitempty"`
+	KeepFilterEntryRules  string `json:"keep_filter_entry_rules,omitempty"`
+	UserAgent             string `json:"user_agent,omitempty"`
+	Cookie                string `json:"cookie,omitempty"`
+	ProxyURL              string `json:"proxy_url,omitempty"`
+	FetchViaProxy         bool   `json:"fetch_via_proxy,omitempty"`
+	Crawler               bool   `json:"crawler,omitempty"`
+	AppriseServiceURLs    string `json:"apprise_service_urls,omitempty"`
+	WebhookURL            string `json:"webhook_url,omitempty"`
+	NtfyEnabled           bool   `json:"ntfy_enabled,omitempty"`
+	NtfyPriority          int    `json:"ntfy_priority,omitempty"`
+	NtfyTopic             string `json:"ntfy_topic,omitempty"`
+	PushoverEnabled       bool   `json:"pushover_enabled,omitempty"`
+	PushoverPriority      int    `json:"pushover_priority,omitempty"`
 	// Pointers are needed to avoid breaking /v1/categories?counts=true
 	FeedCount   *int `json:"feed_count,omitempty"`
 	TotalUnread *int `json:"total_unread,omitempty"`
+
+	// Depth is the nesting level of the category, set by Categories.Tree.
+	Depth int `json:"-"`
+
+	// UndecryptableSecrets holds the stored secrets that could not be decrypted, by column.
+	// They are kept in the database until a new value is set.
+	UndecryptableSecrets map[string]string `json:"-"`
+}
+
+// HideCredentials removes the default cookie of the category.
+func (c *Category) HideCredentials() {
+	c.Cookie = ""
 }
 
 func (c *Category) String() string {
@@ -21,13 +64,39 @@ func (c *Category) String() string {
 }
 
 type CategoryCreationRequest struct {
-	Title        string `json:"title"`
-	HideGlobally bool   `json:"hide_globally"`
+	Title           string `json:"title"`
+	HideGlobally    bool   `json:"hide_globally"`
+	ParentID        int64  `json:"parent_id"`
+	RetentionPolicy string `json:"retention_policy"`
+	RetentionValue  int    `json:"retention_value"`
 }
 
 type CategoryModificationRequest struct {
-	Title        *string `json:"title"`
-	HideGlobally *bool   `json:"hide_globally"`
+	Title           *string `json:"title"`
+	HideGlobally    *bool   `json:"hide_globally"`
+	ParentID        *int64  `json:"parent_id"`
+	RetentionPolicy *string `json:"retention_policy"`
+	RetentionValue  *int    `json:"retention_value"`
+
+	ScraperRules          *string `json:"scraper_rules"`
+	RewriteRules          *string `json:"rewrite_rules"`
+	UrlRewriteRules       *string `json:"urlrewrite_rules"`
+	BlocklistRules        *string `json:"blocklist_rules"`
+	KeeplistRules         *string `json:"keeplist_rules"`
+	BlockFilterEntryRules *string `json:"block_filter_entry_rules"`
+	KeepFilterEntryRules  *string `json:"keep_filter_entry_rules"`
+	UserAgent             *string `json:"user_agent"`
+	Cookie                *string `json:"cookie"`
+	ProxyURL              *string `json:"proxy_url"`
+	FetchViaProxy         *bool   `json:"fetch_via_proxy"`
+	Crawler               *bool   `json:"crawler"`
+	AppriseServiceURLs    *string `json:"apprise_service_urls"`
+	WebhookURL            *string `json:"webhook_url"`
+	NtfyEnabled           *bool   `json:"ntfy_enabled"`
+	NtfyPriority          *int    `json:"ntfy_priority"`
+	NtfyTopic             *string `json:"ntfy_topic"`
+	PushoverEnabled       *bool   `json:"pushover_enabled"`
+	PushoverPriority      *int    `json:"pushover_priority"`
 }
 
 func (c *CategoryModificationRequest) Patch(category *Category) {
@@ -38,7 +107,164 @@ func (c *CategoryModificationRequest) Patch(category *Category) {
 	if c.HideGlobally != nil {
 		category.HideGlobally = *c.HideGlobally
 	}
+
+	if c.ParentID != nil {
+		category.ParentID = *c.ParentID
+	}
+
+	if c.RetentionPolicy != nil {
+		category.RetentionPolicy = *c.RetentionPolicy
+	}
+
+	if c.RetentionValue != nil {
+		category.RetentionValue = *c.RetentionValue
+	}
+
+	if c.ScraperRules != nil {
+		category.ScraperRules = *c.ScraperRules
+	}
+
+	if c.RewriteRules != nil {
+		category.RewriteRules = *c.RewriteRules
+	}
+
+	if c.UrlRewriteRules != nil {
+		category.UrlRewriteRules = *c.UrlRewriteRules
+	}
+
+	if c.BlocklistRules != nil {
+		category.BlocklistRules = *c.BlocklistRules
+	}
+
+	if c.KeeplistRules != nil {
+		category.KeeplistRules = *c.KeeplistRules
+	}
+
+	if c.BlockFilterEntryRules != nil {
+		category.BlockFilterEntryRules = *c.BlockFilterEntryRules
+	}
+
+	if c.KeepFilterEntryRules != nil {
+		category.KeepFilterEntryRules = *c.KeepFilterEntryRules
+	}
+
+	if c.UserAgent != nil {
+		category.UserAgent = *c.UserAgent
+	}
+
+	if c.Cookie != nil {
+		category.Cookie = *c.Cookie
+	}
+
+	if c.ProxyURL != nil {
+		category.ProxyURL = *c.ProxyURL
+	}
+
+	if c.FetchViaProxy != nil {
+		category.FetchViaProxy = *c.FetchViaProxy
+	}
+
+	if c.Crawler != nil {
+		category.Crawler = *c.Crawler
+	}
+
+	if c.AppriseServiceURLs != nil {
+		category.AppriseServiceURLs = *c.AppriseServiceURLs
+	}
+
+	if c.WebhookURL != nil {
+		category.WebhookURL = *c.WebhookURL
+	}
+
+	if c.NtfyEnabled != nil {
+		category.NtfyEnabled = *c.NtfyEnabled
+	}
+
+	if c.NtfyPriority != nil {
+		category.NtfyPriority = *c.NtfyPriority
+	}
+
+	if c.NtfyTopic != nil {
+		category.NtfyTopic = *c.NtfyTopic
+	}
+
+	if c.PushoverEnabled != nil {
+		category.PushoverEnabled = *c.PushoverEnabled
+	}
+
+	if c.PushoverPriority != nil {
+		category.PushoverPriority = *c.PushoverPriority
+	}
 }
 
 // Categories represents a list of categories.
 type Categories []Category
+
+// Tree returns the categories in depth-first order, each category followed by its subcategories.
+// The order of siblings is preserved and the Depth field of each category is set.
+// Categories with a missing parent are considered as top-level categories.
+func (c Categories) Tree() Categories {
+	children := make(map[int64][]Category, len(c))
+	for _, category := range c {
+		parentID := category.ParentID
+		if !c.contains(parentID) {
+			parentID = 0
+		}
+		children[parentID] = append(children[parentID], category)
+	}
+
+	tree := make(Categories, 0, len(c))
+	visited := make(map[int64]bool, len(c))
+
+	var walk func(parentID int64, depth int)
+	walk = func(parentID int64, depth int) {
+		for _, category := range children[parentID] {
+			if visited[category.ID] {
+				continue
+			}
+			visited[category.ID] = true
+			category.Depth = depth
+			tree = append(tree, category)
+			walk(category.ID, depth+1)
+		}
+	}
+	walk(0, 0)
+
+	// Categories that are part of a cycle are never reached from the top level.
+	for _, category := range c {
+		if !visited[category.ID] {
+			children[0] = []Category{category}
+			walk(0, 0)
+		}
+	}
+
+	return tree
+}
+
+// Path returns the titles of the given category and its ancestors, starting with the top-level category.
+func (c Categories) Path(categoryID int64) []string {
+	var path []string
+	visited := make(map[int64]bool)
+
+	for categoryID != 0 && !visited[categoryID] {
+		visited[categoryID] = true
+		index := slices.IndexFunc(c, func(category Category) bool { return category.ID == categoryID })
+		if index == -1 {
+			break
+		}
+		path = append(path, c[index].Title)
+		categoryID = c[index].ParentID
+	}
+
+	slices.Reverse(path)
+	return path
+}
+
+// PathTitle returns the titles of the given category and its ancestors joined by the path separator, e.g. "News/World".
+func (c Categories) PathTitle(categoryID int64) string {
+	return strings.Join(c.Path(categoryID), CategoryPathSeparator)
+}
+
+func (c Categories) contains(categoryID int64) bool {
+	return slices.ContainsFunc(c, func(category Category) bool { return category.ID == categoryID })
+}
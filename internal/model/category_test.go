@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCategoriesTree(t *testing.T) {
+	categories := Categories{
+		{ID: 1, Title: "Europe", ParentID: 3},
+		{ID: 2, Title: "News"},
+		{ID: 3, Title: "World", ParentID: 2},
+		{ID: 4, Title: "Technology"},
+		{ID: 5, Title: "Orphan", ParentID: 42},
+	}
+
+	tree := categories.Tree()
+
+	var ids []int64
+	var depths []int
+	for _, category := range tree {
+		ids = append(ids, category.ID)
+		depths = append(depths, category.Depth)
+	}
+
+	if expected := []int64{2, 3, 1, 4, 5}; !slices.Equal(ids, expected) {
+		t.Errorf(`Unexpected order: got %v instead of %v`, ids, expected)
+	}
+
+	if expected := []int{0, 1, 2, 0, 0}; !slices.Equal(depths, expected) {
+		t.Errorf(`Unexpected depths: got %v instead of %v`, depths, expected)
+	}
+}
+
+func TestCategoriesTreeWithCycle(t *testing.T) {
+	categories := Categories{
+		{ID: 1, Title: "A", ParentID: 2},
+		{ID: 2, Title: "B", ParentID: 1},
+	}
+
+	if tree := categories.Tree(); len(tree) != 2 {
+		t.Errorf(`All categories must be part of the tree, got %d categories`, len(tree))
+	}
+
+	if path := categories.Path(1); !slices.Equal(path, []string{"B", "A"}) {
+		t.Errorf(`Unexpected path: %v`, path)
+	}
+}
+
+func TestCategoriesPath(t *testing.T) {
+	categories := Categories{
+		{ID: 1, Title: "Europe", ParentID: 3},
+		{ID: 2, Title: "News"},
+		{ID: 3, Title: "World", ParentID: 2},
+	}
+
+	if path := categories.PathTitle(1); path != "News/World/Europe" {
+		t.Errorf(`Unexpected path: %q`, path)
+	}
+
+	if path := categories.PathTitle(2); path != "News" {
+		t.Errorf(`Unexpected path: %q`, path)
+	}
+
+	if path := categories.PathTitle(42); path != "" {
+		t.Errorf(`Unknown categories must have an empty path, got %q`, path)
+	}
+}
+
+func TestCategoryModificationRequestPatchFeedSettings(t *testing.T) {
+	category := &Category{Title: "News", UserAgent: "Old UA", Crawler: true}
+	request := &CategoryModificationRequest{
+		UserAgent: SetOptionalField(""),
+		Cookie:    SetOptionalField("session=abc"),
+	}
+	request.Patch(category)
+
+	if category.UserAgent != "" {
+		t.Errorf(`The user agent should be cleared, got %q`, category.UserAgent)
+	}
+
+	if category.Cookie != "session=abc" {
+		t.Errorf(`The cookie should be updated, got %q`, category.Cookie)
+	}
+
+	if !category.Crawler || category.Title != "News" {
+		t.Error(`The fields that are not part of the request should not be modified`)
+	}
+}
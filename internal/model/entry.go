@@ -5,6 +5,10 @@ package model // import "miniflux.app/v2/internal/model"
 
 import (
 	"time"
+
+	"github.com/gorilla/mux"
+
+	"miniflux.app/v2/internal/mediaproxy"
 )
 
 // Entry statuses and default sorting order.
@@ -18,31 +22,44 @@ const (
 
 // Entry represents a feed item in the system.
 type Entry struct {
-	ID          int64         `json:"id"`
-	UserID      int64         `json:"user_id"`
-	FeedID      int64         `json:"feed_id"`
-	Status      string        `json:"status"`
-	Hash        string        `json:"hash"`
-	Title       string        `json:"title"`
-	URL         string        `json:"url"`
-	CommentsURL string        `json:"comments_url"`
-	Date        time.Time     `json:"published_at"`
-	CreatedAt   time.Time     `json:"created_at"`
-	ChangedAt   time.Time     `json:"changed_at"`
-	Content     string        `json:"content"`
-	Author      string        `json:"author"`
-	ShareCode   string        `json:"share_code"`
-	Starred     bool          `json:"starred"`
-	ReadingTime int           `json:"reading_time"`
-	Enclosures  EnclosureList `json:"enclosures"`
-	Feed        *Feed         `json:"feed,omitempty"`
-	Tags        []string      `json:"tags"`
+	ID           int64         `json:"id"`
+	UserID       int64         `json:"user_id"`
+	FeedID       int64         `json:"feed_id"`
+	Status       string        `json:"status"`
+	Hash         string        `json:"hash"`
+	Title        string        `json:"title"`
+	URL          string        `json:"url"`
+	CommentsURL  string        `json:"comments_url"`
+	Date         time.Time     `json:"published_at"`
+	CreatedAt    time.Time     `json:"created_at"`
+	ChangedAt    time.Time     `json:"changed_at"`
+	Content      string        `json:"content"`
+	Author       string        `json:"author"`
+	ShareCode    string        `json:"share_code"`
+	Starred      bool          `json:"starred"`
+	ReadLater    bool          `json:"read_later"`
+	SnoozedUntil *time.Time    `json:"snoozed_until,omitempty"`
+	ReadingTime  int           `json:"reading_time"`
+	Enclosures   EnclosureList `json:"enclosures"`
+	Feed         *Feed         `json:"feed,omitempty"`
+	Tags         []string      `json:"tags"`
+	ThumbnailURL string        `json:"thumbnail_url"`
+	Language     string        `json:"language"`
+	Summary      string        `json:"summary"` // Plain text, it must be escaped when rendered as HTML.
+	Labels       Labels        `json:"labels"`
+	Highlights   Highlights    `json:"highlights,omitempty"`
+
+	// Cached output of the language model, the summary and the tags are merged into the fields above.
+	LLMCacheKey string   `json:"-"`
+	LLMSummary  string   `json:"-"`
+	LLMTags     []string `json:"-"`
 }
 
 func NewEntry() *Entry {
 	return &Entry{
 		Enclosures: make(EnclosureList, 0),
 		Tags:       make([]string, 0),
+		Labels:     make(Labels, 0),
 		Feed: &Feed{
 			Category: &Category{},
 			Icon:     &FeedIcon{},
@@ -66,6 +83,18 @@ func (e *Entry) ShouldMarkAsReadOnView(user *User) bool {
 	return user.MarkReadOnView
 }
 
+// IsSnoozed returns true if the entry is hidden until a later time.
+func (e *Entry) IsSnoozed() bool {
+	return e.SnoozedUntil != nil && e.Status == EntryStatusRead && e.SnoozedUntil.After(time.Now())
+}
+
+// ProxifyThumbnailURL modifies the thumbnail URL to use the media proxy if necessary.
+func (e *Entry) ProxifyThumbnailURL(router *mux.Router, mediaProxyOption string, mediaProxyResourceTypes []string) {
+	if mediaproxy.ShouldProxifyURLWithMimeType(e.ThumbnailURL, "image/*", mediaProxyOption, mediaProxyResourceTypes) {
+		e.ThumbnailURL = mediaproxy.ProxifyAbsoluteURL(router, e.ThumbnailURL)
+	}
+}
+
 // Entries represents a list of entries.
 type Entries []*Entry
 
@@ -75,6 +104,13 @@ type EntriesStatusUpdateRequest struct {
 	Status   string  `json:"status"`
 }
 
+// EntrySnoozeRequest represents a request to hide an entry until the given time.
+//
+// Values without timezone information are interpreted in the user timezone.
+type EntrySnoozeRequest struct {
+	SnoozedUntil string `json:"snoozed_until"`
+}
+
 // EntryUpdateRequest represents a request to update an entry.
 type EntryUpdateRequest struct {
 	Title   *string `json:"title"`
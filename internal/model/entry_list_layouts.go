@@ -0,0 +1,11 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+func EntryListLayouts() map[string]string {
+	return map[string]string{
+		"list":  "form.prefs.select.list",
+		"cards": "form.prefs.select.cards",
+	}
+}
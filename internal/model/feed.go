@@ -4,6 +4,7 @@
 package model // import "miniflux.app/v2/internal/model"
 
 import (
+	"cmp"
 	"fmt"
 	"io"
 	"time"
@@ -22,45 +23,61 @@ const (
 
 // Feed represents a feed in the application.
 type Feed struct {
-	ID                          int64     `json:"id"`
-	UserID                      int64     `json:"user_id"`
-	FeedURL                     string    `json:"feed_url"`
-	SiteURL                     string    `json:"site_url"`
-	Title                       string    `json:"title"`
-	Description                 string    `json:"description"`
-	CheckedAt                   time.Time `json:"checked_at"`
-	NextCheckAt                 time.Time `json:"next_check_at"`
-	EtagHeader                  string    `json:"etag_header"`
-	LastModifiedHeader          string    `json:"last_modified_header"`
-	ParsingErrorMsg             string    `json:"parsing_error_message"`
-	ParsingErrorCount           int       `json:"parsing_error_count"`
-	ScraperRules                string    `json:"scraper_rules"`
-	RewriteRules                string    `json:"rewrite_rules"`
-	BlocklistRules              string    `json:"blocklist_rules"`
-	KeeplistRules               string    `json:"keeplist_rules"`
-	BlockFilterEntryRules       string    `json:"block_filter_entry_rules"`
-	KeepFilterEntryRules        string    `json:"keep_filter_entry_rules"`
-	UrlRewriteRules             string    `json:"urlrewrite_rules"`
-	UserAgent                   string    `json:"user_agent"`
-	Cookie                      string    `json:"cookie"`
-	Username                    string    `json:"username"`
-	Password                    string    `json:"password"`
-	Disabled                    bool      `json:"disabled"`
-	NoMediaPlayer               bool      `json:"no_media_player"`
-	IgnoreHTTPCache             bool      `json:"ignore_http_cache"`
-	AllowSelfSignedCertificates bool      `json:"allow_self_signed_certificates"`
-	FetchViaProxy               bool      `json:"fetch_via_proxy"`
-	HideGlobally                bool      `json:"hide_globally"`
-	DisableHTTP2                bool      `json:"disable_http2"`
-	PushoverEnabled             bool      `json:"pushover_enabled"`
-	NtfyEnabled                 bool      `json:"ntfy_enabled"`
-	Crawler                     bool      `json:"crawler"`
-	AppriseServiceURLs          string    `json:"apprise_service_urls"`
-	WebhookURL                  string    `json:"webhook_url"`
-	NtfyPriority                int       `json:"ntfy_priority"`
-	NtfyTopic                   string    `json:"ntfy_topic"`
-	PushoverPriority            int       `json:"pushover_priority"`
-	ProxyURL                    string    `json:"proxy_url"`
+	ID                          int64      `json:"id"`
+	UserID                      int64      `json:"user_id"`
+	FeedURL                     string     `json:"feed_url"`
+	SiteURL                     string     `json:"site_url"`
+	Title                       string     `json:"title"`
+	Description                 string     `json:"description"`
+	CheckedAt                   time.Time  `json:"checked_at"`
+	NextCheckAt                 time.Time  `json:"next_check_at"`
+	EtagHeader                  string     `json:"etag_header"`
+	LastModifiedHeader          string     `json:"last_modified_header"`
+	ParsingErrorMsg             string     `json:"parsing_error_message"`
+	ParsingErrorCount           int        `json:"parsing_error_count"`
+	ScraperRules                string     `json:"scraper_rules"`
+	RewriteRules                string     `json:"rewrite_rules"`
+	BlocklistRules              string     `json:"blocklist_rules"`
+	KeeplistRules               string     `json:"keeplist_rules"`
+	BlockFilterEntryRules       string     `json:"block_filter_entry_rules"`
+	KeepFilterEntryRules        string     `json:"keep_filter_entry_rules"`
+	UrlRewriteRules             string     `json:"urlrewrite_rules"`
+	UserAgent                   string     `json:"user_agent"`
+	Cookie                      string     `json:"cookie"`
+	HTTPHeaders                 string     `json:"http_headers"`
+	ClientCertificate           string     `json:"client_certificate"`
+	ClientKey                   string     `json:"client_key"`
+	CACertificates              string     `json:"ca_certificates"`
+	Username                    string     `json:"username"`
+	Password                    string     `json:"password"`
+	Disabled                    bool       `json:"disabled"`
+	NoMediaPlayer               bool       `json:"no_media_player"`
+	IgnoreHTTPCache             bool       `json:"ignore_http_cache"`
+	AllowSelfSignedCertificates bool       `json:"allow_self_signed_certificates"`
+	FetchViaProxy               bool       `json:"fetch_via_proxy"`
+	HideGlobally                bool       `json:"hide_globally"`
+	DisableHTTP2                bool       `json:"disable_http2"`
+	PushoverEnabled             bool       `json:"pushover_enabled"`
+	NtfyEnabled                 bool       `json:"ntfy_enabled"`
+	Crawler                     bool       `json:"crawler"`
+	LLMProcessing               bool       `json:"llm_processing"`
+	PausedUntil                 *time.Time `json:"paused_until,omitempty"`
+	HideEntriesWhilePaused      bool       `json:"hide_entries_while_paused"`
+	RetentionPolicy             string     `json:"retention_policy"`
+	RetentionValue              int        `json:"retention_value"`
+	AppriseServiceURLs          string     `json:"apprise_service_urls"`
+	WebhookURL                  string     `json:"webhook_url"`
+	NtfyPriority                int        `json:"ntfy_priority"`
+	NtfyTopic                   string     `json:"ntfy_topic"`
+	PushoverPriority            int        `json:"pushover_priority"`
+	ProxyURL                    string     `json:"proxy_url"`
+	ProxyPool                   string     `json:"proxy_pool"`
+
+	// The flags of the category are used instead of the feed flags when they are inherited.
+	InheritFetchViaProxy   bool `json:"inherit_fetch_via_proxy"`
+	InheritCrawler         bool `json:"inherit_crawler"`
+	InheritNtfyEnabled     bool `json:"inherit_ntfy_enabled"`
+	InheritPushoverEnabled bool `json:"inherit_pushover_enabled"`
 
 	// Non-persisted attributes
 	Category *Category `json:"category,omitempty"`
@@ -73,6 +90,10 @@ type Feed struct {
 	UnreadCount            int           `json:"-"`
 	ReadCount              int           `json:"-"`
 	NumberOfVisibleEntries int           `json:"-"`
+
+	// UndecryptableSecrets holds the stored secrets that could not be decrypted, by column.
+	// They are kept in the database until a new value is set.
+	UndecryptableSecrets map[string]string `json:"-"`
 }
 
 type FeedCounters struct {
@@ -96,12 +117,73 @@ func (f *Feed) WithCategoryID(categoryID int64) {
 	f.Category = &Category{ID: categoryID}
 }
 
+// HideCredentials removes the password, cookie, HTTP headers and client key of the feed and its category.
+func (f *Feed) HideCredentials() {
+	f.Password = ""
+	f.Cookie = ""
+	f.HTTPHeaders = ""
+	f.ClientKey = ""
+	if f.Category != nil {
+		f.Category.HideCredentials()
+	}
+}
+
+// InheritCategorySettings returns a copy of the feed where the settings left empty are inherited from its category.
+// Inherited flags take the value of the category, flags set on the feed are kept even when disabled.
+// The persisted feed is not modified.
+func (f *Feed) InheritCategorySettings() *Feed {
+	feed := *f
+	if f.Category == nil {
+		return &feed
+	}
+
+	category := f.Category
+	feed.ScraperRules = cmp.Or(f.ScraperRules, category.ScraperRules)
+	feed.RewriteRules = cmp.Or(f.RewriteRules, category.RewriteRules)
+	feed.UrlRewriteRules = cmp.Or(f.UrlRewriteRules, category.UrlRewriteRules)
+	feed.BlocklistRules = cmp.Or(f.BlocklistRules, category.BlocklistRules)
+	feed.KeeplistRules = cmp.Or(f.KeeplistRules, category.KeeplistRules)
+	feed.BlockFilterEntryRules = cmp.Or(f.BlockFilterEntryRules, category.BlockFilterEntryRules)
+	feed.KeepFilterEntryRules = cmp.Or(f.KeepFilterEntryRules, category.KeepFilterEntryRules)
+	feed.UserAgent = cmp.Or(f.UserAgent, category.UserAgent)
+	feed.Cookie = cmp.Or(f.Cookie, category.Cookie)
+	feed.ProxyURL = cmp.Or(f.ProxyURL, category.ProxyURL)
+	feed.AppriseServiceURLs = cmp.Or(f.AppriseServiceURLs, category.AppriseServiceURLs)
+	feed.WebhookURL = cmp.Or(f.WebhookURL, category.WebhookURL)
+
+	if f.InheritFetchViaProxy {
+		feed.FetchViaProxy = category.FetchViaProxy
+	}
+
+	if f.InheritCrawler {
+		feed.Crawler = category.Crawler
+	}
+
+	if f.InheritNtfyEnabled && category.NtfyEnabled {
+		feed.NtfyEnabled = true
+		feed.NtfyPriority = category.NtfyPriority
+		feed.NtfyTopic = category.NtfyTopic
+	}
+
+	if f.InheritPushoverEnabled && category.PushoverEnabled {
+		feed.PushoverEnabled = true
+		feed.PushoverPriority = category.PushoverPriority
+	}
+
+	return &feed
+}
+
 // WithTranslatedErrorMessage adds a new error message and increment the error counter.
 func (f *Feed) WithTranslatedErrorMessage(message string) {
 	f.ParsingErrorCount++
 	f.ParsingErrorMsg = message
 }
 
+// IsPaused returns true if the feed should not be refreshed until a later time.
+func (f *Feed) IsPaused() bool {
+	return f.PausedUntil != nil && f.PausedUntil.After(time.Now())
+}
+
 // ResetErrorCounter removes all previous errors.
 func (f *Feed) ResetErrorCounter() {
 	f.ParsingErrorCount = 0
@@ -153,14 +235,21 @@ type FeedCreationRequest struct {
 	CategoryID                  int64  `json:"category_id"`
 	UserAgent                   string `json:"user_agent"`
 	Cookie                      string `json:"cookie"`
+	HTTPHeaders                 string `json:"http_headers"`
+	ClientCertificate           string `json:"client_certificate"`
+	ClientKey                   string `json:"client_key"`
+	CACertificates              string `json:"ca_certificates"`
 	Username                    string `json:"username"`
 	Password                    string `json:"password"`
 	Crawler                     bool   `json:"crawler"`
+	InheritCrawler              bool   `json:"inherit_crawler"`
+	LLMProcessing               bool   `json:"llm_processing"`
 	Disabled                    bool   `json:"disabled"`
 	NoMediaPlayer               bool   `json:"no_media_player"`
 	IgnoreHTTPCache             bool   `json:"ignore_http_cache"`
 	AllowSelfSignedCertificates bool   `json:"allow_self_signed_certificates"`
 	FetchViaProxy               bool   `json:"fetch_via_proxy"`
+	InheritFetchViaProxy        bool   `json:"inherit_fetch_via_proxy"`
 	HideGlobally                bool   `json:"hide_globally"`
 	DisableHTTP2                bool   `json:"disable_http2"`
 	ScraperRules                string `json:"scraper_rules"`
@@ -171,6 +260,7 @@ type FeedCreationRequest struct {
 	KeepFilterEntryRules        string `json:"keep_filter_entry_rules"`
 	UrlRewriteRules             string `json:"urlrewrite_rules"`
 	ProxyURL                    string `json:"proxy_url"`
+	ProxyPool                   string `json:"proxy_pool"`
 }
 
 type FeedCreationRequestFromSubscriptionDiscovery struct {
@@ -195,8 +285,14 @@ type FeedModificationRequest struct {
 	BlockFilterEntryRules       *string `json:"block_filter_entry_rules"`
 	KeepFilterEntryRules        *string `json:"keep_filter_entry_rules"`
 	Crawler                     *bool   `json:"crawler"`
+	InheritCrawler              *bool   `json:"inherit_crawler"`
+	LLMProcessing               *bool   `json:"llm_processing"`
 	UserAgent                   *string `json:"user_agent"`
 	Cookie                      *string `json:"cookie"`
+	HTTPHeaders                 *string `json:"http_headers"`
+	ClientCertificate           *string `json:"client_certificate"`
+	ClientKey                   *string `json:"client_key"`
+	CACertificates              *string `json:"ca_certificates"`
 	Username                    *string `json:"username"`
 	Password                    *string `json:"password"`
 	CategoryID                  *int64  `json:"category_id"`
@@ -205,9 +301,15 @@ type FeedModificationRequest struct {
 	IgnoreHTTPCache             *bool   `json:"ignore_http_cache"`
 	AllowSelfSignedCertificates *bool   `json:"allow_self_signed_certificates"`
 	FetchViaProxy               *bool   `json:"fetch_via_proxy"`
+	InheritFetchViaProxy        *bool   `json:"inherit_fetch_via_proxy"`
 	HideGlobally                *bool   `json:"hide_globally"`
 	DisableHTTP2                *bool   `json:"disable_http2"`
 	ProxyURL                    *string `json:"proxy_url"`
+	ProxyPool                   *string `json:"proxy_pool"`
+	PausedUntil                 *string `json:"paused_until"` // RFC 3339 date, an empty string resumes the feed.
+	HideEntriesWhilePaused      *bool   `json:"hide_entries_while_paused"`
+	RetentionPolicy             *string `json:"retention_policy"`
+	RetentionValue              *int    `json:"retention_value"`
 }
 
 // Patch updates a feed with modified values.
@@ -258,6 +360,15 @@ func (f *FeedModificationRequest) Patch(feed *Feed) {
 
 	if f.Crawler != nil {
 		feed.Crawler = *f.Crawler
+		feed.InheritCrawler = false
+	}
+
+	if f.InheritCrawler != nil {
+		feed.InheritCrawler = *f.InheritCrawler
+	}
+
+	if f.LLMProcessing != nil {
+		feed.LLMProcessing = *f.LLMProcessing
 	}
 
 	if f.UserAgent != nil {
@@ -268,6 +379,22 @@ func (f *FeedModificationRequest) Patch(feed *Feed) {
 		feed.Cookie = *f.Cookie
 	}
 
+	if f.HTTPHeaders != nil {
+		feed.HTTPHeaders = *f.HTTPHeaders
+	}
+
+	if f.ClientCertificate != nil {
+		feed.ClientCertificate = *f.ClientCertificate
+	}
+
+	if f.ClientKey != nil {
+		feed.ClientKey = *f.ClientKey
+	}
+
+	if f.CACertificates != nil {
+		feed.CACertificates = *f.CACertificates
+	}
+
 	if f.Username != nil {
 		feed.Username = *f.Username
 	}
@@ -298,6 +425,11 @@ func (f *FeedModificationRequest) Patch(feed *Feed) {
 
 	if f.FetchViaProxy != nil {
 		feed.FetchViaProxy = *f.FetchViaProxy
+		feed.InheritFetchViaProxy = false
+	}
+
+	if f.InheritFetchViaProxy != nil {
+		feed.InheritFetchViaProxy = *f.InheritFetchViaProxy
 	}
 
 	if f.HideGlobally != nil {
@@ -311,7 +443,38 @@ func (f *FeedModificationRequest) Patch(feed *Feed) {
 	if f.ProxyURL != nil {
 		feed.ProxyURL = *f.ProxyURL
 	}
+
+	if f.ProxyPool != nil {
+		feed.ProxyPool = *f.ProxyPool
+	}
+
+	if f.PausedUntil != nil {
+		if pausedUntil, err := time.Parse(time.RFC3339, *f.PausedUntil); err == nil {
+			feed.PausedUntil = &pausedUntil
+		} else {
+			feed.PausedUntil = nil
+		}
+	}
+
+	if f.HideEntriesWhilePaused != nil {
+		feed.HideEntriesWhilePaused = *f.HideEntriesWhilePaused
+	}
+
+	if f.RetentionPolicy != nil {
+		feed.RetentionPolicy = *f.RetentionPolicy
+	}
+
+	if f.RetentionValue != nil {
+		feed.RetentionValue = *f.RetentionValue
+	}
 }
 
 // Feeds is a list of feed
 type Feeds []*Feed
+
+// HideCredentials removes the credentials of all the feeds, see Feed.HideCredentials.
+func (f Feeds) HideCredentials() {
+	for _, feed := range f {
+		feed.HideCredentials()
+	}
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+// Actions applied to a batch of feeds.
+const (
+	FeedBatchActionUpdate  = "update"
+	FeedBatchActionMove    = "move"
+	FeedBatchActionEnable  = "enable"
+	FeedBatchActionDisable = "disable"
+	FeedBatchActionRefresh = "refresh"
+	FeedBatchActionDelete  = "delete"
+)
+
+// FeedBatchActions returns the list of batch actions with their translation key.
+func FeedBatchActions() map[string]string {
+	return map[string]string{
+		FeedBatchActionUpdate:  "form.feed_batch.action.update",
+		FeedBatchActionMove:    "form.feed_batch.action.move",
+		FeedBatchActionEnable:  "form.feed_batch.action.enable",
+		FeedBatchActionDisable: "form.feed_batch.action.disable",
+		FeedBatchActionRefresh: "form.feed_batch.action.refresh",
+		FeedBatchActionDelete:  "form.feed_batch.action.delete",
+	}
+}
+
+// FeedBatchRequest represents a request to apply the same action to many feeds.
+type FeedBatchRequest struct {
+	FeedIDs    []int64                  `json:"feed_ids"`
+	Action     string                   `json:"action"`
+	CategoryID int64                    `json:"category_id,omitempty"`
+	Changes    *FeedModificationRequest `json:"changes,omitempty"`
+}
+
+// Patch applies the action of the batch request to the given feed.
+// Refresh and delete actions do not modify the feed.
+func (r *FeedBatchRequest) Patch(feed *Feed) {
+	switch r.Action {
+	case FeedBatchActionUpdate:
+		r.Changes.Patch(feed)
+	case FeedBatchActionMove:
+		feed.WithCategoryID(r.CategoryID)
+	case FeedBatchActionEnable:
+		feed.Disabled = false
+	case FeedBatchActionDisable:
+		feed.Disabled = true
+	}
+}
+
+// FeedBatchError represents a validation error of one feed in a batch request.
+type FeedBatchError struct {
+	FeedID       int64  `json:"feed_id"`
+	ErrorMessage string `json:"error_message"`
+}
+
+// FeedBatchResponse represents the result of a batch request.
+// When at least one feed is invalid, the errors are returned with a bad request status and no feed is modified.
+type FeedBatchResponse struct {
+	FeedIDs      []int64          `json:"feed_ids"`
+	ErrorMessage string           `json:"error_message,omitempty"`
+	Errors       []FeedBatchError `json:"errors,omitempty"`
+}
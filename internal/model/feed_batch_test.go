@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "testing"
+
+func TestFeedBatchRequestPatch(t *testing.T) {
+	feed := &Feed{ID: 1, Category: &Category{ID: 1}, UserAgent: "old"}
+
+	(&FeedBatchRequest{Action: FeedBatchActionMove, CategoryID: 2}).Patch(feed)
+	if feed.Category.ID != 2 {
+		t.Errorf(`The feed should be moved to the category #2, got #%d`, feed.Category.ID)
+	}
+
+	(&FeedBatchRequest{Action: FeedBatchActionDisable}).Patch(feed)
+	if !feed.Disabled {
+		t.Error(`The feed should be disabled`)
+	}
+
+	(&FeedBatchRequest{Action: FeedBatchActionEnable}).Patch(feed)
+	if feed.Disabled {
+		t.Error(`The feed should be enabled`)
+	}
+
+	userAgent := "new"
+	(&FeedBatchRequest{Action: FeedBatchActionUpdate, Changes: &FeedModificationRequest{UserAgent: &userAgent}}).Patch(feed)
+	if feed.UserAgent != "new" {
+		t.Errorf(`The user agent should be updated, got %q`, feed.UserAgent)
+	}
+
+	(&FeedBatchRequest{Action: FeedBatchActionRefresh}).Patch(feed)
+	if feed.Category.ID != 2 || feed.Disabled || feed.UserAgent != "new" {
+		t.Error(`The refresh action should not modify the feed`)
+	}
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"fmt"
+	"time"
+)
+
+// Highlight represents a passage of an entry selected by the user, with an optional note.
+// The offsets are character positions in the text content of the entry.
+type Highlight struct {
+	ID          int64     `json:"id"`
+	UserID      int64     `json:"user_id"`
+	EntryID     int64     `json:"entry_id"`
+	Quote       string    `json:"quote"`
+	Note        string    `json:"note"`
+	StartOffset int       `json:"start_offset"`
+	EndOffset   int       `json:"end_offset"`
+	CreatedAt   time.Time `json:"created_at"`
+	ChangedAt   time.Time `json:"changed_at"`
+	Entry       *Entry    `json:"entry,omitempty"`
+}
+
+func (h *Highlight) String() string {
+	return fmt.Sprintf("ID=%d, EntryID=%d, Offsets=%d-%d", h.ID, h.EntryID, h.StartOffset, h.EndOffset)
+}
+
+type HighlightCreationRequest struct {
+	Quote       string `json:"quote"`
+	Note        string `json:"note"`
+	StartOffset int    `json:"start_offset"`
+	EndOffset   int    `json:"end_offset"`
+}
+
+type HighlightModificationRequest struct {
+	Note *string `json:"note"`
+}
+
+func (h *HighlightModificationRequest) Patch(highlight *Highlight) {
+	if h.Note != nil {
+		highlight.Note = *h.Note
+	}
+}
+
+// Highlights represents a list of highlights.
+type Highlights []*Highlight
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/http/httpguts"
+)
+
+var (
+	ErrInvalidHTTPHeader   = errors.New("model: invalid HTTP header")
+	ErrForbiddenHTTPHeader = errors.New("model: forbidden HTTP header")
+)
+
+// forbiddenHTTPHeaders are hop-by-hop headers and headers managed by the HTTP client.
+var forbiddenHTTPHeaders = []string{
+	"Connection",
+	"Content-Length",
+	"Host",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Proxy-Connection",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+// ParseHTTPHeaders parses custom HTTP request headers written as one "Name: Value" pair per line.
+// Empty lines are ignored.
+func ParseHTTPHeaders(value string) (http.Header, error) {
+	headers := make(http.Header)
+
+	for line := range strings.Lines(value) {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		name, headerValue, found := strings.Cut(line, ":")
+		name = strings.TrimSpace(name)
+		headerValue = strings.TrimSpace(headerValue)
+		if !found || !httpguts.ValidHeaderFieldName(name) || !httpguts.ValidHeaderFieldValue(headerValue) {
+			return nil, ErrInvalidHTTPHeader
+		}
+
+		name = http.CanonicalHeaderKey(name)
+		for _, forbiddenHeader := range forbiddenHTTPHeaders {
+			if name == forbiddenHeader {
+				return nil, ErrForbiddenHTTPHeader
+			}
+		}
+
+		headers.Add(name, headerValue)
+	}
+
+	return headers, nil
+}
+
+// RequestHeaders returns the custom HTTP headers sent with the requests of the feed.
+func (f *Feed) RequestHeaders() http.Header {
+	return requestHeaders(f.HTTPHeaders)
+}
+
+// RequestHeaders returns the custom HTTP headers sent when fetching the new feed.
+func (r *FeedCreationRequest) RequestHeaders() http.Header {
+	return requestHeaders(r.HTTPHeaders)
+}
+
+// RequestHeaders returns the custom HTTP headers sent during the discovery.
+func (r *SubscriptionDiscoveryRequest) RequestHeaders() http.Header {
+	return requestHeaders(r.HTTPHeaders)
+}
+
+// requestHeaders parses headers validated beforehand, invalid values are ignored.
+func requestHeaders(value string) http.Header {
+	headers, err := ParseHTTPHeaders(value)
+	if err != nil {
+		return nil
+	}
+	return headers
+}
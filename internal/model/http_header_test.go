@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseHTTPHeaders(t *testing.T) {
+	headers, err := ParseHTTPHeaders("Authorization: Bearer token\n\n  x-api-key : abc:def  \r\nX-Custom: 1\nX-Custom: 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if headers.Get("Authorization") != "Bearer token" {
+		t.Errorf(`Unexpected Authorization header: %q`, headers.Get("Authorization"))
+	}
+
+	if headers.Get("X-Api-Key") != "abc:def" {
+		t.Errorf(`Unexpected X-Api-Key header: %q`, headers.Get("X-Api-Key"))
+	}
+
+	if len(headers.Values("X-Custom")) != 2 {
+		t.Errorf(`Repeated headers should be kept, got %v`, headers.Values("X-Custom"))
+	}
+}
+
+func TestParseInvalidHTTPHeaders(t *testing.T) {
+	scenarios := map[string]error{
+		"Authorization":              ErrInvalidHTTPHeader,
+		"Invalid Name: value":        ErrInvalidHTTPHeader,
+		": value":                    ErrInvalidHTTPHeader,
+		"X-Test: a\x00b":             ErrInvalidHTTPHeader,
+		"Connection: keep-alive":     ErrForbiddenHTTPHeader,
+		"transfer-encoding: chunked": ErrForbiddenHTTPHeader,
+		"Host: example.org":          ErrForbiddenHTTPHeader,
+		"Proxy-Authorization: abc":   ErrForbiddenHTTPHeader,
+	}
+
+	for value, expected := range scenarios {
+		if _, err := ParseHTTPHeaders(value); !errors.Is(err, expected) {
+			t.Errorf(`Parsing %q should return %v, got %v`, value, expected, err)
+		}
+	}
+}
+
+func TestFeedRequestHeaders(t *testing.T) {
+	feed := &Feed{HTTPHeaders: "X-Api-Key: abc"}
+	if feed.RequestHeaders().Get("X-Api-Key") != "abc" {
+		t.Error(`The custom headers of the feed should be returned`)
+	}
+
+	feed.HTTPHeaders = "Upgrade: websocket"
+	if feed.RequestHeaders() != nil {
+		t.Error(`Invalid custom headers should be ignored`)
+	}
+}
@@ -122,4 +122,14 @@ type Integration struct {
 	PushoverToken                    string
 	PushoverDevice                   string
 	PushoverPrefix                   string
+	LLMEnabled                       bool
+	LLMURL                           string
+	LLMAPIKey                        string
+	LLMModel                         string
+	LLMSummarize                     bool
+	LLMTagging                       bool
+
+	// UndecryptableSecrets holds the stored secrets that could not be decrypted, by column.
+	// They are kept in the database until a new value is set.
+	UndecryptableSecrets map[string]string
 }
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"time"
+)
+
+// Invitation represents a link generated by an administrator to let someone create an account.
+//
+// Only a hash of the token is stored, the token itself is returned once when the invitation is created.
+// The accounts created with the invitation use its language and get its categories.
+type Invitation struct {
+	ID          int64
+	Token       string
+	Description string
+	Language    string
+	Categories  []string
+
+	// MaxUses is the number of accounts that can be created with the invitation, 0 means unlimited.
+	MaxUses   int
+	UseCount  int
+	ExpiresAt *time.Time
+	CreatedAt time.Time
+}
+
+// IsExpired returns true if the invitation has an expiration date in the past.
+func (i *Invitation) IsExpired() bool {
+	return i.ExpiresAt != nil && !i.ExpiresAt.After(time.Now())
+}
+
+// IsExhausted returns true if the invitation has been used as many times as allowed.
+func (i *Invitation) IsExhausted() bool {
+	return i.MaxUses > 0 && i.UseCount >= i.MaxUses
+}
+
+// IsUsable returns true if an account can still be created with the invitation.
+func (i *Invitation) IsUsable() bool {
+	return !i.IsExpired() && !i.IsExhausted()
+}
+
+// Invitations represents a list of invitations.
+type Invitations []*Invitation
+
+// InvitationCreationRequest represents the request to create an invitation.
+type InvitationCreationRequest struct {
+	Description string
+	Language    string
+	Categories  []string
+	MaxUses     int
+	ExpiresAt   *time.Time
+}
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInvitationIsUsable(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	scenarios := []struct {
+		invitation *Invitation
+		expected   bool
+	}{
+		{&Invitation{}, true},
+		{&Invitation{MaxUses: 0, UseCount: 100}, true},
+		{&Invitation{MaxUses: 3, UseCount: 2}, true},
+		{&Invitation{MaxUses: 3, UseCount: 3}, false},
+		{&Invitation{MaxUses: 1, ExpiresAt: &future}, true},
+		{&Invitation{MaxUses: 1, ExpiresAt: &past}, false},
+	}
+
+	for _, scenario := range scenarios {
+		if result := scenario.invitation.IsUsable(); result != scenario.expected {
+			t.Errorf(`Unexpected result for %+v, got %v instead of %v`, scenario.invitation, result, scenario.expected)
+		}
+	}
+}
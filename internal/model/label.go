@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "fmt"
+
+// Label represents a label defined by the user and attached to individual entries.
+type Label struct {
+	ID     int64  `json:"id"`
+	UserID int64  `json:"user_id"`
+	Title  string `json:"title"`
+	// Pointers are needed to omit the counters when they are not fetched.
+	EntryCount  *int `json:"entry_count,omitempty"`
+	UnreadCount *int `json:"unread_count,omitempty"`
+}
+
+func (l *Label) String() string {
+	return fmt.Sprintf("ID=%d, UserID=%d, Title=%s", l.ID, l.UserID, l.Title)
+}
+
+type LabelCreationRequest struct {
+	Title string `json:"title"`
+}
+
+type LabelModificationRequest struct {
+	Title *string `json:"title"`
+}
+
+func (l *LabelModificationRequest) Patch(label *Label) {
+	if l.Title != nil {
+		label.Title = *l.Title
+	}
+}
+
+// EntryLabelsRequest represents the request to replace the labels of an entry.
+type EntryLabelsRequest struct {
+	LabelIDs []int64 `json:"label_ids"`
+}
+
+// Labels represents a list of labels.
+type Labels []Label
+
+// Contains returns true if the list contains the given label.
+func (l Labels) Contains(labelID int64) bool {
+	for _, label := range l {
+		if label.ID == labelID {
+			return true
+		}
+	}
+	return false
+}
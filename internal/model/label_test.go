@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "testing"
+
+func TestLabelsContains(t *testing.T) {
+	labels := Labels{{ID: 1, Title: "To Read"}, {ID: 3, Title: "Work"}}
+
+	if !labels.Contains(3) {
+		t.Error(`The label #3 should be part of the list`)
+	}
+
+	if labels.Contains(2) {
+		t.Error(`The label #2 should not be part of the list`)
+	}
+
+	if (Labels{}).Contains(1) {
+		t.Error(`An empty list should not contain any label`)
+	}
+}
+
+func TestLabelModificationRequestPatch(t *testing.T) {
+	label := &Label{ID: 1, Title: "Old"}
+
+	(&LabelModificationRequest{}).Patch(label)
+	if label.Title != "Old" {
+		t.Errorf(`The title should not change when not provided, got %q`, label.Title)
+	}
+
+	title := "New"
+	(&LabelModificationRequest{Title: &title}).Patch(label)
+	if label.Title != "New" {
+		t.Errorf(`Unexpected title: %q`, label.Title)
+	}
+}
@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"time"
+)
+
+// RegistrationRequest represents an account requested with the open registration form.
+// The account is created once an administrator approves the request.
+type RegistrationRequest struct {
+	ID        int64
+	Username  string
+	CreatedAt time.Time
+}
+
+// RegistrationRequests represents a list of registration requests.
+type RegistrationRequests []*RegistrationRequest
@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+// Retention policies applied by the cleanup task.
+//
+// The policy of a feed overrides the policy of its category, which overrides
+// the policy of the parent categories and then the policy of the user.
+// The global archive intervals are used when no policy is defined.
+const (
+	RetentionPolicyDefault = ""      // Inherited from the upper level.
+	RetentionPolicyDays    = "days"  // Entries older than N days are archived.
+	RetentionPolicyCount   = "count" // Only the N most recent entries are kept.
+	RetentionPolicyNever   = "never" // Entries are never archived.
+)
+
+// RetentionPolicies returns the list of retention policies with their translation key.
+func RetentionPolicies() map[string]string {
+	return map[string]string{
+		RetentionPolicyDefault: "form.retention.select.default",
+		RetentionPolicyDays:    "form.retention.select.days",
+		RetentionPolicyCount:   "form.retention.select.count",
+		RetentionPolicyNever:   "form.retention.select.never",
+	}
+}
@@ -8,9 +8,14 @@ type SubscriptionDiscoveryRequest struct {
 	URL                         string `json:"url"`
 	UserAgent                   string `json:"user_agent"`
 	Cookie                      string `json:"cookie"`
+	HTTPHeaders                 string `json:"http_headers"`
+	ClientCertificate           string `json:"client_certificate"`
+	ClientKey                   string `json:"client_key"`
+	CACertificates              string `json:"ca_certificates"`
 	Username                    string `json:"username"`
 	Password                    string `json:"password"`
 	ProxyURL                    string `json:"proxy_url"`
+	ProxyPool                   string `json:"proxy_pool"`
 	FetchViaProxy               bool   `json:"fetch_via_proxy"`
 	AllowSelfSignedCertificates bool   `json:"allow_self_signed_certificates"`
 	DisableHTTP2                bool   `json:"disable_http2"`
@@ -43,6 +43,11 @@ type User struct {
 	KeepFilterEntryRules            string     `json:"keep_filter_entry_rules"`
 	AlwaysOpenExternalLinks         bool       `json:"always_open_external_links"`
 	OpenExternalLinksInNewTab       bool       `json:"open_external_links_in_new_tab"`
+	EntryListLayout                 string     `json:"entry_list_layout"`
+	RetentionPolicy                 string     `json:"retention_policy"`
+	RetentionValue                  int        `json:"retention_value"`
+	TOTPEnabled                     bool       `json:"totp_enabled"`
+	TOTPRequired                    bool       `json:"totp_required"`
 }
 
 // UserCreationRequest represents the request to create a user.
@@ -86,6 +91,10 @@ type UserModificationRequest struct {
 	KeepFilterEntryRules            *string  `json:"keep_filter_entry_rules"`
 	AlwaysOpenExternalLinks         *bool    `json:"always_open_external_links"`
 	OpenExternalLinksInNewTab       *bool    `json:"open_external_links_in_new_tab"`
+	EntryListLayout                 *string  `json:"entry_list_layout"`
+	RetentionPolicy                 *string  `json:"retention_policy"`
+	RetentionValue                  *int     `json:"retention_value"`
+	TOTPRequired                    *bool    `json:"totp_required"`
 }
 
 // Patch updates the User object with the modification request.
@@ -209,6 +218,33 @@ func (u *UserModificationRequest) Patch(user *User) {
 	if u.OpenExternalLinksInNewTab != nil {
 		user.OpenExternalLinksInNewTab = *u.OpenExternalLinksInNewTab
 	}
+
+	if u.EntryListLayout != nil {
+		user.EntryListLayout = *u.EntryListLayout
+	}
+
+	if u.RetentionPolicy != nil {
+		user.RetentionPolicy = *u.RetentionPolicy
+	}
+
+	if u.RetentionValue != nil {
+		user.RetentionValue = *u.RetentionValue
+	}
+
+	if u.TOTPRequired != nil {
+		user.TOTPRequired = *u.TOTPRequired
+	}
+}
+
+// MustEnrollTOTP returns true if the user has to configure two-factor authentication before using the application.
+func (u *User) MustEnrollTOTP(requiredForEveryone bool) bool {
+	return !u.TOTPEnabled && (u.TOTPRequired || requiredForEveryone)
+}
+
+// RequiresTOTP returns true if a password alone is not enough to authenticate the user,
+// either because two-factor authentication is enabled or because it has to be configured first.
+func (u *User) RequiresTOTP(requiredForEveryone bool) bool {
+	return u.TOTPEnabled || u.MustEnrollTOTP(requiredForEveryone)
 }
 
 // UseTimezone converts last login date to the given timezone.
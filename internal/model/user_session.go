@@ -18,12 +18,21 @@ type UserSession struct {
 	CreatedAt time.Time
 	UserAgent string
 	IP        string
+
+	UserHasPassword  bool
+	UserTOTPEnabled  bool
+	UserTOTPRequired bool
 }
 
 func (u *UserSession) String() string {
 	return fmt.Sprintf(`ID=%q, UserID=%q, IP=%q, Token=%q`, u.ID, u.UserID, u.IP, u.Token)
 }
 
+// MustEnrollTOTP returns true if the user has a password and has to configure TOTP before using the application.
+func (u *UserSession) MustEnrollTOTP(requiredForEveryone bool) bool {
+	return u.UserHasPassword && !u.UserTOTPEnabled && (u.UserTOTPRequired || requiredForEveryone)
+}
+
 // UseTimezone converts creation date to the given timezone.
 func (u *UserSession) UseTimezone(tz string) {
 	u.CreatedAt = timezone.Convert(tz, u.CreatedAt)
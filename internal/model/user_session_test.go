@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "testing"
+
+func TestUserSessionMustEnrollTOTP(t *testing.T) {
+	scenarios := []struct {
+		session             UserSession
+		requiredForEveryone bool
+		expected            bool
+	}{
+		{UserSession{UserHasPassword: true}, false, false},
+		{UserSession{UserHasPassword: true}, true, true},
+		{UserSession{UserHasPassword: true, UserTOTPRequired: true}, false, true},
+		{UserSession{UserHasPassword: true, UserTOTPRequired: true, UserTOTPEnabled: true}, false, false},
+		{UserSession{UserHasPassword: true, UserTOTPEnabled: true}, true, false},
+		{UserSession{UserTOTPRequired: true}, true, false},
+	}
+
+	for _, scenario := range scenarios {
+		if result := scenario.session.MustEnrollTOTP(scenario.requiredForEveryone); result != scenario.expected {
+			t.Errorf(`Unexpected result for %+v with requiredForEveryone=%v, got %v instead of %v`, scenario.session, scenario.requiredForEveryone, result, scenario.expected)
+		}
+	}
+}
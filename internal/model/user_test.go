@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "testing"
+
+func TestUserRequiresTOTP(t *testing.T) {
+	scenarios := []struct {
+		user                User
+		requiredForEveryone bool
+		expected            bool
+	}{
+		{User{}, false, false},
+		{User{TOTPEnabled: true}, false, true},
+		{User{TOTPRequired: true}, false, true},
+		{User{}, true, true},
+		{User{TOTPEnabled: true, TOTPRequired: true}, true, true},
+	}
+
+	for _, scenario := range scenarios {
+		if result := scenario.user.RequiresTOTP(scenario.requiredForEveryone); result != scenario.expected {
+			t.Errorf(`Unexpected result for TOTPEnabled=%v TOTPRequired=%v with requiredForEveryone=%v, got %v instead of %v`,
+				scenario.user.TOTPEnabled, scenario.user.TOTPRequired, scenario.requiredForEveryone, result, scenario.expected)
+		}
+	}
+}
@@ -7,36 +7,110 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
 )
 
+// oidcDiscoveryTimeout bounds the requests sent to an OpenID Connect provider, including the discovery.
+const oidcDiscoveryTimeout = 10 * time.Second
+
 type Manager struct {
-	providers map[string]Provider
+	mutex         sync.Mutex
+	providers     map[string]Provider
+	oidcProviders map[string]*oidcProviderSettings
+}
+
+type oidcProviderSettings struct {
+	// discoveryMutex serializes the discovery of the provider without blocking the other providers.
+	discoveryMutex    sync.Mutex
+	clientID          string
+	clientSecret      string
+	redirectURL       string
+	discoveryEndpoint string
+	roleMapping       *RoleMapping
 }
 
-func (m *Manager) FindProvider(name string) (Provider, error) {
+// FindProvider returns the provider with the given name.
+// OpenID Connect providers are discovered on first use and cached, the discovery is retried on the next call when it fails.
+func (m *Manager) FindProvider(ctx context.Context, name string) (Provider, error) {
+	provider, settings := m.lookupProvider(name)
+	if provider != nil {
+		return provider, nil
+	}
+
+	if settings == nil {
+		return nil, errors.New("oauth2 provider not found")
+	}
+
+	settings.discoveryMutex.Lock()
+	defer settings.discoveryMutex.Unlock()
+
+	// Another request may have discovered the provider in the meantime.
+	if provider, _ := m.lookupProvider(name); provider != nil {
+		return provider, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, oidcDiscoveryTimeout)
+	defer cancel()
+
+	// The HTTP client is kept by the provider to fetch the signing keys later on.
+	ctx = oidc.ClientContext(ctx, &http.Client{Timeout: oidcDiscoveryTimeout})
+
+	oidcProvider, err := NewOidcProvider(ctx, name, settings.clientID, settings.clientSecret, settings.redirectURL, settings.discoveryEndpoint, settings.roleMapping)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("OIDC provider discovered",
+		slog.String("provider", name),
+		slog.String("discovery_endpoint", settings.discoveryEndpoint),
+	)
+	m.AddProvider(name, oidcProvider)
+	return oidcProvider, nil
+}
+
+// lookupProvider returns the cached provider, or the settings of the OpenID Connect provider to discover.
+func (m *Manager) lookupProvider(name string) (Provider, *oidcProviderSettings) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
 	if provider, found := m.providers[name]; found {
 		return provider, nil
 	}
 
-	return nil, errors.New("oauth2 provider not found")
+	return nil, m.oidcProviders[name]
 }
 
 func (m *Manager) AddProvider(name string, provider Provider) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
 	m.providers[name] = provider
 }
 
-func NewManager(ctx context.Context, clientID, clientSecret, redirectURL, oidcDiscoveryEndpoint string) *Manager {
-	m := &Manager{providers: make(map[string]Provider)}
+// AddOIDCProvider registers an OpenID Connect provider, the discovery endpoint is fetched by FindProvider.
+func (m *Manager) AddOIDCProvider(name, clientID, clientSecret, redirectURL, discoveryEndpoint string, roleMapping *RoleMapping) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.oidcProviders[name] = &oidcProviderSettings{
+		clientID:          clientID,
+		clientSecret:      clientSecret,
+		redirectURL:       redirectURL,
+		discoveryEndpoint: discoveryEndpoint,
+		roleMapping:       roleMapping,
+	}
+}
+
+func NewManager(clientID, clientSecret, redirectURL, oidcDiscoveryEndpoint string, oidcRoleMapping *RoleMapping) *Manager {
+	m := &Manager{providers: make(map[string]Provider), oidcProviders: make(map[string]*oidcProviderSettings)}
 	m.AddProvider("google", NewGoogleProvider(clientID, clientSecret, redirectURL))
 
 	if oidcDiscoveryEndpoint != "" {
-		if genericOidcProvider, err := NewOidcProvider(ctx, clientID, clientSecret, redirectURL, oidcDiscoveryEndpoint); err != nil {
-			slog.Error("Failed to initialize OIDC provider",
-				slog.Any("error", err),
-			)
-		} else {
-			m.AddProvider("oidc", genericOidcProvider)
-		}
+		m.AddOIDCProvider(DefaultOIDCProvider, clientID, clientSecret, redirectURL, oidcDiscoveryEndpoint, oidcRoleMapping)
 	}
 
 	if clientSecret == "" {
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2 // import "miniflux.app/v2/internal/oauth2"
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestFindProviderDoesNotWaitForSlowDiscovery(t *testing.T) {
+	requested := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(requested)
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	manager := NewManager("client_id", "client_secret", "https://miniflux.example.org/oauth2/callback", "", nil)
+	manager.AddOIDCProvider("slow", "client_id", "client_secret", "https://miniflux.example.org/oauth2/callback", server.URL, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	discoveryErr := make(chan error, 1)
+	go func() {
+		_, err := manager.FindProvider(ctx, "slow")
+		discoveryErr <- err
+	}()
+	<-requested
+
+	start := time.Now()
+	if _, err := manager.FindProvider(context.Background(), "google"); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf(`The discovery of another provider should not block, waited %v`, elapsed)
+	}
+
+	cancel()
+	select {
+	case err := <-discoveryErr:
+		if err == nil {
+			t.Error(`A canceled discovery should fail`)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal(`The discovery should stop when the request is canceled`)
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"miniflux.app/v2/internal/model"
 
@@ -15,30 +17,67 @@ import (
 )
 
 var (
-	ErrEmptyUsername = errors.New("oidc: username is empty")
+	ErrEmptyUsername  = errors.New("oidc: username is empty")
+	ErrInvalidSubject = errors.New("oidc: the subject contains a reserved character")
+)
+
+const (
+	// DefaultOIDCProvider is the name of the OpenID Connect provider configured with the OAUTH2_* settings.
+	DefaultOIDCProvider = "oidc"
+
+	// oidcIdentitySeparator separates the provider name from the subject in the identities of the additional providers.
+	// The subjects containing it are refused, so an identity always belongs to a single provider.
+	oidcIdentitySeparator = "\x1f"
 )
 
 type oidcProvider struct {
+	name         string
 	clientID     string
 	clientSecret string
 	redirectURL  string
+	roleMapping  *RoleMapping
 	provider     *oidc.Provider
 }
 
-func NewOidcProvider(ctx context.Context, clientID, clientSecret, redirectURL, discoveryEndpoint string) (*oidcProvider, error) {
+func NewOidcProvider(ctx context.Context, name, clientID, clientSecret, redirectURL, discoveryEndpoint string, roleMapping *RoleMapping) (*oidcProvider, error) {
 	provider, err := oidc.NewProvider(ctx, discoveryEndpoint)
 	if err != nil {
 		return nil, fmt.Errorf(`oidc: failed to initialize provider %q: %w`, discoveryEndpoint, err)
 	}
 
 	return &oidcProvider{
+		name:         name,
 		clientID:     clientID,
 		clientSecret: clientSecret,
 		redirectURL:  redirectURL,
+		roleMapping:  roleMapping,
 		provider:     provider,
 	}, nil
 }
 
+// OIDCIdentity returns the identity stored in User.OpenIDConnectID for the subject of a provider.
+// The subjects of the additional providers are prefixed with their name, since they are only unique for a given issuer.
+func OIDCIdentity(providerName, subject string) string {
+	if providerName == DefaultOIDCProvider {
+		return subject
+	}
+
+	return providerName + oidcIdentitySeparator + subject
+}
+
+// OIDCIdentityProvider returns the name of the provider of an identity stored in User.OpenIDConnectID.
+func OIDCIdentityProvider(identity string, providerNames []string) string {
+	if identity == "" {
+		return ""
+	}
+
+	if name, _, found := strings.Cut(identity, oidcIdentitySeparator); found && slices.Contains(providerNames, name) {
+		return name
+	}
+
+	return DefaultOIDCProvider
+}
+
 func (o *oidcProvider) GetUserExtraKey() string {
 	return "openid_connect_id"
 }
@@ -65,9 +104,20 @@ func (o *oidcProvider) GetProfile(ctx context.Context, code, codeVerifier string
 		return nil, fmt.Errorf(`oidc: failed to get user info: %w`, err)
 	}
 
+	if strings.Contains(userInfo.Subject, oidcIdentitySeparator) {
+		return nil, ErrInvalidSubject
+	}
+
 	profile := &Profile{
-		Key: o.GetUserExtraKey(),
-		ID:  userInfo.Subject,
+		Key:         o.GetUserExtraKey(),
+		ID:          OIDCIdentity(o.name, userInfo.Subject),
+		roleMapping: o.roleMapping,
+	}
+
+	if o.roleMapping.isEnabled() {
+		if profile.Groups, err = o.groups(ctx, token, userInfo); err != nil {
+			return nil, err
+		}
 	}
 
 	var userClaims userClaims
@@ -91,6 +141,35 @@ func (o *oidcProvider) GetProfile(ctx context.Context, code, codeVerifier string
 	return profile, nil
 }
 
+// groups returns the groups found in the claims of the ID token and of the user info endpoint.
+func (o *oidcProvider) groups(ctx context.Context, token *oauth2.Token, userInfo *oidc.UserInfo) ([]string, error) {
+	var userInfoClaims map[string]any
+	if err := userInfo.Claims(&userInfoClaims); err != nil {
+		return nil, fmt.Errorf(`oidc: failed to parse user info claims: %w`, err)
+	}
+
+	claimSets := []map[string]any{userInfoClaims}
+
+	if rawIDToken, ok := token.Extra("id_token").(string); ok && rawIDToken != "" {
+		idToken, err := o.provider.Verifier(&oidc.Config{ClientID: o.clientID}).Verify(ctx, rawIDToken)
+		if err != nil {
+			return nil, fmt.Errorf(`oidc: failed to verify ID token: %w`, err)
+		}
+
+		if idToken.Subject != userInfo.Subject {
+			return nil, fmt.Errorf(`oidc: the ID token subject %q does not match the user info subject %q`, idToken.Subject, userInfo.Subject)
+		}
+
+		var idTokenClaims map[string]any
+		if err := idToken.Claims(&idTokenClaims); err != nil {
+			return nil, fmt.Errorf(`oidc: failed to parse ID token claims: %w`, err)
+		}
+		claimSets = append(claimSets, idTokenClaims)
+	}
+
+	return o.roleMapping.groups(claimSets...), nil
+}
+
 func (o *oidcProvider) PopulateUserCreationWithProfileID(user *model.UserCreationRequest, profile *Profile) {
 	user.OpenIDConnectID = profile.ID
 }
@@ -12,6 +12,28 @@ type Profile struct {
 	Key      string
 	ID       string
 	Username string
+
+	// Groups contains the values of the group claims of OpenID Connect profiles.
+	Groups []string
+
+	roleMapping *RoleMapping
+}
+
+// IsAllowed returns false when the provider requires groups and the user is not a member of any of them.
+func (p Profile) IsAllowed() bool {
+	if p.roleMapping == nil || len(p.roleMapping.RequiredGroups) == 0 {
+		return true
+	}
+	return containsAny(p.Groups, p.roleMapping.RequiredGroups)
+}
+
+// AdminFlag returns the admin flag derived from the groups of the user.
+// The admin flag is not managed by the provider when managed is false.
+func (p Profile) AdminFlag() (isAdmin, managed bool) {
+	if p.roleMapping == nil || len(p.roleMapping.AdminGroups) == 0 {
+		return false, false
+	}
+	return containsAny(p.Groups, p.roleMapping.AdminGroups), true
 }
 
 func (p Profile) String() string {
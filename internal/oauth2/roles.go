@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2 // import "miniflux.app/v2/internal/oauth2"
+
+import (
+	"slices"
+	"strings"
+)
+
+// RoleMapping maps the group and role claims of OpenID Connect users to their access and admin flag.
+type RoleMapping struct {
+	// GroupsClaims are the claims containing the groups of the user. Nested claims are separated by dots.
+	GroupsClaims []string
+
+	// Members of AdminGroups are administrators. The admin flag is not managed when it is empty.
+	AdminGroups []string
+
+	// Users must be a member of at least one of the RequiredGroups. Everyone is allowed when it is empty.
+	RequiredGroups []string
+}
+
+func (m *RoleMapping) isEnabled() bool {
+	return m != nil && (len(m.AdminGroups) > 0 || len(m.RequiredGroups) > 0)
+}
+
+// groups returns the sorted and deduplicated values of the group claims found in the claim sets.
+func (m *RoleMapping) groups(claimSets ...map[string]any) []string {
+	var groups []string
+	for _, claims := range claimSets {
+		for _, claim := range m.GroupsClaims {
+			groups = append(groups, claimValues(claims, claim)...)
+		}
+	}
+
+	slices.Sort(groups)
+	return slices.Compact(groups)
+}
+
+// claimValues returns the string values of a claim. Claims names containing dots, like the namespaced
+// claims of some providers, are looked up as is before being considered as nested claims.
+func claimValues(claims map[string]any, name string) []string {
+	value, found := claims[name]
+	if !found {
+		parent, child, nested := strings.Cut(name, ".")
+		if !nested {
+			return nil
+		}
+
+		parentClaims, ok := claims[parent].(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		return claimValues(parentClaims, child)
+	}
+
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+
+	return nil
+}
+
+func containsAny(values, candidates []string) bool {
+	return slices.ContainsFunc(candidates, func(candidate string) bool {
+		return slices.Contains(values, candidate)
+	})
+}
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2 // import "miniflux.app/v2/internal/oauth2"
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRoleMappingGroups(t *testing.T) {
+	mapping := &RoleMapping{
+		GroupsClaims: []string{"groups", "realm_access.roles", "https://example.org/roles"},
+	}
+
+	idTokenClaims := map[string]any{
+		"groups": []any{"readers", "admins", 42},
+		"realm_access": map[string]any{
+			"roles": []any{"offline_access"},
+		},
+	}
+
+	userInfoClaims := map[string]any{
+		"groups":                    "readers",
+		"https://example.org/roles": []any{"editors"},
+	}
+
+	expected := []string{"admins", "editors", "offline_access", "readers"}
+	if groups := mapping.groups(idTokenClaims, userInfoClaims); !slices.Equal(groups, expected) {
+		t.Errorf(`Unexpected groups, got %v instead of %v`, groups, expected)
+	}
+}
+
+func TestRoleMappingGroupsWithMissingClaims(t *testing.T) {
+	mapping := &RoleMapping{
+		GroupsClaims: []string{"groups", "realm_access.roles"},
+	}
+
+	claims := map[string]any{
+		"realm_access": "not an object",
+	}
+
+	if groups := mapping.groups(claims); len(groups) != 0 {
+		t.Errorf(`No groups should be found, got %v`, groups)
+	}
+}
+
+func TestProfileRoles(t *testing.T) {
+	scenarios := []struct {
+		mapping         *RoleMapping
+		groups          []string
+		expectedAllowed bool
+		expectedAdmin   bool
+		expectedManaged bool
+	}{
+		{nil, []string{"admins"}, true, false, false},
+		{&RoleMapping{}, nil, true, false, false},
+		{&RoleMapping{RequiredGroups: []string{"readers"}}, []string{"readers"}, true, false, false},
+		{&RoleMapping{RequiredGroups: []string{"readers"}}, []string{"others"}, false, false, false},
+		{&RoleMapping{AdminGroups: []string{"admins"}}, []string{"readers", "admins"}, true, true, true},
+		{&RoleMapping{AdminGroups: []string{"admins"}}, []string{"readers"}, true, false, true},
+	}
+
+	for _, scenario := range scenarios {
+		profile := Profile{Groups: scenario.groups, roleMapping: scenario.mapping}
+
+		if allowed := profile.IsAllowed(); allowed != scenario.expectedAllowed {
+			t.Errorf(`Unexpected access for %v with %+v, got %v`, scenario.groups, scenario.mapping, allowed)
+		}
+
+		isAdmin, managed := profile.AdminFlag()
+		if isAdmin != scenario.expectedAdmin || managed != scenario.expectedManaged {
+			t.Errorf(`Unexpected admin flag for %v with %+v, got %v/%v`, scenario.groups, scenario.mapping, isAdmin, managed)
+		}
+	}
+}
+
+func TestOIDCIdentity(t *testing.T) {
+	if identity := OIDCIdentity(DefaultOIDCProvider, "subject"); identity != "subject" {
+		t.Errorf(`The identities of the default provider should not be prefixed, got %q`, identity)
+	}
+
+	if identity := OIDCIdentity("keycloak", "subject"); identity != "keycloak\x1fsubject" {
+		t.Errorf(`Unexpected identity, got %q`, identity)
+	}
+}
+
+func TestOIDCIdentityProvider(t *testing.T) {
+	providerNames := []string{"keycloak", "authentik"}
+
+	scenarios := map[string]string{
+		"":                                  "",
+		"0c8a5fb1-subject":                  DefaultOIDCProvider,
+		OIDCIdentity("keycloak", "subject"): "keycloak",
+		OIDCIdentity("authentik", "a:b"):    "authentik",
+		OIDCIdentity("keycloakish", "sub"):  DefaultOIDCProvider,
+		"keycloak:subject":                  DefaultOIDCProvider,
+		"dex:subject-from-oidc":             DefaultOIDCProvider,
+		OIDCIdentity(DefaultOIDCProvider, "keycloak:subject"): DefaultOIDCProvider,
+	}
+
+	for identity, expected := range scenarios {
+		if result := OIDCIdentityProvider(identity, providerNames); result != expected {
+			t.Errorf(`Unexpected provider for %q, got %q instead of %q`, identity, result, expected)
+		}
+	}
+}
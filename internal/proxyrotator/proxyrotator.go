@@ -4,53 +4,286 @@
 package proxyrotator // import "miniflux.app/v2/internal/proxyrotator"
 
 import (
+	"fmt"
+	"log/slog"
+	"net"
 	"net/url"
 	"sync"
+	"time"
 )
 
+const (
+	defaultMaxFailures      = 3
+	defaultEjectionDuration = 5 * time.Minute
+	healthCheckTimeout      = 5 * time.Second
+)
+
+// ProxyRotatorInstance is the default pool built from the HTTP_CLIENT_PROXIES option.
 var ProxyRotatorInstance *ProxyRotator
 
+// ProxyPools contains the named pools that feeds can select, indexed by name.
+var ProxyPools = make(map[string]*ProxyRotator)
+
+type proxy struct {
+	url                 *url.URL
+	consecutiveFailures int
+	ejectedUntil        time.Time
+	requests            int64
+	failures            int64
+	ejections           int64
+}
+
+// ProxyStats represents the health and the counters of a single proxy.
+type ProxyStats struct {
+	Pool      string
+	URL       string
+	Healthy   bool
+	Requests  int64
+	Failures  int64
+	Ejections int64
+}
+
 // ProxyRotator manages a list of proxies and rotates through them.
+// Proxies failing too many times in a row are ejected from the rotation for a while.
 type ProxyRotator struct {
-	proxies      []*url.URL
-	currentIndex int
-	mutex        sync.Mutex
+	name             string
+	proxies          []*proxy
+	currentIndex     int
+	maxFailures      int
+	ejectionDuration time.Duration
+	mutex            sync.Mutex
+	now              func() time.Time
 }
 
 // NewProxyRotator creates a new ProxyRotator with the given proxy URLs.
 func NewProxyRotator(proxyURLs []string) (*ProxyRotator, error) {
-	parsedProxies := make([]*url.URL, 0, len(proxyURLs))
+	parsedProxies := make([]*proxy, 0, len(proxyURLs))
 
 	for _, p := range proxyURLs {
 		proxyURL, err := url.Parse(p)
 		if err != nil {
 			return nil, err
 		}
-		parsedProxies = append(parsedProxies, proxyURL)
+
+		switch proxyURL.Scheme {
+		case "http", "https", "socks5", "socks5h":
+		default:
+			return nil, fmt.Errorf("proxyrotator: unsupported proxy scheme %q", proxyURL.Scheme)
+		}
+
+		parsedProxies = append(parsedProxies, &proxy{url: proxyURL})
 	}
 
 	return &ProxyRotator{
-		proxies:      parsedProxies,
-		currentIndex: 0,
-		mutex:        sync.Mutex{},
+		proxies:          parsedProxies,
+		currentIndex:     0,
+		maxFailures:      defaultMaxFailures,
+		ejectionDuration: defaultEjectionDuration,
+		mutex:            sync.Mutex{},
+		now:              time.Now,
 	}, nil
 }
 
-// GetNextProxy returns the next proxy in the rotation.
+// NewProxyPool creates a named ProxyRotator and registers it in ProxyPools.
+func NewProxyPool(name string, proxyURLs []string) (*ProxyRotator, error) {
+	pr, err := NewProxyRotator(proxyURLs)
+	if err != nil {
+		return nil, err
+	}
+
+	pr.name = name
+	ProxyPools[name] = pr
+	return pr, nil
+}
+
+// Pool returns the named pool, or the default rotator when the name is empty or unknown.
+func Pool(name string) *ProxyRotator {
+	if pr, found := ProxyPools[name]; found {
+		return pr
+	}
+	return ProxyRotatorInstance
+}
+
+// SetEjectionPolicy defines how many consecutive failures eject a proxy, and for how long.
+func (pr *ProxyRotator) SetEjectionPolicy(maxFailures int, ejectionDuration time.Duration) {
+	pr.mutex.Lock()
+	defer pr.mutex.Unlock()
+
+	pr.maxFailures = max(maxFailures, 1)
+	pr.ejectionDuration = ejectionDuration
+}
+
+// GetNextProxy returns the next healthy proxy in the rotation.
+// When all proxies are ejected, the one coming back first is returned.
 func (pr *ProxyRotator) GetNextProxy() *url.URL {
 	if len(pr.proxies) == 0 {
 		return nil
 	}
 
 	pr.mutex.Lock()
-	proxy := pr.proxies[pr.currentIndex]
-	pr.currentIndex = (pr.currentIndex + 1) % len(pr.proxies)
-	pr.mutex.Unlock()
+	defer pr.mutex.Unlock()
+
+	now := pr.now()
+	var fallback *proxy
+
+	for range len(pr.proxies) {
+		p := pr.proxies[pr.currentIndex]
+		pr.currentIndex = (pr.currentIndex + 1) % len(pr.proxies)
+
+		if !now.Before(p.ejectedUntil) {
+			p.requests++
+			return p.url
+		}
 
-	return proxy
+		if fallback == nil || p.ejectedUntil.Before(fallback.ejectedUntil) {
+			fallback = p
+		}
+	}
+
+	fallback.requests++
+	return fallback.url
 }
 
 // HasProxies checks if there are any proxies available in the rotator.
 func (pr *ProxyRotator) HasProxies() bool {
 	return len(pr.proxies) > 0
 }
+
+// ReportSuccess resets the failure counter of the proxy.
+func (pr *ProxyRotator) ReportSuccess(proxyURL *url.URL) {
+	pr.mutex.Lock()
+	defer pr.mutex.Unlock()
+
+	if p := pr.findProxy(proxyURL); p != nil {
+		p.consecutiveFailures = 0
+	}
+}
+
+// ReportFailure records a failed request, the proxy is ejected once it reaches the maximum number of consecutive failures.
+func (pr *ProxyRotator) ReportFailure(proxyURL *url.URL) {
+	pr.mutex.Lock()
+	defer pr.mutex.Unlock()
+
+	p := pr.findProxy(proxyURL)
+	if p == nil {
+		return
+	}
+
+	p.failures++
+	p.consecutiveFailures++
+
+	if p.consecutiveFailures >= pr.maxFailures {
+		pr.eject(p)
+	}
+}
+
+// CheckHealth connects to every proxy: unreachable proxies are ejected and reachable ones are restored.
+func (pr *ProxyRotator) CheckHealth() {
+	for _, p := range pr.proxies {
+		address := proxyAddress(p.url)
+		conn, err := net.DialTimeout("tcp", address, healthCheckTimeout)
+
+		pr.mutex.Lock()
+		if err != nil {
+			slog.Warn("Proxy health check failed",
+				slog.String("pool", pr.name),
+				slog.String("proxy_url", p.url.Redacted()),
+				slog.Any("error", err),
+			)
+			p.failures++
+			pr.eject(p)
+		} else {
+			conn.Close()
+			p.consecutiveFailures = 0
+			p.ejectedUntil = time.Time{}
+		}
+		pr.mutex.Unlock()
+	}
+}
+
+// Stats returns the health and the counters of each proxy.
+func (pr *ProxyRotator) Stats() []ProxyStats {
+	pr.mutex.Lock()
+	defer pr.mutex.Unlock()
+
+	now := pr.now()
+	stats := make([]ProxyStats, 0, len(pr.proxies))
+	for _, p := range pr.proxies {
+		stats = append(stats, ProxyStats{
+			Pool:      pr.name,
+			URL:       p.url.Redacted(),
+			Healthy:   !now.Before(p.ejectedUntil),
+			Requests:  p.requests,
+			Failures:  p.failures,
+			Ejections: p.ejections,
+		})
+	}
+	return stats
+}
+
+func (pr *ProxyRotator) findProxy(proxyURL *url.URL) *proxy {
+	if proxyURL == nil {
+		return nil
+	}
+
+	for _, p := range pr.proxies {
+		if p.url == proxyURL || p.url.String() == proxyURL.String() {
+			return p
+		}
+	}
+	return nil
+}
+
+func (pr *ProxyRotator) eject(p *proxy) {
+	if pr.now().Before(p.ejectedUntil) {
+		return
+	}
+
+	slog.Warn("Ejecting proxy from the rotation",
+		slog.String("pool", pr.name),
+		slog.String("proxy_url", p.url.Redacted()),
+		slog.Int("consecutive_failures", p.consecutiveFailures),
+		slog.Duration("duration", pr.ejectionDuration),
+	)
+
+	p.ejections++
+	p.ejectedUntil = pr.now().Add(pr.ejectionDuration)
+}
+
+func proxyAddress(proxyURL *url.URL) string {
+	port := proxyURL.Port()
+	if port == "" {
+		switch proxyURL.Scheme {
+		case "https":
+			port = "443"
+		case "socks5", "socks5h":
+			port = "1080"
+		default:
+			port = "80"
+		}
+	}
+	return net.JoinHostPort(proxyURL.Hostname(), port)
+}
+
+// Rotators returns the default rotator followed by the named pools.
+func Rotators() []*ProxyRotator {
+	var rotators []*ProxyRotator
+	if ProxyRotatorInstance != nil {
+		rotators = append(rotators, ProxyRotatorInstance)
+	}
+	for _, pr := range ProxyPools {
+		rotators = append(rotators, pr)
+	}
+	return rotators
+}
+
+// StartHealthChecks checks periodically the health of all proxies in the background.
+func StartHealthChecks(interval time.Duration) {
+	go func() {
+		for range time.Tick(interval) {
+			for _, pr := range Rotators() {
+				pr.CheckHealth()
+			}
+		}
+	}()
+}
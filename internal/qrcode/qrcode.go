@@ -0,0 +1,459 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package qrcode implements a minimal QR Code encoder (byte mode, medium error correction level),
+// enough to display short texts like TOTP enrollment URIs.
+package qrcode // import "miniflux.app/v2/internal/qrcode"
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+)
+
+const quietZone = 4
+
+var ErrDataTooLong = errors.New("qrcode: data too long")
+
+// versionInfo describes the blocks of a version for the medium error correction level.
+type versionInfo struct {
+	ecCodewordsPerBlock int
+	group1Blocks        int
+	group1DataCodewords int
+	group2Blocks        int
+	group2DataCodewords int
+	alignmentPositions  []int
+}
+
+var versions = []versionInfo{
+	1:  {10, 1, 16, 0, 0, nil},
+	2:  {16, 1, 28, 0, 0, []int{6, 18}},
+	3:  {26, 1, 44, 0, 0, []int{6, 22}},
+	4:  {18, 2, 32, 0, 0, []int{6, 26}},
+	5:  {24, 2, 43, 0, 0, []int{6, 30}},
+	6:  {16, 4, 27, 0, 0, []int{6, 34}},
+	7:  {18, 4, 31, 0, 0, []int{6, 22, 38}},
+	8:  {22, 2, 38, 2, 39, []int{6, 24, 42}},
+	9:  {22, 3, 36, 2, 37, []int{6, 26, 46}},
+	10: {26, 4, 43, 1, 44, []int{6, 28, 50}},
+}
+
+func (v versionInfo) dataCodewords() int {
+	return v.group1Blocks*v.group1DataCodewords + v.group2Blocks*v.group2DataCodewords
+}
+
+// Code is a QR Code symbol, true modules are dark.
+type Code struct {
+	Size       int
+	modules    [][]bool
+	isFunction [][]bool
+}
+
+// Dark returns true if the module at the given column and row is dark.
+func (c *Code) Dark(x, y int) bool {
+	return c.modules[y][x]
+}
+
+// Encode returns the smallest QR Code able to hold the given text.
+func Encode(text string) (*Code, error) {
+	data := []byte(text)
+
+	version := 0
+	for v := 1; v < len(versions); v++ {
+		if dataBits(v, len(data)) <= versions[v].dataCodewords()*8 {
+			version = v
+			break
+		}
+	}
+
+	if version == 0 {
+		return nil, ErrDataTooLong
+	}
+
+	code := newCode(version)
+	code.drawFunctionPatterns(version)
+	code.drawCodewords(interleave(version, encodeData(version, data)))
+
+	bestMask, bestPenalty := 0, -1
+	for mask := range 8 {
+		code.applyMask(mask)
+		code.drawFormatBits(mask)
+		if penalty := code.penalty(); bestPenalty < 0 || penalty < bestPenalty {
+			bestMask, bestPenalty = mask, penalty
+		}
+		code.applyMask(mask)
+	}
+
+	code.applyMask(bestMask)
+	code.drawFormatBits(bestMask)
+	return code, nil
+}
+
+// WritePNG writes the QR Code as a PNG image, each module being a square of scale pixels.
+func (c *Code) WritePNG(w io.Writer, scale int) error {
+	size := (c.Size + 2*quietZone) * scale
+	img := image.NewPaletted(image.Rect(0, 0, size, size), color.Palette{color.White, color.Black})
+
+	for y := range c.Size {
+		for x := range c.Size {
+			if !c.modules[y][x] {
+				continue
+			}
+
+			for dy := range scale {
+				for dx := range scale {
+					img.SetColorIndex((x+quietZone)*scale+dx, (y+quietZone)*scale+dy, 1)
+				}
+			}
+		}
+	}
+
+	return png.Encode(w, img)
+}
+
+func newCode(version int) *Code {
+	size := version*4 + 17
+	code := &Code{Size: size, modules: make([][]bool, size), isFunction: make([][]bool, size)}
+	for i := range size {
+		code.modules[i] = make([]bool, size)
+		code.isFunction[i] = make([]bool, size)
+	}
+	return code
+}
+
+func dataBits(version, length int) int {
+	return 4 + characterCountBits(version) + length*8
+}
+
+func characterCountBits(version int) int {
+	if version < 10 {
+		return 8
+	}
+	return 16
+}
+
+// encodeData returns the data codewords: byte mode indicator, character count, data, terminator and padding.
+func encodeData(version int, data []byte) []byte {
+	capacity := versions[version].dataCodewords() * 8
+
+	var bits bitBuffer
+	bits.append(0b0100, 4)
+	bits.append(len(data), characterCountBits(version))
+	for _, b := range data {
+		bits.append(int(b), 8)
+	}
+
+	bits.append(0, min(4, capacity-len(bits)))
+	bits.append(0, (8-len(bits)%8)%8)
+	for padByte := 0xEC; len(bits) < capacity; padByte ^= 0xEC ^ 0x11 {
+		bits.append(padByte, 8)
+	}
+
+	codewords := make([]byte, len(bits)/8)
+	for i, bit := range bits {
+		if bit {
+			codewords[i/8] |= 1 << (7 - i%8)
+		}
+	}
+	return codewords
+}
+
+// interleave splits the data in blocks, appends the error correction codewords and interleaves the blocks.
+func interleave(version int, data []byte) []byte {
+	info := versions[version]
+	divisor := reedSolomonDivisor(info.ecCodewordsPerBlock)
+
+	var dataBlocks, ecBlocks [][]byte
+	for i := range info.group1Blocks + info.group2Blocks {
+		length := info.group1DataCodewords
+		if i >= info.group1Blocks {
+			length = info.group2DataCodewords
+		}
+
+		block := data[:length]
+		data = data[length:]
+		dataBlocks = append(dataBlocks, block)
+		ecBlocks = append(ecBlocks, reedSolomonRemainder(block, divisor))
+	}
+
+	var result []byte
+	for i := range max(info.group1DataCodewords, info.group2DataCodewords) {
+		for _, block := range dataBlocks {
+			if i < len(block) {
+				result = append(result, block[i])
+			}
+		}
+	}
+
+	for i := range info.ecCodewordsPerBlock {
+		for _, block := range ecBlocks {
+			result = append(result, block[i])
+		}
+	}
+
+	return result
+}
+
+func (c *Code) setFunctionModule(x, y int, dark bool) {
+	c.modules[y][x] = dark
+	c.isFunction[y][x] = true
+}
+
+func (c *Code) drawFunctionPatterns(version int) {
+	for i := range c.Size {
+		c.setFunctionModule(6, i, i%2 == 0)
+		c.setFunctionModule(i, 6, i%2 == 0)
+	}
+
+	c.drawFinderPattern(3, 3)
+	c.drawFinderPattern(c.Size-4, 3)
+	c.drawFinderPattern(3, c.Size-4)
+
+	positions := versions[version].alignmentPositions
+	for i, x := range positions {
+		for j, y := range positions {
+			// Skip the three corners occupied by finder patterns.
+			if (i == 0 && j == 0) || (i == 0 && j == len(positions)-1) || (i == len(positions)-1 && j == 0) {
+				continue
+			}
+			c.drawAlignmentPattern(x, y)
+		}
+	}
+
+	// Reserve the format areas, drawn once the mask is known.
+	c.drawFormatBits(0)
+	c.drawVersionBits(version)
+}
+
+func (c *Code) drawFinderPattern(centerX, centerY int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			x, y := centerX+dx, centerY+dy
+			if x < 0 || x >= c.Size || y < 0 || y >= c.Size {
+				continue
+			}
+
+			distance := max(abs(dx), abs(dy))
+			c.setFunctionModule(x, y, distance != 2 && distance != 4)
+		}
+	}
+}
+
+func (c *Code) drawAlignmentPattern(centerX, centerY int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			c.setFunctionModule(centerX+dx, centerY+dy, max(abs(dx), abs(dy)) != 1)
+		}
+	}
+}
+
+// formatBits returns the 15 bits of format information for the medium error correction level.
+func formatBits(mask int) int {
+	// The medium error correction level is encoded as 00.
+	data := mask
+	remainder := data
+	for range 10 {
+		remainder = (remainder << 1) ^ ((remainder >> 9) * 0x537)
+	}
+	return (data<<10 | remainder) ^ 0x5412
+}
+
+func (c *Code) drawFormatBits(mask int) {
+	bits := formatBits(mask)
+
+	for i := range 6 {
+		c.setFunctionModule(8, i, bit(bits, i))
+	}
+	c.setFunctionModule(8, 7, bit(bits, 6))
+	c.setFunctionModule(8, 8, bit(bits, 7))
+	c.setFunctionModule(7, 8, bit(bits, 8))
+	for i := 9; i < 15; i++ {
+		c.setFunctionModule(14-i, 8, bit(bits, i))
+	}
+
+	for i := range 8 {
+		c.setFunctionModule(c.Size-1-i, 8, bit(bits, i))
+	}
+	for i := 8; i < 15; i++ {
+		c.setFunctionModule(8, c.Size-15+i, bit(bits, i))
+	}
+	c.setFunctionModule(8, c.Size-8, true)
+}
+
+// versionBits returns the 18 bits of version information, only present from version 7.
+func versionBits(version int) int {
+	remainder := version
+	for range 12 {
+		remainder = (remainder << 1) ^ ((remainder >> 11) * 0x1F25)
+	}
+	return version<<12 | remainder
+}
+
+func (c *Code) drawVersionBits(version int) {
+	if version < 7 {
+		return
+	}
+
+	bits := versionBits(version)
+	for i := range 18 {
+		a, b := c.Size-11+i%3, i/3
+		c.setFunctionModule(a, b, bit(bits, i))
+		c.setFunctionModule(b, a, bit(bits, i))
+	}
+}
+
+// drawCodewords places the codewords in the zigzag order, skipping function modules.
+func (c *Code) drawCodewords(codewords []byte) {
+	i := 0
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+
+		for vertical := range c.Size {
+			for j := range 2 {
+				x := right - j
+				y := vertical
+				if (right+1)&2 == 0 {
+					y = c.Size - 1 - vertical
+				}
+
+				if !c.isFunction[y][x] && i < len(codewords)*8 {
+					c.modules[y][x] = codewords[i/8]>>(7-i%8)&1 == 1
+					i++
+				}
+			}
+		}
+	}
+}
+
+// applyMask inverts the data modules selected by the mask, applying it twice restores the original code.
+func (c *Code) applyMask(mask int) {
+	for y := range c.Size {
+		for x := range c.Size {
+			if c.isFunction[y][x] {
+				continue
+			}
+
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+
+			if invert {
+				c.modules[y][x] = !c.modules[y][x]
+			}
+		}
+	}
+}
+
+// penalty scores the readability of the code, the mask with the lowest penalty is used.
+func (c *Code) penalty() int {
+	penalty := 0
+	darkCount := 0
+
+	for i := range c.Size {
+		penalty += c.linePenalty(func(j int) bool { return c.modules[i][j] })
+		penalty += c.linePenalty(func(j int) bool { return c.modules[j][i] })
+	}
+
+	for y := range c.Size {
+		for x := range c.Size {
+			if c.modules[y][x] {
+				darkCount++
+			}
+
+			if x < c.Size-1 && y < c.Size-1 {
+				dark := c.modules[y][x]
+				if dark == c.modules[y][x+1] && dark == c.modules[y+1][x] && dark == c.modules[y+1][x+1] {
+					penalty += 3
+				}
+			}
+		}
+	}
+
+	total := c.Size * c.Size
+	deviation := abs(darkCount*20-total*10) / total
+	return penalty + deviation*10
+}
+
+// linePenalty scores runs of same color modules and patterns looking like finder patterns.
+func (c *Code) linePenalty(module func(int) bool) int {
+	penalty := 0
+	runLength := 1
+
+	for j := 1; j <= c.Size; j++ {
+		if j < c.Size && module(j) == module(j-1) {
+			runLength++
+			continue
+		}
+
+		if runLength >= 5 {
+			penalty += runLength - 2
+		}
+		runLength = 1
+	}
+
+	finderLike := []bool{true, false, true, true, true, false, true}
+	for j := 0; j+len(finderLike) <= c.Size; j++ {
+		matches := true
+		for k, dark := range finderLike {
+			if module(j+k) != dark {
+				matches = false
+				break
+			}
+		}
+
+		if matches && (isLight(module, j-4, j, c.Size) || isLight(module, j+7, j+11, c.Size)) {
+			penalty += 40
+		}
+	}
+
+	return penalty
+}
+
+// isLight returns true if the modules between start and end are light, modules outside the code are light.
+func isLight(module func(int) bool, start, end, size int) bool {
+	for j := start; j < end; j++ {
+		if j >= 0 && j < size && module(j) {
+			return false
+		}
+	}
+	return true
+}
+
+func bit(value, i int) bool {
+	return (value>>i)&1 != 0
+}
+
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+type bitBuffer []bool
+
+func (b *bitBuffer) append(value, length int) {
+	for i := length - 1; i >= 0; i-- {
+		*b = append(*b, bit(value, i))
+	}
+}
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package qrcode // import "miniflux.app/v2/internal/qrcode"
+
+import (
+	"bytes"
+	"errors"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func TestReedSolomonRemainder(t *testing.T) {
+	// "HELLO WORLD" encoded as version 1-M.
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	expected := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+
+	if result := reedSolomonRemainder(data, reedSolomonDivisor(10)); !bytes.Equal(result, expected) {
+		t.Fatalf(`Unexpected error correction codewords: %v`, result)
+	}
+}
+
+func TestFormatBits(t *testing.T) {
+	expected := []int{
+		0b101010000010010,
+		0b101000100100101,
+		0b101111001111100,
+		0b101101101001011,
+		0b100010111111001,
+		0b100000011001110,
+		0b100111110010111,
+		0b100101010100000,
+	}
+
+	for mask, bits := range expected {
+		if result := formatBits(mask); result != bits {
+			t.Errorf(`Unexpected format bits for mask %d: %015b`, mask, result)
+		}
+	}
+}
+
+func TestVersionBits(t *testing.T) {
+	if result := versionBits(7); result != 0b000111110010010100 {
+		t.Fatalf(`Unexpected version bits: %018b`, result)
+	}
+}
+
+func TestEncodeData(t *testing.T) {
+	codewords := encodeData(1, []byte("ab"))
+	expected := []byte{0x40, 0x26, 0x16, 0x20, 0xEC, 0x11}
+	if !bytes.HasPrefix(codewords, expected) || len(codewords) != 16 {
+		t.Fatalf(`Unexpected data codewords: %x`, codewords)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	text := "otpauth://totp/Miniflux:admin?digits=6&issuer=Miniflux&period=30&secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
+	code, err := Encode(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if code.Size != 41 {
+		t.Fatalf(`Unexpected size %d, the text should fit in a version 6 code`, code.Size)
+	}
+
+	// Finder patterns have a dark center, surrounded by a light ring.
+	for _, corner := range [][2]int{{3, 3}, {code.Size - 4, 3}, {3, code.Size - 4}} {
+		if !code.Dark(corner[0], corner[1]) || code.Dark(corner[0]+2, corner[1]) || !code.Dark(corner[0]+3, corner[1]) {
+			t.Errorf(`Invalid finder pattern at %v`, corner)
+		}
+	}
+
+	if !code.Dark(8, code.Size-8) {
+		t.Error(`The dark module is missing`)
+	}
+
+	var buffer bytes.Buffer
+	if err := code.WritePNG(&buffer, 4); err != nil {
+		t.Fatal(err)
+	}
+
+	img, err := png.Decode(&buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if width := img.Bounds().Dx(); width != (41+2*quietZone)*4 {
+		t.Fatalf(`Unexpected image width: %d`, width)
+	}
+}
+
+func TestEncodeReadsBack(t *testing.T) {
+	for _, length := range []int{10, 100, 200} {
+		text := strings.Repeat("a", length)
+		code, err := Encode(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		version := (code.Size - 17) / 4
+		expected := interleave(version, encodeData(version, []byte(text)))
+
+		mask := code.readFormatMask()
+		if mask < 0 {
+			t.Fatalf(`Unable to read the mask of the code holding %d bytes`, length)
+		}
+
+		code.applyMask(mask)
+		if result := code.readCodewords(len(expected)); !bytes.Equal(result, expected) {
+			t.Fatalf(`The codewords cannot be read back from the code holding %d bytes`, length)
+		}
+	}
+}
+
+func TestEncodeTooLong(t *testing.T) {
+	if _, err := Encode(strings.Repeat("a", 300)); !errors.Is(err, ErrDataTooLong) {
+		t.Fatalf(`Expected ErrDataTooLong, got %v`, err)
+	}
+}
+
+func (c *Code) readFormatMask() int {
+	bits := 0
+	for i := range 6 {
+		bits |= boolToInt(c.modules[i][8]) << i
+	}
+	bits |= boolToInt(c.modules[7][8]) << 6
+	bits |= boolToInt(c.modules[8][8]) << 7
+	bits |= boolToInt(c.modules[8][7]) << 8
+	for i := 9; i < 15; i++ {
+		bits |= boolToInt(c.modules[8][14-i]) << i
+	}
+
+	for mask := range 8 {
+		if formatBits(mask) == bits {
+			return mask
+		}
+	}
+	return -1
+}
+
+func (c *Code) readCodewords(count int) []byte {
+	codewords := make([]byte, count)
+	i := 0
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+
+		for vertical := range c.Size {
+			for j := range 2 {
+				x := right - j
+				y := vertical
+				if (right+1)&2 == 0 {
+					y = c.Size - 1 - vertical
+				}
+
+				if !c.isFunction[y][x] && i < count*8 {
+					if c.modules[y][x] {
+						codewords[i/8] |= 1 << (7 - i%8)
+					}
+					i++
+				}
+			}
+		}
+	}
+	return codewords
+}
+
+func boolToInt(value bool) int {
+	if value {
+		return 1
+	}
+	return 0
+}
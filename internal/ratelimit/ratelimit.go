@@ -0,0 +1,318 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit // import "miniflux.app/v2/internal/ratelimit"
+
+import (
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/v2/internal/metric"
+)
+
+// Authentication methods used to label log messages and metrics.
+const (
+	MethodWeb          = "web"
+	MethodTOTP         = "totp"
+	MethodAPI          = "api"
+	MethodFever        = "fever"
+	MethodGoogleReader = "google_reader"
+	MethodRegistration = "registration"
+)
+
+const (
+	// usernameLockoutMinClientIPs is the number of distinct client IPs the failures must come from
+	// before a username is locked out, so a single client cannot lock out somebody else's account.
+	usernameLockoutMinClientIPs = 3
+
+	// defaultMaxClients bounds the memory used by the counters.
+	defaultMaxClients = 10000
+
+	registrationMaxAttempts        = 5
+	registrationLockoutDuration    = 10 * time.Minute
+	registrationMaxLockoutDuration = 24 * time.Hour
+)
+
+// AuthenticationLimiterInstance is shared by all the authentication endpoints.
+// It is nil when rate limiting is disabled.
+var AuthenticationLimiterInstance *Limiter
+
+// RegistrationLimiterInstance limits the account requests of the open registration.
+// It is always enabled and does not share its counters with the authentication limiter.
+var RegistrationLimiterInstance = NewRegistrationLimiter()
+
+// LockoutHandler is called when a client IP or a username is locked out.
+// The username is empty when only the client IP has been locked out.
+type LockoutHandler func(method, clientIP, username string, lockedUntil time.Time)
+
+type client struct {
+	failures    int
+	lockouts    int
+	lastFailure time.Time
+	lockedUntil time.Time
+	clientIPs   map[string]struct{}
+}
+
+type lockout struct {
+	method      string
+	clientIP    string
+	username    string
+	lockedUntil time.Time
+}
+
+// Limiter counts failed authentication attempts by client IP and by username.
+//
+// Once a client IP reaches the maximum number of attempts, it is locked out.
+// A username is only locked out once it reaches the maximum number of attempts from several client IPs.
+// The lockout duration doubles after each lockout, up to the maximum duration.
+// The counters are forgotten once no failure has been recorded during the maximum lockout duration.
+type Limiter struct {
+	mutex              sync.Mutex
+	maxAttempts        int
+	maxClients         int
+	lockoutDuration    time.Duration
+	maxLockoutDuration time.Duration
+	clients            map[string]*client
+	lastCleanup        time.Time
+	lockoutHandler     LockoutHandler
+	authentication     bool
+	now                func() time.Time
+}
+
+// NewLimiter returns a new Limiter.
+func NewLimiter(maxAttempts int, lockoutDuration, maxLockoutDuration time.Duration) *Limiter {
+	if maxLockoutDuration < lockoutDuration {
+		maxLockoutDuration = lockoutDuration
+	}
+
+	return &Limiter{
+		maxAttempts:        maxAttempts,
+		maxClients:         defaultMaxClients,
+		lockoutDuration:    lockoutDuration,
+		maxLockoutDuration: maxLockoutDuration,
+		clients:            make(map[string]*client),
+		authentication:     true,
+		now:                time.Now,
+	}
+}
+
+// NewRegistrationLimiter returns a Limiter counting the account requests by client IP.
+// Unlike authentication failures, the attempts and lockouts are not reported in the authentication metrics.
+func NewRegistrationLimiter() *Limiter {
+	limiter := NewLimiter(registrationMaxAttempts, registrationLockoutDuration, registrationMaxLockoutDuration)
+	limiter.authentication = false
+	return limiter
+}
+
+// SetLockoutHandler registers a function called after each lockout, for example to write the audit log.
+func (l *Limiter) SetLockoutHandler(handler LockoutHandler) {
+	if l == nil {
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.lockoutHandler = handler
+}
+
+// LockedUntil returns the end of the lockout affecting the client IP or the username.
+// The zero time is returned when authentication attempts are allowed.
+func (l *Limiter) LockedUntil(clientIP, username string) time.Time {
+	if l == nil {
+		return time.Time{}
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	var lockedUntil time.Time
+	for _, key := range keys(clientIP, username) {
+		if c, found := l.clients[key]; found && c.lockedUntil.After(now) && c.lockedUntil.After(lockedUntil) {
+			lockedUntil = c.lockedUntil
+		}
+	}
+
+	return lockedUntil
+}
+
+// Allow returns true if the client IP and the username are not locked out.
+func (l *Limiter) Allow(clientIP, username string) bool {
+	return l.LockedUntil(clientIP, username).IsZero()
+}
+
+// RecordFailure registers a failed authentication attempt and locks out the client IP or the username
+// when they reach the maximum number of attempts.
+func (l *Limiter) RecordFailure(method, clientIP, username string) {
+	if l == nil {
+		return
+	}
+
+	if l.authentication {
+		metric.AuthenticationFailures.WithLabelValues(method).Inc()
+	}
+
+	l.mutex.Lock()
+	lockouts := l.recordFailure(method, clientIP, username)
+	handler := l.lockoutHandler
+	l.mutex.Unlock()
+
+	// The handler is called without holding the lock because it may be slow, e.g. a database write.
+	if handler != nil {
+		for _, lockout := range lockouts {
+			handler(lockout.method, lockout.clientIP, lockout.username, lockout.lockedUntil)
+		}
+	}
+}
+
+func (l *Limiter) recordFailure(method, clientIP, username string) []lockout {
+	now := l.now()
+	l.cleanup(now, false)
+
+	var lockouts []lockout
+	for _, key := range keys(clientIP, username) {
+		isUsername := key != ipKey(clientIP)
+
+		c, found := l.clients[key]
+		if !found || (now.Sub(c.lastFailure) > l.maxLockoutDuration && !c.lockedUntil.After(now)) {
+			if !found && len(l.clients) >= l.maxClients {
+				l.evict(now)
+			}
+			c = &client{}
+			l.clients[key] = c
+		}
+
+		c.failures++
+		c.lastFailure = now
+
+		if isUsername {
+			if c.clientIPs == nil {
+				c.clientIPs = make(map[string]struct{}, usernameLockoutMinClientIPs)
+			}
+			if len(c.clientIPs) < usernameLockoutMinClientIPs {
+				c.clientIPs[clientIP] = struct{}{}
+			}
+			if len(c.clientIPs) < usernameLockoutMinClientIPs {
+				continue
+			}
+		}
+
+		if c.failures < l.maxAttempts {
+			continue
+		}
+
+		duration := l.lockoutDuration << min(c.lockouts, 30)
+		if duration <= 0 || duration > l.maxLockoutDuration {
+			duration = l.maxLockoutDuration
+		}
+
+		c.failures = 0
+		c.clientIPs = nil
+		c.lockouts++
+		c.lockedUntil = now.Add(duration)
+
+		if l.authentication {
+			metric.AuthenticationLockouts.WithLabelValues(method).Inc()
+
+			slog.Warn("Too many failed authentication attempts, locking out",
+				slog.Bool("authentication_lockout", true),
+				slog.String("authentication_method", method),
+				slog.String("client_ip", clientIP),
+				slog.String("username", username),
+				slog.String("locked_out", key),
+				slog.Int("lockouts", c.lockouts),
+				slog.Duration("duration", duration),
+			)
+		} else {
+			slog.Warn("Too many attempts, locking out",
+				slog.String("method", method),
+				slog.String("client_ip", clientIP),
+				slog.String("locked_out", key),
+				slog.Int("lockouts", c.lockouts),
+				slog.Duration("duration", duration),
+			)
+		}
+
+		lockedOut := lockout{method: method, clientIP: clientIP, lockedUntil: c.lockedUntil}
+		if isUsername {
+			lockedOut.username = username
+		}
+		lockouts = append(lockouts, lockedOut)
+	}
+
+	return lockouts
+}
+
+// RecordSuccess forgets the failed attempts of the username once the user is authenticated.
+// The client IP counters are kept, otherwise a valid account could be used to try other usernames indefinitely.
+func (l *Limiter) RecordSuccess(username string) {
+	if l == nil || username == "" {
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	delete(l.clients, usernameKey(username))
+}
+
+// cleanup removes the clients without failures during the maximum lockout duration.
+// The cleanup runs at most once per maximum lockout duration unless it is forced.
+func (l *Limiter) cleanup(now time.Time, force bool) {
+	if !force && now.Sub(l.lastCleanup) < l.maxLockoutDuration {
+		return
+	}
+
+	l.lastCleanup = now
+	for key, c := range l.clients {
+		if now.Sub(c.lastFailure) > l.maxLockoutDuration && !c.lockedUntil.After(now) {
+			delete(l.clients, key)
+		}
+	}
+}
+
+// evict makes room for a new client once the maximum number of clients is reached.
+// Expired clients are removed first, then the client with the oldest failure, preferably one that is not locked out.
+func (l *Limiter) evict(now time.Time) {
+	l.cleanup(now, true)
+	if len(l.clients) < l.maxClients {
+		return
+	}
+
+	var oldestKey string
+	var oldest *client
+	for key, c := range l.clients {
+		switch {
+		case oldest == nil:
+		case oldest.lockedUntil.After(now) && !c.lockedUntil.After(now):
+		case oldest.lockedUntil.After(now) == c.lockedUntil.After(now) && c.lastFailure.Before(oldest.lastFailure):
+		default:
+			continue
+		}
+		oldestKey, oldest = key, c
+	}
+
+	delete(l.clients, oldestKey)
+}
+
+func keys(clientIP, username string) []string {
+	var keys []string
+	if clientIP != "" {
+		keys = append(keys, ipKey(clientIP))
+	}
+	if username != "" {
+		keys = append(keys, usernameKey(username))
+	}
+	return keys
+}
+
+func ipKey(clientIP string) string {
+	return "ip:" + clientIP
+}
+
+func usernameKey(username string) string {
+	return "username:" + strings.ToLower(strings.TrimSpace(username))
+}
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit // import "miniflux.app/v2/internal/ratelimit"
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestLimiter(now *time.Time) *Limiter {
+	limiter := NewLimiter(3, time.Minute, 10*time.Minute)
+	limiter.now = func() time.Time { return *now }
+	return limiter
+}
+
+func TestLimiterLocksOutAfterMaxAttempts(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := newTestLimiter(&now)
+
+	for range 2 {
+		limiter.RecordFailure(MethodWeb, "192.0.2.1", "alice")
+		if !limiter.Allow("192.0.2.1", "alice") {
+			t.Fatal(`The client should not be locked out before reaching the maximum number of attempts`)
+		}
+	}
+
+	limiter.RecordFailure(MethodWeb, "192.0.2.1", "alice")
+	if lockedUntil := limiter.LockedUntil("192.0.2.1", "alice"); !lockedUntil.Equal(now.Add(time.Minute)) {
+		t.Fatalf(`Unexpected lockout end, got %v`, lockedUntil)
+	}
+
+	if !limiter.Allow("192.0.2.2", "alice") {
+		t.Error(`A single client IP should not lock out the username`)
+	}
+
+	if limiter.Allow("192.0.2.1", "bob") {
+		t.Error(`The client IP should be locked out for any username`)
+	}
+
+	if !limiter.Allow("192.0.2.2", "bob") {
+		t.Error(`Other clients should not be locked out`)
+	}
+
+	now = now.Add(time.Minute + time.Second)
+	if !limiter.Allow("192.0.2.1", "alice") {
+		t.Error(`The lockout should have expired`)
+	}
+}
+
+func TestLimiterLockoutDurationDoubles(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := newTestLimiter(&now)
+
+	expectedDurations := []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute, 10 * time.Minute, 10 * time.Minute}
+	for _, expectedDuration := range expectedDurations {
+		for range 3 {
+			limiter.RecordFailure(MethodAPI, "192.0.2.1", "")
+		}
+
+		lockedUntil := limiter.LockedUntil("192.0.2.1", "")
+		if duration := lockedUntil.Sub(now); duration != expectedDuration {
+			t.Fatalf(`Unexpected lockout duration, got %v instead of %v`, duration, expectedDuration)
+		}
+
+		now = lockedUntil
+	}
+}
+
+func TestLimiterLocksOutUsernameFromSeveralClientIPs(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := newTestLimiter(&now)
+
+	limiter.RecordFailure(MethodWeb, "192.0.2.1", "alice")
+	limiter.RecordFailure(MethodWeb, "192.0.2.1", "alice")
+	limiter.RecordFailure(MethodWeb, "192.0.2.2", "alice")
+	if !limiter.Allow("192.0.2.4", "alice") {
+		t.Fatal(`The username should not be locked out before failures come from enough client IPs`)
+	}
+
+	limiter.RecordFailure(MethodWeb, "192.0.2.3", "alice")
+	if limiter.Allow("192.0.2.4", "alice") {
+		t.Error(`The username should be locked out from any client IP`)
+	}
+
+	if !limiter.Allow("192.0.2.4", "bob") {
+		t.Error(`Other usernames should not be locked out`)
+	}
+}
+
+func TestLimiterCallsLockoutHandler(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := newTestLimiter(&now)
+
+	var lockouts []string
+	limiter.SetLockoutHandler(func(method, clientIP, username string, lockedUntil time.Time) {
+		if !lockedUntil.Equal(now.Add(time.Minute)) {
+			t.Errorf(`Unexpected lockout end, got %v`, lockedUntil)
+		}
+		lockouts = append(lockouts, method+" "+clientIP+" "+username)
+	})
+
+	limiter.RecordFailure(MethodWeb, "192.0.2.1", "alice")
+	limiter.RecordFailure(MethodWeb, "192.0.2.2", "alice")
+	if len(lockouts) != 0 {
+		t.Fatalf(`The handler should not be called before a lockout, got %v`, lockouts)
+	}
+
+	limiter.RecordFailure(MethodTOTP, "192.0.2.3", "alice")
+	if len(lockouts) != 1 || lockouts[0] != "totp 192.0.2.3 alice" {
+		t.Fatalf(`Unexpected lockouts, got %v`, lockouts)
+	}
+
+	limiter.RecordFailure(MethodFever, "192.0.2.4", "")
+	limiter.RecordFailure(MethodFever, "192.0.2.4", "")
+	limiter.RecordFailure(MethodFever, "192.0.2.4", "")
+	if len(lockouts) != 2 || lockouts[1] != "fever 192.0.2.4 " {
+		t.Fatalf(`Unexpected lockouts, got %v`, lockouts)
+	}
+}
+
+func TestLimiterEvictsClientsWhenFull(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := newTestLimiter(&now)
+	limiter.maxClients = 2
+
+	for range 3 {
+		limiter.RecordFailure(MethodFever, "192.0.2.1", "")
+	}
+
+	now = now.Add(time.Second)
+	limiter.RecordFailure(MethodFever, "192.0.2.2", "")
+	now = now.Add(time.Second)
+	limiter.RecordFailure(MethodFever, "192.0.2.3", "")
+
+	if len(limiter.clients) != 2 {
+		t.Fatalf(`Unexpected number of clients, got %d`, len(limiter.clients))
+	}
+
+	if limiter.Allow("192.0.2.1", "") {
+		t.Error(`Locked out clients should be evicted last`)
+	}
+
+	if _, found := limiter.clients[ipKey("192.0.2.2")]; found {
+		t.Error(`The client with the oldest failure should have been evicted`)
+	}
+}
+
+func TestLimiterForgetsOldFailures(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := newTestLimiter(&now)
+
+	limiter.RecordFailure(MethodFever, "192.0.2.1", "")
+	limiter.RecordFailure(MethodFever, "192.0.2.1", "")
+
+	now = now.Add(11 * time.Minute)
+	limiter.RecordFailure(MethodFever, "192.0.2.1", "")
+
+	if !limiter.Allow("192.0.2.1", "") {
+		t.Error(`Failures older than the maximum lockout duration should be forgotten`)
+	}
+}
+
+func TestLimiterSuccessResetsUsername(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := newTestLimiter(&now)
+
+	limiter.RecordFailure(MethodWeb, "192.0.2.1", "Alice")
+	limiter.RecordFailure(MethodWeb, "192.0.2.1", "Alice")
+	limiter.RecordSuccess("alice")
+	limiter.RecordFailure(MethodWeb, "192.0.2.2", "alice")
+
+	if !limiter.Allow("192.0.2.2", "alice") {
+		t.Error(`A successful login should reset the username failures`)
+	}
+
+	limiter.RecordFailure(MethodWeb, "192.0.2.1", "bob")
+	if limiter.Allow("192.0.2.1", "") {
+		t.Error(`A successful login should not reset the client IP failures`)
+	}
+}
+
+func TestNilLimiterAllowsEverything(t *testing.T) {
+	var limiter *Limiter
+	limiter.RecordFailure(MethodWeb, "192.0.2.1", "alice")
+	limiter.RecordSuccess("alice")
+
+	if !limiter.Allow("192.0.2.1", "alice") {
+		t.Error(`A disabled limiter should allow all attempts`)
+	}
+}
+
+func TestRegistrationLimiterLocksOutAfterMaxAttempts(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewRegistrationLimiter()
+	limiter.now = func() time.Time { return now }
+
+	for range registrationMaxAttempts - 1 {
+		limiter.RecordFailure(MethodRegistration, "192.0.2.1", "")
+	}
+
+	if !limiter.Allow("192.0.2.1", "") {
+		t.Fatal(`The client should not be locked out before reaching the maximum number of account requests`)
+	}
+
+	limiter.RecordFailure(MethodRegistration, "192.0.2.1", "")
+	if lockedUntil := limiter.LockedUntil("192.0.2.1", ""); !lockedUntil.Equal(now.Add(registrationLockoutDuration)) {
+		t.Fatalf(`Unexpected lockout end, got %v`, lockedUntil)
+	}
+
+	if RegistrationLimiterInstance == nil {
+		t.Error(`The registration limiter should always be enabled`)
+	}
+}
@@ -22,6 +22,9 @@ import (
 type atom10Feed struct {
 	XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
 
+	// The "xml:lang" attribute indicates the natural language of the element and its descendants.
+	Lang string `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+
 	// The "atom:id" element conveys a permanent, universally unique
 	// identifier for an entry or feed.
 	//
@@ -85,6 +88,9 @@ type atom10Feed struct {
 }
 
 type atom10Entry struct {
+	// The "xml:lang" attribute indicates the natural language of the element and its descendants.
+	Lang string `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+
 	// The "atom:id" element conveys a permanent, universally unique
 	// identifier for an entry or feed.
 	//
@@ -4,6 +4,7 @@
 package atom // import "miniflux.app/v2/internal/reader/atom"
 
 import (
+	"cmp"
 	"log/slog"
 	"slices"
 	"sort"
@@ -14,6 +15,7 @@ import (
 	"miniflux.app/v2/internal/crypto"
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/reader/date"
+	"miniflux.app/v2/internal/reader/language"
 	"miniflux.app/v2/internal/reader/sanitizer"
 	"miniflux.app/v2/internal/urllib"
 )
@@ -78,6 +80,9 @@ func (a *atom10Adapter) populateEntries(siteURL string) model.Entries {
 	for _, atomEntry := range a.atomFeed.Entries {
 		entry := model.NewEntry()
 
+		// Populate the entry language, inherited from the feed when not specified.
+		entry.Language = language.Normalize(cmp.Or(atomEntry.Lang, a.atomFeed.Lang))
+
 		// Populate the entry URL.
 		entry.URL = atomEntry.Links.originalLink()
 		if entry.URL != "" {
@@ -175,6 +180,9 @@ func (a *atom10Adapter) populateEntries(siteURL string) model.Entries {
 						slog.Any("error", err),
 					)
 				} else {
+					if entry.ThumbnailURL == "" {
+						entry.ThumbnailURL = mediaAbsoluteURL
+					}
 					uniqueEnclosuresMap[mediaAbsoluteURL] = true
 					entry.Enclosures = append(entry.Enclosures, &model.Enclosure{
 						URL:      mediaAbsoluteURL,
@@ -123,6 +123,39 @@ func TestParseFeedWithoutTitle(t *testing.T) {
 	}
 }
 
+func TestParseEntryLanguage(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<feed xmlns="http://www.w3.org/2005/Atom" xml:lang="en-US">
+			<title>Example Feed</title>
+			<link href="http://example.org/"/>
+			<entry>
+				<title>Entry in the feed language</title>
+				<link href="http://example.org/a"/>
+				<id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6a</id>
+				<updated>2003-12-13T18:30:02Z</updated>
+			</entry>
+			<entry xml:lang="fr">
+				<title>Article en français</title>
+				<link href="http://example.org/b"/>
+				<id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6b</id>
+				<updated>2003-12-13T18:30:02Z</updated>
+			</entry>
+		</feed>`
+
+	feed, err := Parse("https://example.org/", bytes.NewReader([]byte(data)), "10")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Entries[0].Language != "en" {
+		t.Errorf("Incorrect entry language, got: %q", feed.Entries[0].Language)
+	}
+
+	if feed.Entries[1].Language != "fr" {
+		t.Errorf("Incorrect entry language, got: %q", feed.Entries[1].Language)
+	}
+}
+
 func TestParseEntryWithoutTitleButWithURL(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 	<feed xmlns="http://www.w3.org/2005/Atom">
@@ -5,13 +5,16 @@ package fetcher // import "miniflux.app/v2/internal/reader/fetcher"
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net"
 	"net/http"
 	"net/url"
 	"slices"
+	"strings"
 	"time"
 
 	"miniflux.app/v2/internal/proxyrotator"
@@ -33,6 +36,10 @@ type RequestBuilder struct {
 	disableCompression bool
 	proxyRotator       *proxyrotator.ProxyRotator
 	feedProxyURL       string
+	customHeaderNames  []string
+	clientCertificate  string
+	clientKey          string
+	caCertificates     string
 }
 
 func NewRequestBuilder() *RequestBuilder {
@@ -84,6 +91,29 @@ func (r *RequestBuilder) WithUsernameAndPassword(username, password string) *Req
 	return r
 }
 
+// WithCustomHeaders adds user-defined headers to the request, replacing the headers with the same name set before.
+// Their values are redacted from the logs as they usually contain credentials.
+func (r *RequestBuilder) WithCustomHeaders(headers http.Header) *RequestBuilder {
+	for name, values := range headers {
+		r.headers[name] = slices.Clone(values)
+		r.customHeaderNames = append(r.customHeaderNames, name)
+	}
+	return r
+}
+
+// WithClientCertificate presents the PEM encoded certificate and private key for mutual TLS authentication.
+func (r *RequestBuilder) WithClientCertificate(certificate, key string) *RequestBuilder {
+	r.clientCertificate = certificate
+	r.clientKey = key
+	return r
+}
+
+// WithCACertificates trusts only the PEM encoded certificate authorities instead of the system ones.
+func (r *RequestBuilder) WithCACertificates(certificates string) *RequestBuilder {
+	r.caCertificates = certificates
+	return r
+}
+
 func (r *RequestBuilder) WithProxyRotator(proxyRotator *proxyrotator.ProxyRotator) *RequestBuilder {
 	r.proxyRotator = proxyRotator
 	return r
@@ -155,6 +185,28 @@ func (r *RequestBuilder) ExecuteRequest(requestURL string) (*http.Response, erro
 		}
 	}
 
+	if r.clientCertificate != "" || r.caCertificates != "" {
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+
+		if r.clientCertificate != "" {
+			certificate, err := tls.X509KeyPair([]byte(r.clientCertificate), []byte(r.clientKey))
+			if err != nil {
+				return nil, fmt.Errorf(`fetcher: invalid client certificate: %w`, err)
+			}
+			transport.TLSClientConfig.Certificates = []tls.Certificate{certificate}
+		}
+
+		if r.caCertificates != "" {
+			rootCAs := x509.NewCertPool()
+			if !rootCAs.AppendCertsFromPEM([]byte(r.caCertificates)) {
+				return nil, errors.New(`fetcher: invalid CA certificates`)
+			}
+			transport.TLSClientConfig.RootCAs = rootCAs
+		}
+	}
+
 	if r.disableHTTP2 {
 		transport.ForceAttemptHTTP2 = false
 
@@ -163,7 +215,7 @@ func (r *RequestBuilder) ExecuteRequest(requestURL string) (*http.Response, erro
 		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
 	}
 
-	var clientProxyURL *url.URL
+	var clientProxyURL, rotatedProxyURL *url.URL
 
 	switch {
 	case r.feedProxyURL != "":
@@ -176,6 +228,7 @@ func (r *RequestBuilder) ExecuteRequest(requestURL string) (*http.Response, erro
 		clientProxyURL = r.clientProxyURL
 	case r.proxyRotator != nil && r.proxyRotator.HasProxies():
 		clientProxyURL = r.proxyRotator.GetNextProxy()
+		rotatedProxyURL = clientProxyURL
 	}
 
 	var clientProxyURLRedacted string
@@ -219,13 +272,60 @@ func (r *RequestBuilder) ExecuteRequest(requestURL string) (*http.Response, erro
 	slog.Debug("Making outgoing request", slog.Group("request",
 		slog.String("method", req.Method),
 		slog.String("url", req.URL.String()),
-		slog.Any("headers", req.Header),
+		slog.Any("headers", r.redactedHeaders()),
 		slog.Bool("without_redirects", r.withoutRedirects),
 		slog.Bool("use_app_client_proxy", r.useClientProxy),
 		slog.String("client_proxy_url", clientProxyURLRedacted),
 		slog.Bool("ignore_tls_errors", r.ignoreTLSErrors),
+		slog.Bool("with_client_certificate", r.clientCertificate != ""),
+		slog.Bool("with_ca_certificates", r.caCertificates != ""),
 		slog.Bool("disable_http2", r.disableHTTP2),
 	))
 
-	return client.Do(req)
+	response, err := client.Do(req)
+
+	if rotatedProxyURL != nil {
+		if isProxyConnectionError(err) {
+			r.proxyRotator.ReportFailure(rotatedProxyURL)
+		} else {
+			r.proxyRotator.ReportSuccess(rotatedProxyURL)
+		}
+	}
+
+	return response, err
+}
+
+// isProxyConnectionError returns true when the proxy itself cannot be reached or refuses the credentials,
+// as opposed to the remote server. A SOCKS proxy reporting that the remote server is unreachable or refuses
+// the connection is working as expected and must not be ejected.
+func isProxyConnectionError(err error) bool {
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) {
+		return false
+	}
+
+	switch opErr.Op {
+	case "proxyconnect":
+		return true
+	case "socks connect":
+		var dialErr *net.OpError
+		if errors.As(opErr.Err, &dialErr) {
+			return true
+		}
+		return opErr.Err != nil && strings.Contains(opErr.Err.Error(), "authentication")
+	default:
+		return false
+	}
+}
+
+func (r *RequestBuilder) redactedHeaders() http.Header {
+	if len(r.customHeaderNames) == 0 {
+		return r.headers
+	}
+
+	headers := r.headers.Clone()
+	for _, name := range r.customHeaderNames {
+		headers.Set(name, "[redacted]")
+	}
+	return headers
 }
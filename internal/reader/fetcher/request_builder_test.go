@@ -4,11 +4,23 @@
 package fetcher // import "miniflux.app/v2/internal/reader/fetcher"
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
 	"time"
+
+	"miniflux.app/v2/internal/proxyrotator"
 )
 
 func TestNewRequestBuilder(t *testing.T) {
@@ -162,6 +174,118 @@ func TestRequestBuilder_WithCookie(t *testing.T) {
 	}
 }
 
+func TestRequestBuilder_WithCustomHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("Expected Authorization to be 'Bearer token', got '%s'", r.Header.Get("Authorization"))
+		}
+		if r.Header.Get("X-Api-Key") != "secret" {
+			t.Errorf("Expected X-Api-Key to be 'secret', got '%s'", r.Header.Get("X-Api-Key"))
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	builder := NewRequestBuilder()
+	builder.WithUsernameAndPassword("user", "password")
+	builder.WithCustomHeaders(http.Header{"Authorization": {"Bearer token"}, "X-Api-Key": {"secret"}})
+
+	if redacted := builder.redactedHeaders(); redacted.Get("X-Api-Key") != "[redacted]" || redacted.Get("Authorization") != "[redacted]" {
+		t.Errorf("Expected custom headers to be redacted, got %v", redacted)
+	}
+
+	resp, err := builder.ExecuteRequest(server.URL)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer resp.Body.Close()
+}
+
+func TestRequestBuilder_WithClientCertificateAndCACertificates(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) != 1 || r.TLS.PeerCertificates[0].Subject.CommonName != "miniflux-client" {
+			t.Errorf("Expected the client certificate to be presented")
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	serverCertificate := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	clientCertificate, clientKey := generateTestCertificate(t, "miniflux-client")
+
+	builder := NewRequestBuilder()
+	builder.WithClientCertificate(clientCertificate, clientKey)
+	builder.WithCACertificates(serverCertificate)
+
+	resp, err := builder.ExecuteRequest(server.URL)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	}
+}
+
+func TestRequestBuilder_WithUntrustedCACertificates(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	otherCertificate, _ := generateTestCertificate(t, "other-ca")
+
+	if _, err := NewRequestBuilder().WithCACertificates(otherCertificate).ExecuteRequest(server.URL); err == nil {
+		t.Error("Expected an error when the server certificate is not signed by the CA bundle")
+	}
+}
+
+func TestRequestBuilder_WithInvalidClientCertificate(t *testing.T) {
+	if _, err := NewRequestBuilder().WithClientCertificate("invalid", "invalid").ExecuteRequest("https://example.org"); err == nil {
+		t.Error("Expected an error with an invalid client certificate")
+	}
+
+	if _, err := NewRequestBuilder().WithCACertificates("invalid").ExecuteRequest("https://example.org"); err == nil {
+		t.Error("Expected an error with invalid CA certificates")
+	}
+}
+
+func generateTestCertificate(t *testing.T, commonName string) (string, string) {
+	t.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: key}))
+}
+
 func TestRequestBuilder_WithUsernameAndPassword(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -420,3 +544,69 @@ func TestRequestBuilder_TimeoutConfiguration(t *testing.T) {
 		t.Errorf("Expected timeout around 1s, took %v", duration)
 	}
 }
+
+func TestRequestBuilder_ReportsProxyFailures(t *testing.T) {
+	proxyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxyServer.Close()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadProxyURL := "http://" + listener.Addr().String()
+	listener.Close()
+
+	rotator, err := proxyrotator.NewProxyRotator([]string{deadProxyURL, proxyServer.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotator.SetEjectionPolicy(1, time.Minute)
+
+	if _, err := NewRequestBuilder().WithProxyRotator(rotator).ExecuteRequest("http://example.org/feed.xml"); err == nil {
+		t.Fatal("Expected an error when the proxy is unreachable")
+	}
+
+	for range 2 {
+		resp, err := NewRequestBuilder().WithProxyRotator(rotator).ExecuteRequest("http://example.org/feed.xml")
+		if err != nil {
+			t.Fatalf("Expected the request to go through the healthy proxy, got: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	stats := rotator.Stats()
+	if stats[0].Failures != 1 || stats[0].Healthy {
+		t.Errorf("Expected the unreachable proxy to be ejected, got: %+v", stats[0])
+	}
+	if stats[1].Failures != 0 || stats[1].Requests != 2 {
+		t.Errorf("Unexpected stats for the healthy proxy: %+v", stats[1])
+	}
+}
+
+func TestIsProxyConnectionError(t *testing.T) {
+	proxyDialErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connect: connection refused")}
+
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"no error", nil, false},
+		{"remote server error", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connect: connection refused")}, false},
+		{"http proxy unreachable", &url.Error{Op: "Get", Err: &net.OpError{Op: "proxyconnect", Net: "tcp", Err: proxyDialErr}}, true},
+		{"socks proxy unreachable", &net.OpError{Op: "socks connect", Net: "tcp", Err: proxyDialErr}, true},
+		{"socks authentication failure", &net.OpError{Op: "socks connect", Net: "tcp", Err: errors.New("username/password authentication failed")}, true},
+		{"socks remote host unreachable", &net.OpError{Op: "socks connect", Net: "tcp", Err: errors.New("unknown error host unreachable")}, false},
+		{"socks remote connection refused", &net.OpError{Op: "socks connect", Net: "tcp", Err: errors.New("unknown error connection refused")}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := isProxyConnectionError(tc.err); result != tc.expected {
+				t.Errorf("Expected %v for %v, got %v", tc.expected, tc.err, result)
+			}
+		})
+	}
+}
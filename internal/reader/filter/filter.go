@@ -196,6 +196,9 @@ func matchesRule(rule filterRule, entry *model.Entry) bool {
 		return match
 	case "EntryTag":
 		return containsRegexPattern(rule.Value, entry.Tags)
+	case "EntryLanguage":
+		match, _ := regexp.MatchString(rule.Value, entry.Language)
+		return match
 	}
 
 	return false
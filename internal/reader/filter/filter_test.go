@@ -23,6 +23,7 @@ func createTestEntry() *model.Entry {
 		Author:      "Test Author",
 		Date:        time.Now(),
 		Tags:        []string{"golang", "testing", "miniflux"},
+		Language:    "en",
 	}
 }
 
@@ -649,6 +650,18 @@ func TestMatchesRule(t *testing.T) {
 			entry:    entry,
 			expected: false,
 		},
+		{
+			name:     "EntryLanguage match",
+			rule:     filterRule{Type: "EntryLanguage", Value: "^(en|fr)$"},
+			entry:    entry,
+			expected: true,
+		},
+		{
+			name:     "EntryLanguage no match",
+			rule:     filterRule{Type: "EntryLanguage", Value: "^de$"},
+			entry:    entry,
+			expected: false,
+		},
 		{
 			name:     "EntryDate future",
 			rule:     filterRule{Type: "EntryDate", Value: "future"},
@@ -5,6 +5,7 @@ package handler // import "miniflux.app/v2/internal/reader/handler"
 
 import (
 	"bytes"
+	"cmp"
 	"errors"
 	"log/slog"
 	"time"
@@ -34,7 +35,12 @@ func CreateFeedFromSubscriptionDiscovery(store *storage.Storage, userID int64, f
 		slog.String("proxy_url", feedCreationRequest.ProxyURL),
 	)
 
-	if !store.CategoryIDExists(userID, feedCreationRequest.CategoryID) {
+	category, storeErr := store.Category(userID, feedCreationRequest.CategoryID)
+	if storeErr != nil {
+		return nil, locale.NewLocalizedErrorWrapper(storeErr, "error.database_error", storeErr)
+	}
+
+	if category == nil {
 		return nil, locale.NewLocalizedErrorWrapper(ErrCategoryNotFound, "error.category_not_found")
 	}
 
@@ -50,13 +56,22 @@ func CreateFeedFromSubscriptionDiscovery(store *storage.Storage, userID int64, f
 	subscription.UserID = userID
 	subscription.UserAgent = feedCreationRequest.UserAgent
 	subscription.Cookie = feedCreationRequest.Cookie
+	subscription.HTTPHeaders = feedCreationRequest.HTTPHeaders
+	subscription.ClientCertificate = feedCreationRequest.ClientCertificate
+	subscription.ClientKey = feedCreationRequest.ClientKey
+	subscription.CACertificates = feedCreationRequest.CACertificates
 	subscription.Username = feedCreationRequest.Username
 	subscription.Password = feedCreationRequest.Password
 	subscription.Crawler = feedCreationRequest.Crawler
+	subscription.InheritCrawler = feedCreationRequest.InheritCrawler
+	subscription.LLMProcessing = feedCreationRequest.LLMProcessing
 	subscription.Disabled = feedCreationRequest.Disabled
 	subscription.IgnoreHTTPCache = feedCreationRequest.IgnoreHTTPCache
 	subscription.AllowSelfSignedCertificates = feedCreationRequest.AllowSelfSignedCertificates
 	subscription.FetchViaProxy = feedCreationRequest.FetchViaProxy
+	subscription.InheritFetchViaProxy = feedCreationRequest.InheritFetchViaProxy
+	subscription.InheritNtfyEnabled = true
+	subscription.InheritPushoverEnabled = true
 	subscription.ScraperRules = feedCreationRequest.ScraperRules
 	subscription.RewriteRules = feedCreationRequest.RewriteRules
 	subscription.BlocklistRules = feedCreationRequest.BlocklistRules
@@ -68,8 +83,9 @@ func CreateFeedFromSubscriptionDiscovery(store *storage.Storage, userID int64, f
 	subscription.LastModifiedHeader = feedCreationRequest.LastModified
 	subscription.FeedURL = feedCreationRequest.FeedURL
 	subscription.DisableHTTP2 = feedCreationRequest.DisableHTTP2
-	subscription.WithCategoryID(feedCreationRequest.CategoryID)
+	subscription.Category = category
 	subscription.ProxyURL = feedCreationRequest.ProxyURL
+	subscription.ProxyPool = feedCreationRequest.ProxyPool
 	subscription.CheckedNow()
 
 	processor.ProcessFeedEntries(store, subscription, userID, true)
@@ -89,14 +105,17 @@ func CreateFeedFromSubscriptionDiscovery(store *storage.Storage, userID int64, f
 	requestBuilder.WithUserAgent(feedCreationRequest.UserAgent, config.Opts.HTTPClientUserAgent())
 	requestBuilder.WithCookie(feedCreationRequest.Cookie)
 	requestBuilder.WithTimeout(config.Opts.HTTPClientTimeout())
-	requestBuilder.WithProxyRotator(proxyrotator.ProxyRotatorInstance)
+	requestBuilder.WithProxyRotator(proxyrotator.Pool(feedCreationRequest.ProxyPool))
 	requestBuilder.WithCustomFeedProxyURL(feedCreationRequest.ProxyURL)
 	requestBuilder.WithCustomApplicationProxyURL(config.Opts.HTTPClientProxyURL())
 	requestBuilder.UseCustomApplicationProxyURL(feedCreationRequest.FetchViaProxy)
 	requestBuilder.IgnoreTLSErrors(feedCreationRequest.AllowSelfSignedCertificates)
 	requestBuilder.DisableHTTP2(feedCreationRequest.DisableHTTP2)
+	requestBuilder.WithCustomHeaders(feedCreationRequest.RequestHeaders())
+	requestBuilder.WithClientCertificate(feedCreationRequest.ClientCertificate, feedCreationRequest.ClientKey)
+	requestBuilder.WithCACertificates(feedCreationRequest.CACertificates)
 
-	icon.NewIconChecker(store, subscription).UpdateOrCreateFeedIcon()
+	icon.NewIconChecker(store, subscription.InheritCategorySettings()).UpdateOrCreateFeedIcon()
 
 	return subscription, nil
 }
@@ -109,21 +128,29 @@ func CreateFeed(store *storage.Storage, userID int64, feedCreationRequest *model
 		slog.String("proxy_url", feedCreationRequest.ProxyURL),
 	)
 
-	if !store.CategoryIDExists(userID, feedCreationRequest.CategoryID) {
+	category, storeErr := store.Category(userID, feedCreationRequest.CategoryID)
+	if storeErr != nil {
+		return nil, locale.NewLocalizedErrorWrapper(storeErr, "error.database_error", storeErr)
+	}
+
+	if category == nil {
 		return nil, locale.NewLocalizedErrorWrapper(ErrCategoryNotFound, "error.category_not_found")
 	}
 
 	requestBuilder := fetcher.NewRequestBuilder()
 	requestBuilder.WithUsernameAndPassword(feedCreationRequest.Username, feedCreationRequest.Password)
-	requestBuilder.WithUserAgent(feedCreationRequest.UserAgent, config.Opts.HTTPClientUserAgent())
-	requestBuilder.WithCookie(feedCreationRequest.Cookie)
+	requestBuilder.WithUserAgent(cmp.Or(feedCreationRequest.UserAgent, category.UserAgent), config.Opts.HTTPClientUserAgent())
+	requestBuilder.WithCookie(cmp.Or(feedCreationRequest.Cookie, category.Cookie))
 	requestBuilder.WithTimeout(config.Opts.HTTPClientTimeout())
-	requestBuilder.WithProxyRotator(proxyrotator.ProxyRotatorInstance)
-	requestBuilder.WithCustomFeedProxyURL(feedCreationRequest.ProxyURL)
+	requestBuilder.WithProxyRotator(proxyrotator.Pool(feedCreationRequest.ProxyPool))
+	requestBuilder.WithCustomFeedProxyURL(cmp.Or(feedCreationRequest.ProxyURL, category.ProxyURL))
 	requestBuilder.WithCustomApplicationProxyURL(config.Opts.HTTPClientProxyURL())
-	requestBuilder.UseCustomApplicationProxyURL(feedCreationRequest.FetchViaProxy)
+	requestBuilder.UseCustomApplicationProxyURL(feedCreationRequest.FetchViaProxy || category.FetchViaProxy)
 	requestBuilder.IgnoreTLSErrors(feedCreationRequest.AllowSelfSignedCertificates)
 	requestBuilder.DisableHTTP2(feedCreationRequest.DisableHTTP2)
+	requestBuilder.WithCustomHeaders(feedCreationRequest.RequestHeaders())
+	requestBuilder.WithClientCertificate(feedCreationRequest.ClientCertificate, feedCreationRequest.ClientKey)
+	requestBuilder.WithCACertificates(feedCreationRequest.CACertificates)
 
 	responseHandler := fetcher.NewResponseHandler(requestBuilder.ExecuteRequest(feedCreationRequest.FeedURL))
 	defer responseHandler.Close()
@@ -151,14 +178,23 @@ func CreateFeed(store *storage.Storage, userID int64, feedCreationRequest *model
 	subscription.UserID = userID
 	subscription.UserAgent = feedCreationRequest.UserAgent
 	subscription.Cookie = feedCreationRequest.Cookie
+	subscription.HTTPHeaders = feedCreationRequest.HTTPHeaders
+	subscription.ClientCertificate = feedCreationRequest.ClientCertificate
+	subscription.ClientKey = feedCreationRequest.ClientKey
+	subscription.CACertificates = feedCreationRequest.CACertificates
 	subscription.Username = feedCreationRequest.Username
 	subscription.Password = feedCreationRequest.Password
 	subscription.Crawler = feedCreationRequest.Crawler
+	subscription.InheritCrawler = feedCreationRequest.InheritCrawler
+	subscription.LLMProcessing = feedCreationRequest.LLMProcessing
 	subscription.Disabled = feedCreationRequest.Disabled
 	subscription.IgnoreHTTPCache = feedCreationRequest.IgnoreHTTPCache
 	subscription.AllowSelfSignedCertificates = feedCreationRequest.AllowSelfSignedCertificates
 	subscription.DisableHTTP2 = feedCreationRequest.DisableHTTP2
 	subscription.FetchViaProxy = feedCreationRequest.FetchViaProxy
+	subscription.InheritFetchViaProxy = feedCreationRequest.InheritFetchViaProxy
+	subscription.InheritNtfyEnabled = true
+	subscription.InheritPushoverEnabled = true
 	subscription.ScraperRules = feedCreationRequest.ScraperRules
 	subscription.RewriteRules = feedCreationRequest.RewriteRules
 	subscription.UrlRewriteRules = feedCreationRequest.UrlRewriteRules
@@ -171,7 +207,8 @@ func CreateFeed(store *storage.Storage, userID int64, feedCreationRequest *model
 	subscription.LastModifiedHeader = responseHandler.LastModified()
 	subscription.FeedURL = responseHandler.EffectiveURL()
 	subscription.ProxyURL = feedCreationRequest.ProxyURL
-	subscription.WithCategoryID(feedCreationRequest.CategoryID)
+	subscription.ProxyPool = feedCreationRequest.ProxyPool
+	subscription.Category = category
 	subscription.CheckedNow()
 
 	processor.ProcessFeedEntries(store, subscription, userID, true)
@@ -186,7 +223,7 @@ func CreateFeed(store *storage.Storage, userID int64, feedCreationRequest *model
 		slog.String("feed_url", subscription.FeedURL),
 	)
 
-	icon.NewIconChecker(store, subscription).UpdateOrCreateFeedIcon()
+	icon.NewIconChecker(store, subscription.InheritCategorySettings()).UpdateOrCreateFeedIcon()
 
 	return subscription, nil
 }
@@ -221,17 +258,23 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 	originalFeed.CheckedNow()
 	originalFeed.ScheduleNextCheck(weeklyEntryCount, refreshDelay)
 
+	// Settings left empty on the feed are inherited from its category.
+	effectiveFeed := originalFeed.InheritCategorySettings()
+
 	requestBuilder := fetcher.NewRequestBuilder()
 	requestBuilder.WithUsernameAndPassword(originalFeed.Username, originalFeed.Password)
-	requestBuilder.WithUserAgent(originalFeed.UserAgent, config.Opts.HTTPClientUserAgent())
-	requestBuilder.WithCookie(originalFeed.Cookie)
+	requestBuilder.WithUserAgent(effectiveFeed.UserAgent, config.Opts.HTTPClientUserAgent())
+	requestBuilder.WithCookie(effectiveFeed.Cookie)
 	requestBuilder.WithTimeout(config.Opts.HTTPClientTimeout())
-	requestBuilder.WithProxyRotator(proxyrotator.ProxyRotatorInstance)
-	requestBuilder.WithCustomFeedProxyURL(originalFeed.ProxyURL)
+	requestBuilder.WithProxyRotator(proxyrotator.Pool(originalFeed.ProxyPool))
+	requestBuilder.WithCustomFeedProxyURL(effectiveFeed.ProxyURL)
 	requestBuilder.WithCustomApplicationProxyURL(config.Opts.HTTPClientProxyURL())
-	requestBuilder.UseCustomApplicationProxyURL(originalFeed.FetchViaProxy)
+	requestBuilder.UseCustomApplicationProxyURL(effectiveFeed.FetchViaProxy)
 	requestBuilder.IgnoreTLSErrors(originalFeed.AllowSelfSignedCertificates)
 	requestBuilder.DisableHTTP2(originalFeed.DisableHTTP2)
+	requestBuilder.WithCustomHeaders(originalFeed.RequestHeaders())
+	requestBuilder.WithClientCertificate(originalFeed.ClientCertificate, originalFeed.ClientKey)
+	requestBuilder.WithCACertificates(originalFeed.CACertificates)
 
 	ignoreHTTPCache := originalFeed.IgnoreHTTPCache || forceRefresh
 	if !ignoreHTTPCache {
@@ -339,7 +382,7 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 		processor.ProcessFeedEntries(store, originalFeed, userID, forceRefresh)
 
 		// We don't update existing entries when the crawler is enabled (we crawl only inexisting entries). Unless it is forced to refresh
-		updateExistingEntries := forceRefresh || !originalFeed.Crawler
+		updateExistingEntries := forceRefresh || !effectiveFeed.Crawler
 		newEntries, storeErr := store.RefreshFeedEntries(originalFeed.UserID, originalFeed.ID, originalFeed.Entries, updateExistingEntries)
 		if storeErr != nil {
 			localizedError := locale.NewLocalizedErrorWrapper(storeErr, "error.database_error", storeErr)
@@ -360,14 +403,14 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 				slog.Any("error", intErr),
 			)
 		} else if userIntegrations != nil && len(newEntries) > 0 {
-			go integration.PushEntries(originalFeed, newEntries, userIntegrations)
+			go integration.PushEntries(effectiveFeed, newEntries, userIntegrations)
 		}
 
 		originalFeed.EtagHeader = responseHandler.ETag()
 		originalFeed.LastModifiedHeader = responseHandler.LastModified()
 
 		originalFeed.IconURL = updatedFeed.IconURL
-		iconChecker := icon.NewIconChecker(store, originalFeed)
+		iconChecker := icon.NewIconChecker(store, originalFeed.InheritCategorySettings())
 		if forceRefresh {
 			iconChecker.UpdateOrCreateFeedIcon()
 		} else {
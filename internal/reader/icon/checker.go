@@ -30,12 +30,15 @@ func (c *iconChecker) fetchAndStoreIcon() {
 	requestBuilder.WithUserAgent(c.feed.UserAgent, config.Opts.HTTPClientUserAgent())
 	requestBuilder.WithCookie(c.feed.Cookie)
 	requestBuilder.WithTimeout(config.Opts.HTTPClientTimeout())
-	requestBuilder.WithProxyRotator(proxyrotator.ProxyRotatorInstance)
+	requestBuilder.WithProxyRotator(proxyrotator.Pool(c.feed.ProxyPool))
 	requestBuilder.WithCustomFeedProxyURL(c.feed.ProxyURL)
 	requestBuilder.WithCustomApplicationProxyURL(config.Opts.HTTPClientProxyURL())
 	requestBuilder.UseCustomApplicationProxyURL(c.feed.FetchViaProxy)
 	requestBuilder.IgnoreTLSErrors(c.feed.AllowSelfSignedCertificates)
 	requestBuilder.DisableHTTP2(c.feed.DisableHTTP2)
+	requestBuilder.WithCustomHeaders(c.feed.RequestHeaders())
+	requestBuilder.WithClientCertificate(c.feed.ClientCertificate, c.feed.ClientKey)
+	requestBuilder.WithCACertificates(c.feed.CACertificates)
 
 	iconFinder := newIconFinder(requestBuilder, c.feed.SiteURL, c.feed.IconURL)
 	if icon, err := iconFinder.findIcon(); err != nil {
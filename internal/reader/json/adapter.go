@@ -4,6 +4,7 @@
 package json // import "miniflux.app/v2/internal/reader/json"
 
 import (
+	"cmp"
 	"log/slog"
 	"slices"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"miniflux.app/v2/internal/crypto"
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/reader/date"
+	"miniflux.app/v2/internal/reader/language"
 	"miniflux.app/v2/internal/reader/sanitizer"
 	"miniflux.app/v2/internal/urllib"
 )
@@ -68,6 +70,7 @@ func (j *JSONAdapter) BuildFeed(baseURL string) *model.Feed {
 	for _, item := range j.jsonFeed.Items {
 		entry := model.NewEntry()
 		entry.Title = strings.TrimSpace(item.Title)
+		entry.Language = language.Normalize(cmp.Or(item.Language, j.jsonFeed.Language))
 		entry.URL = strings.TrimSpace(item.URL)
 
 		// Make sure the entry URL is absolute.
@@ -149,6 +152,17 @@ func (j *JSONAdapter) BuildFeed(baseURL string) *model.Feed {
 			}
 		}
 
+		// Populate the entry thumbnail.
+		for _, imageURL := range []string{item.ImageURL, item.BannerImageURL} {
+			imageURL = strings.TrimSpace(imageURL)
+			if imageURL != "" {
+				if absoluteImageURL, err := urllib.AbsoluteURL(feed.SiteURL, imageURL); err == nil {
+					entry.ThumbnailURL = absoluteImageURL
+					break
+				}
+			}
+		}
+
 		// Populate the entry tags.
 		for _, tag := range item.Tags {
 			tag = strings.TrimSpace(tag)
@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package language detects the language of a text.
+//
+// Texts written with a script used by a single language (Greek, Hangul, Kana, etc.) are identified by their script.
+// Texts written with the Latin or Cyrillic scripts are compared to built-in trigram profiles
+// using the "out-of-place" distance described by Cavnar and Trenkle.
+package language // import "miniflux.app/v2/internal/reader/language"
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const (
+	// maxTextLength is the number of bytes of text analyzed by the detector.
+	maxTextLength = 4096
+
+	// minLetters is the minimum number of letters required to run the detector.
+	minLetters = 20
+
+	// maxProfileSize is the number of trigrams kept in each profile.
+	maxProfileSize = 300
+
+	// hintTolerance is the maximum relative distance between the best match and the language hint
+	// for the hint to be preferred.
+	hintTolerance = 0.05
+
+	// maxConfidentScore is the normalized distance above which the detection is considered unreliable.
+	maxConfidentScore = 0.9
+)
+
+type profile map[string]int
+
+var profiles = buildProfiles()
+
+var scriptLanguages = []struct {
+	script   *unicode.RangeTable
+	language string
+}{
+	{unicode.Hangul, "ko"},
+	{unicode.Hiragana, "ja"},
+	{unicode.Katakana, "ja"},
+	{unicode.Han, "zh"},
+	{unicode.Greek, "el"},
+	{unicode.Hebrew, "he"},
+	{unicode.Arabic, "ar"},
+	{unicode.Devanagari, "hi"},
+	{unicode.Thai, "th"},
+	{unicode.Armenian, "hy"},
+	{unicode.Georgian, "ka"},
+}
+
+// SupportedLanguages returns the list of languages recognized by the n-gram detector.
+func SupportedLanguages() []string {
+	languages := make([]string, 0, len(profiles))
+	for language := range profiles {
+		languages = append(languages, language)
+	}
+	slices.Sort(languages)
+	return languages
+}
+
+// Normalize converts a language tag such as "en-US" or "pt_BR" to its primary ISO 639 code.
+// It returns an empty string if the tag is not valid.
+func Normalize(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if index := strings.IndexAny(tag, "-_"); index != -1 {
+		tag = tag[:index]
+	}
+
+	if len(tag) < 2 || len(tag) > 3 {
+		return ""
+	}
+
+	for _, r := range tag {
+		if r < 'a' || r > 'z' {
+			return ""
+		}
+	}
+
+	return tag
+}
+
+// Detect returns the ISO 639-1 code of the language of the given plain text.
+//
+// The hint is the language declared by the publisher (e.g. the feed language), it is returned
+// when the text is too short or ambiguous, and it is preferred when it is almost as good as the best match.
+func Detect(text, hint string) string {
+	hint = Normalize(hint)
+
+	if len(text) > maxTextLength {
+		text = text[:maxTextLength]
+	}
+
+	letters, scriptCounts := countLetters(text)
+	if letters < minLetters {
+		return hint
+	}
+
+	// Japanese texts mix Kanji with Kana, check Kana before Han.
+	if scriptCounts["ja"] > 0 && scriptCounts["ja"]*10 >= letters {
+		return "ja"
+	}
+
+	for _, entry := range scriptLanguages {
+		if scriptCounts[entry.language]*2 > letters {
+			return entry.language
+		}
+	}
+
+	documentProfile := buildProfile(text)
+	if len(documentProfile) == 0 {
+		return hint
+	}
+
+	type candidate struct {
+		language string
+		distance int
+	}
+
+	candidates := make([]candidate, 0, len(profiles))
+	for language, languageProfile := range profiles {
+		candidates = append(candidates, candidate{language, distance(documentProfile, languageProfile)})
+	}
+
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		return cmp.Or(cmp.Compare(a.distance, b.distance), strings.Compare(a.language, b.language))
+	})
+
+	best := candidates[0]
+	if hint == "" {
+		return best.language
+	}
+
+	for _, c := range candidates {
+		if c.language == hint {
+			if float64(c.distance) <= float64(best.distance)*(1+hintTolerance) {
+				return hint
+			}
+			return best.language
+		}
+	}
+
+	// The hint is not a language known by the n-gram detector.
+	maxDistance := len(documentProfile) * maxProfileSize
+	if float64(best.distance)/float64(maxDistance) > maxConfidentScore {
+		return hint
+	}
+
+	return best.language
+}
+
+func countLetters(text string) (int, map[string]int) {
+	letters := 0
+	scriptCounts := make(map[string]int)
+
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+
+		for _, entry := range scriptLanguages {
+			if unicode.Is(entry.script, r) {
+				scriptCounts[entry.language]++
+				break
+			}
+		}
+	}
+
+	return letters, scriptCounts
+}
+
+func buildProfiles() map[string]profile {
+	result := make(map[string]profile, len(referenceTexts))
+	for language, text := range referenceTexts {
+		result[language] = buildProfile(text)
+	}
+	return result
+}
+
+func buildProfile(text string) profile {
+	frequencies := make(map[string]int)
+
+	for word := range strings.FieldsFuncSeq(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	}) {
+		runes := []rune(" " + word + " ")
+		for i := 0; i+3 <= len(runes); i++ {
+			frequencies[string(runes[i:i+3])]++
+		}
+	}
+
+	trigrams := make([]string, 0, len(frequencies))
+	for trigram := range frequencies {
+		trigrams = append(trigrams, trigram)
+	}
+
+	slices.SortFunc(trigrams, func(a, b string) int {
+		return cmp.Or(cmp.Compare(frequencies[b], frequencies[a]), strings.Compare(a, b))
+	})
+
+	if len(trigrams) > maxProfileSize {
+		trigrams = trigrams[:maxProfileSize]
+	}
+
+	result := make(profile, len(trigrams))
+	for rank, trigram := range trigrams {
+		result[trigram] = rank
+	}
+
+	return result
+}
+
+func distance(document, language profile) int {
+	total := 0
+	for trigram, documentRank := range document {
+		if languageRank, found := language[trigram]; found {
+			total += max(documentRank-languageRank, languageRank-documentRank)
+		} else {
+			total += maxProfileSize
+		}
+	}
+	return total
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package language // import "miniflux.app/v2/internal/reader/language"
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	var scenarios = []struct {
+		tag      string
+		expected string
+	}{
+		{"en", "en"},
+		{"en-US", "en"},
+		{" pt_BR ", "pt"},
+		{"FR-fr", "fr"},
+		{"fil", "fil"},
+		{"", ""},
+		{"e", ""},
+		{"english", ""},
+		{"12", ""},
+	}
+
+	for _, tc := range scenarios {
+		if result := Normalize(tc.tag); result != tc.expected {
+			t.Errorf(`Unexpected result for %q, got %q instead of %q`, tc.tag, result, tc.expected)
+		}
+	}
+}
+
+func TestDetect(t *testing.T) {
+	var scenarios = []struct {
+		text     string
+		hint     string
+		expected string
+	}{
+		{"The quick brown fox jumps over the lazy dog while the children are playing in the garden.", "", "en"},
+		{"Les enfants jouent dans le jardin pendant que leurs parents préparent le repas du soir.", "", "fr"},
+		{"Die Kinder spielen im Garten, während ihre Eltern das Abendessen vorbereiten.", "", "de"},
+		{"Los niños juegan en el jardín mientras sus padres preparan la cena para toda la familia.", "", "es"},
+		{"I bambini giocano in giardino mentre i loro genitori preparano la cena per tutta la famiglia.", "", "it"},
+		{"As crianças brincam no jardim enquanto os pais preparam o jantar para toda a família.", "", "pt"},
+		{"De kinderen spelen in de tuin terwijl hun ouders het avondeten voor de hele familie klaarmaken.", "", "nl"},
+		{"Dzieci bawią się w ogrodzie, podczas gdy ich rodzice przygotowują kolację dla całej rodziny.", "", "pl"},
+		{"Дети играют в саду, пока их родители готовят ужин для всей семьи.", "", "ru"},
+		{"Діти граються в саду, поки їхні батьки готують вечерю для всієї родини.", "", "uk"},
+		{"子供たちは庭で遊んでいて、両親は家族のために夕食を作っています。", "", "ja"},
+		{"孩子们在花园里玩耍，而他们的父母正在为全家准备晚餐。", "", "zh"},
+		{"아이들은 정원에서 놀고 부모님은 저녁을 준비하고 있습니다.", "", "ko"},
+		{"Τα παιδιά παίζουν στον κήπο ενώ οι γονείς τους ετοιμάζουν το δείπνο.", "", "el"},
+		{"Too short", "de-DE", "de"},
+		{"Too short", "", ""},
+		{"The quick brown fox jumps over the lazy dog while the children are playing in the garden.", "fr", "en"},
+	}
+
+	for _, tc := range scenarios {
+		if result := Detect(tc.text, tc.hint); result != tc.expected {
+			t.Errorf(`Unexpected language for %q (hint %q), got %q instead of %q`, tc.text, tc.hint, result, tc.expected)
+		}
+	}
+}
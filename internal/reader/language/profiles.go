@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package language // import "miniflux.app/v2/internal/reader/language"
+
+// Reference texts used to build the trigram profile of each language written with the Latin or Cyrillic scripts.
+// They are made of common sentences so that frequent function words dominate the profiles.
+var referenceTexts = map[string]string{
+	"en": `The government announced on Monday that it would publish a new report about the state of the economy.
+		According to the minister, this is the first time that all of the data have been made available to the public.
+		We asked several people what they think about these changes, and most of them said that they were not surprised.
+		There is still a lot of work to do before the end of the year, but the team believes that the project will be ready in time.
+		If you want to know more about this story, you can read the full article on our website or listen to the podcast.
+		It was one of the most important decisions of the last decade, and people will be talking about it for years.
+		Which of these options would you choose, and why would you have done things differently?
+		The new version of the software includes many improvements that should make it faster and easier to use.`,
+
+	"fr": `Le gouvernement a annoncé lundi qu'il allait publier un nouveau rapport sur l'état de l'économie.
+		Selon le ministre, c'est la première fois que toutes les données sont mises à la disposition du public.
+		Nous avons demandé à plusieurs personnes ce qu'elles pensent de ces changements, et la plupart d'entre elles n'étaient pas surprises.
+		Il reste encore beaucoup de travail à faire avant la fin de l'année, mais l'équipe pense que le projet sera prêt à temps.
+		Si vous voulez en savoir plus sur cette histoire, vous pouvez lire l'article complet sur notre site ou écouter le balado.
+		C'était l'une des décisions les plus importantes de la dernière décennie, et les gens en parleront pendant des années.
+		Laquelle de ces options choisiriez-vous, et pourquoi auriez-vous fait les choses autrement ?
+		La nouvelle version du logiciel comprend de nombreuses améliorations qui devraient la rendre plus rapide et plus facile à utiliser.`,
+
+	"de": `Die Regierung hat am Montag angekündigt, dass sie einen neuen Bericht über die Lage der Wirtschaft veröffentlichen wird.
+		Nach Angaben des Ministers ist es das erste Mal, dass alle Daten der Öffentlichkeit zur Verfügung gestellt werden.
+		Wir haben mehrere Leute gefragt, was sie von diesen Änderungen halten, und die meisten von ihnen waren nicht überrascht.
+		Bis zum Ende des Jahres gibt es noch viel zu tun, aber das Team glaubt, dass das Projekt rechtzeitig fertig sein wird.
+		Wenn Sie mehr über diese Geschichte erfahren möchten, können Sie den vollständigen Artikel auf unserer Webseite lesen oder den Podcast hören.
+		Es war eine der wichtigsten Entscheidungen des letzten Jahrzehnts, und die Menschen werden noch jahrelang darüber sprechen.
+		Welche dieser Möglichkeiten würden Sie wählen, und warum hätten Sie die Dinge anders gemacht?
+		Die neue Version der Software enthält viele Verbesserungen, die sie schneller und einfacher zu bedienen machen sollen.`,
+
+	"es": `El gobierno anunció el lunes que publicará un nuevo informe sobre el estado de la economía.
+		Según el ministro, es la primera vez que todos los datos se ponen a disposición del público.
+		Preguntamos a varias personas qué piensan de estos cambios, y la mayoría de ellas dijo que no estaban sorprendidas.
+		Todavía queda mucho trabajo por hacer antes del final del año, pero el equipo cree que el proyecto estará listo a tiempo.
+		Si quieres saber más sobre esta historia, puedes leer el artículo completo en nuestra página o escuchar el pódcast.
+		Fue una de las decisiones más importantes de la última década, y la gente hablará de ello durante años.
+		¿Cuál de estas opciones elegirías, y por qué habrías hecho las cosas de otra manera?
+		La nueva versión del programa incluye muchas mejoras que deberían hacerlo más rápido y más fácil de usar.`,
+
+	"it": `Il governo ha annunciato lunedì che pubblicherà un nuovo rapporto sullo stato dell'economia.
+		Secondo il ministro, è la prima volta che tutti i dati vengono messi a disposizione del pubblico.
+		Abbiamo chiesto a diverse persone che cosa pensano di questi cambiamenti, e la maggior parte di loro non era sorpresa.
+		C'è ancora molto lavoro da fare prima della fine dell'anno, ma la squadra crede che il progetto sarà pronto in tempo.
+		Se vuoi saperne di più su questa storia, puoi leggere l'articolo completo sul nostro sito o ascoltare il podcast.
+		È stata una delle decisioni più importanti dell'ultimo decennio, e la gente ne parlerà per anni.
+		Quale di queste opzioni sceglieresti, e perché avresti fatto le cose in modo diverso?
+		La nuova versione del programma contiene molti miglioramenti che dovrebbero renderlo più veloce e più facile da usare.`,
+
+	"pt": `O governo anunciou na segunda-feira que vai publicar um novo relatório sobre o estado da economia.
+		Segundo o ministro, é a primeira vez que todos os dados são colocados à disposição do público.
+		Perguntamos a várias pessoas o que elas pensam sobre essas mudanças, e a maioria delas disse que não ficou surpresa.
+		Ainda há muito trabalho a fazer antes do fim do ano, mas a equipe acredita que o projeto estará pronto a tempo.
+		Se você quiser saber mais sobre esta história, pode ler o artigo completo no nosso site ou ouvir o podcast.
+		Foi uma das decisões mais importantes da última década, e as pessoas vão falar sobre isso durante anos.
+		Qual dessas opções você escolheria, e por que teria feito as coisas de outra maneira?
+		A nova versão do programa inclui muitas melhorias que devem torná-lo mais rápido e mais fácil de usar.`,
+
+	"nl": `De regering heeft maandag aangekondigd dat zij een nieuw rapport over de toestand van de economie zal publiceren.
+		Volgens de minister is het de eerste keer dat alle gegevens voor het publiek beschikbaar worden gesteld.
+		We hebben verschillende mensen gevraagd wat zij van deze veranderingen vinden, en de meesten van hen waren niet verrast.
+		Er is nog veel werk te doen voor het einde van het jaar, maar het team gelooft dat het project op tijd klaar zal zijn.
+		Als je meer over dit verhaal wilt weten, kun je het volledige artikel op onze website lezen of naar de podcast luisteren.
+		Het was een van de belangrijkste beslissingen van het afgelopen decennium, en mensen zullen er nog jaren over praten.
+		Welke van deze mogelijkheden zou je kiezen, en waarom zou je het anders hebben gedaan?
+		De nieuwe versie van de software bevat veel verbeteringen die het sneller en gemakkelijker in gebruik moeten maken.`,
+
+	"pl": `Rząd ogłosił w poniedziałek, że opublikuje nowy raport na temat stanu gospodarki.
+		Według ministra po raz pierwszy wszystkie dane zostaną udostępnione opinii publicznej.
+		Zapytaliśmy kilka osób, co myślą o tych zmianach, i większość z nich powiedziała, że nie była zaskoczona.
+		Do końca roku pozostało jeszcze dużo pracy, ale zespół uważa, że projekt będzie gotowy na czas.
+		Jeśli chcesz dowiedzieć się więcej o tej historii, możesz przeczytać cały artykuł na naszej stronie lub posłuchać podcastu.
+		Była to jedna z najważniejszych decyzji ostatniej dekady i ludzie będą o niej mówić przez wiele lat.
+		Którą z tych możliwości byś wybrał i dlaczego zrobiłbyś to inaczej?
+		Nowa wersja programu zawiera wiele ulepszeń, które powinny sprawić, że będzie szybszy i łatwiejszy w użyciu.`,
+
+	"tr": `Hükümet pazartesi günü ekonominin durumu hakkında yeni bir rapor yayınlayacağını açıkladı.
+		Bakana göre tüm verilerin kamuoyunun kullanımına sunulması ilk kez gerçekleşiyor.
+		Birkaç kişiye bu değişiklikler hakkında ne düşündüklerini sorduk ve çoğu şaşırmadığını söyledi.
+		Yılın sonundan önce yapılacak daha çok iş var, ancak ekip projenin zamanında hazır olacağına inanıyor.
+		Bu hikaye hakkında daha fazla bilgi almak istiyorsanız, web sitemizdeki makalenin tamamını okuyabilir veya podcast dinleyebilirsiniz.
+		Bu, son on yılın en önemli kararlarından biriydi ve insanlar yıllarca bunun hakkında konuşacak.
+		Bu seçeneklerden hangisini seçerdiniz ve neden işleri farklı yapardınız?
+		Yazılımın yeni sürümü, onu daha hızlı ve kullanımı daha kolay hale getirmesi gereken birçok iyileştirme içeriyor.`,
+
+	"id": `Pemerintah mengumumkan pada hari Senin bahwa mereka akan menerbitkan laporan baru tentang keadaan ekonomi.
+		Menurut menteri, ini adalah pertama kalinya semua data tersedia untuk masyarakat.
+		Kami bertanya kepada beberapa orang apa pendapat mereka tentang perubahan ini, dan sebagian besar dari mereka mengatakan tidak terkejut.
+		Masih banyak pekerjaan yang harus dilakukan sebelum akhir tahun, tetapi tim percaya bahwa proyek ini akan selesai tepat waktu.
+		Jika Anda ingin mengetahui lebih lanjut tentang cerita ini, Anda dapat membaca artikel lengkapnya di situs kami atau mendengarkan siniar.
+		Itu adalah salah satu keputusan yang paling penting dalam dekade terakhir, dan orang akan membicarakannya selama bertahun-tahun.
+		Manakah dari pilihan ini yang akan Anda pilih, dan mengapa Anda akan melakukannya dengan cara yang berbeda?
+		Versi baru dari perangkat lunak ini memiliki banyak perbaikan yang seharusnya membuatnya lebih cepat dan lebih mudah digunakan.`,
+
+	"fi": `Hallitus ilmoitti maanantaina julkaisevansa uuden raportin talouden tilasta.
+		Ministerin mukaan tämä on ensimmäinen kerta, kun kaikki tiedot annetaan yleisön saataville.
+		Kysyimme useilta ihmisiltä, mitä he ajattelevat näistä muutoksista, ja useimmat heistä sanoivat, etteivät he olleet yllättyneitä.
+		Ennen vuoden loppua on vielä paljon työtä tehtävänä, mutta tiimi uskoo, että hanke valmistuu ajoissa.
+		Jos haluat tietää lisää tästä tarinasta, voit lukea koko artikkelin verkkosivuiltamme tai kuunnella podcastin.
+		Se oli yksi viime vuosikymmenen tärkeimmistä päätöksistä, ja ihmiset puhuvat siitä vielä vuosia.
+		Minkä näistä vaihtoehdoista valitsisit, ja miksi olisit tehnyt asiat toisin?
+		Ohjelmiston uusi versio sisältää monia parannuksia, joiden pitäisi tehdä siitä nopeampi ja helpompi käyttää.`,
+
+	"ro": `Guvernul a anunțat luni că va publica un nou raport despre starea economiei.
+		Potrivit ministrului, este pentru prima dată când toate datele sunt puse la dispoziția publicului.
+		Am întrebat mai multe persoane ce cred despre aceste schimbări, iar cele mai multe dintre ele au spus că nu au fost surprinse.
+		Mai este încă multă muncă de făcut înainte de sfârșitul anului, dar echipa crede că proiectul va fi gata la timp.
+		Dacă vrei să afli mai multe despre această poveste, poți citi articolul complet pe site-ul nostru sau poți asculta podcastul.
+		A fost una dintre cele mai importante decizii din ultimul deceniu, iar oamenii vor vorbi despre ea ani de zile.
+		Pe care dintre aceste opțiuni ai alege-o și de ce ai fi făcut lucrurile altfel?
+		Noua versiune a programului conține multe îmbunătățiri care ar trebui să îl facă mai rapid și mai ușor de folosit.`,
+
+	"ru": `Правительство объявило в понедельник, что опубликует новый доклад о состоянии экономики.
+		По словам министра, это первый раз, когда все данные становятся доступными для общественности.
+		Мы спросили нескольких человек, что они думают об этих изменениях, и большинство из них сказали, что не были удивлены.
+		До конца года ещё предстоит много работы, но команда считает, что проект будет готов вовремя.
+		Если вы хотите узнать больше об этой истории, вы можете прочитать полную статью на нашем сайте или послушать подкаст.
+		Это было одно из самых важных решений последнего десятилетия, и люди будут говорить о нём ещё много лет.
+		Какой из этих вариантов вы бы выбрали и почему вы бы сделали всё по-другому?
+		Новая версия программы содержит много улучшений, которые должны сделать её быстрее и удобнее в использовании.`,
+
+	"uk": `Уряд оголосив у понеділок, що опублікує нову доповідь про стан економіки.
+		За словами міністра, це перший раз, коли всі дані стають доступними для громадськості.
+		Ми запитали кількох людей, що вони думають про ці зміни, і більшість із них сказали, що не були здивовані.
+		До кінця року ще потрібно виконати багато роботи, але команда вважає, що проєкт буде готовий вчасно.
+		Якщо ви хочете дізнатися більше про цю історію, ви можете прочитати повну статтю на нашому сайті або послухати подкаст.
+		Це було одне з найважливіших рішень останнього десятиліття, і люди говоритимуть про нього ще багато років.
+		Який із цих варіантів ви б обрали і чому ви б зробили все інакше?
+		Нова версія програми містить багато покращень, які мають зробити її швидшою та зручнішою у використанні.`,
+}
@@ -6,6 +6,7 @@ package opml // import "miniflux.app/v2/internal/reader/opml"
 import (
 	"fmt"
 	"io"
+	"slices"
 
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/storage"
@@ -23,14 +24,25 @@ func (h *Handler) Export(userID int64) (string, error) {
 		return "", err
 	}
 
+	categories, err := h.store.Categories(userID)
+	if err != nil {
+		return "", err
+	}
+
 	subscriptions := make([]subcription, 0, len(feeds))
 	for _, feed := range feeds {
+		categoryPath := categories.Path(feed.Category.ID)
+		if len(categoryPath) == 0 {
+			categoryPath = []string{feed.Category.Title}
+		}
+
 		subscriptions = append(subscriptions, subcription{
-			Title:        feed.Title,
-			FeedURL:      feed.FeedURL,
-			SiteURL:      feed.SiteURL,
-			Description:  feed.Description,
-			CategoryName: feed.Category.Title,
+			Title:               feed.Title,
+			FeedURL:             feed.FeedURL,
+			SiteURL:             feed.SiteURL,
+			Description:         feed.Description,
+			CategoryName:        categoryPath[len(categoryPath)-1],
+			ParentCategoryNames: categoryPath[:len(categoryPath)-1],
 		})
 	}
 
@@ -55,15 +67,10 @@ func (h *Handler) Import(userID int64, data io.Reader) error {
 					return fmt.Errorf("opml: unable to find first category: %w", err)
 				}
 			} else {
-				category, err = h.store.CategoryByTitle(userID, subscription.CategoryName)
-				if err != nil {
-					return fmt.Errorf("opml: unable to search category by title: %w", err)
-				}
-
-				if category == nil {
-					category, err = h.store.CreateCategory(userID, &model.CategoryCreationRequest{Title: subscription.CategoryName})
+				for _, categoryName := range append(slices.Clip(subscription.ParentCategoryNames), subscription.CategoryName) {
+					category, err = h.findOrCreateCategory(userID, categoryName, category)
 					if err != nil {
-						return fmt.Errorf(`opml: unable to create this category: %q`, subscription.CategoryName)
+						return err
 					}
 				}
 			}
@@ -75,6 +82,12 @@ func (h *Handler) Import(userID int64, data io.Reader) error {
 				SiteURL:     subscription.SiteURL,
 				Description: subscription.Description,
 				Category:    category,
+
+				// Imported feeds use the flags of their category.
+				InheritCrawler:         true,
+				InheritFetchViaProxy:   true,
+				InheritNtfyEnabled:     true,
+				InheritPushoverEnabled: true,
 			}
 
 			if err := h.store.CreateFeed(feed); err != nil {
@@ -86,6 +99,32 @@ func (h *Handler) Import(userID int64, data io.Reader) error {
 	return nil
 }
 
+// findOrCreateCategory returns the category with the given title under the parent category, it is created if it does not exist.
+func (h *Handler) findOrCreateCategory(userID int64, title string, parent *model.Category) (*model.Category, error) {
+	parentID := int64(0)
+	if parent != nil {
+		parentID = parent.ID
+	}
+
+	category, err := h.store.CategoryByTitle(userID, parentID, title)
+	if err != nil {
+		return nil, fmt.Errorf("opml: unable to search category by title: %w", err)
+	}
+
+	if category != nil {
+		return category, nil
+	}
+
+	request := &model.CategoryCreationRequest{Title: title, ParentID: parentID}
+
+	category, err = h.store.CreateCategory(userID, request)
+	if err != nil {
+		return nil, fmt.Errorf(`opml: unable to create this category: %q`, title)
+	}
+
+	return category, nil
+}
+
 // NewHandler creates a new handler for OPML files.
 func NewHandler(store *storage.Storage) *Handler {
 	return &Handler{store: store}
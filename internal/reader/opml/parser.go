@@ -7,6 +7,7 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
+	"slices"
 
 	"miniflux.app/v2/internal/reader/encoding"
 )
@@ -24,23 +25,32 @@ func parse(data io.Reader) ([]subcription, error) {
 		return nil, fmt.Errorf("opml: unable to parse document: %w", err)
 	}
 
-	return getSubscriptionsFromOutlines(opmlDocument.Outlines, ""), nil
+	return getSubscriptionsFromOutlines(opmlDocument.Outlines, nil), nil
 }
 
-func getSubscriptionsFromOutlines(outlines opmlOutlineCollection, category string) []subcription {
+// getSubscriptionsFromOutlines returns the subscriptions of the outlines, categoryPath contains the titles of the parent outlines.
+func getSubscriptionsFromOutlines(outlines opmlOutlineCollection, categoryPath []string) []subcription {
 	subscriptions := make([]subcription, 0, len(outlines))
 
+	var category string
+	var parentCategories []string
+	if len(categoryPath) > 0 {
+		category = categoryPath[len(categoryPath)-1]
+		parentCategories = categoryPath[:len(categoryPath)-1]
+	}
+
 	for _, outline := range outlines {
 		if outline.IsSubscription() {
 			subscriptions = append(subscriptions, subcription{
-				Title:        outline.GetTitle(),
-				FeedURL:      outline.FeedURL,
-				SiteURL:      outline.GetSiteURL(),
-				Description:  outline.Description,
-				CategoryName: category,
+				Title:               outline.GetTitle(),
+				FeedURL:             outline.FeedURL,
+				SiteURL:             outline.GetSiteURL(),
+				Description:         outline.Description,
+				CategoryName:        category,
+				ParentCategoryNames: parentCategories,
 			})
 		} else if outline.Outlines.HasChildren() {
-			subscriptions = append(subscriptions, getSubscriptionsFromOutlines(outline.Outlines, outline.GetTitle())...)
+			subscriptions = append(subscriptions, getSubscriptionsFromOutlines(outline.Outlines, append(slices.Clip(categoryPath), outline.GetTitle()))...)
 		}
 	}
 	return subscriptions
@@ -5,6 +5,7 @@ package opml // import "miniflux.app/v2/internal/reader/opml"
 
 import (
 	"bytes"
+	"slices"
 	"testing"
 )
 
@@ -12,7 +13,7 @@ import (
 func (s subcription) equals(subscription subcription) bool {
 	return s.Title == subscription.Title && s.SiteURL == subscription.SiteURL &&
 		s.FeedURL == subscription.FeedURL && s.CategoryName == subscription.CategoryName &&
-		s.Description == subscription.Description
+		s.Description == subscription.Description && slices.Equal(s.ParentCategoryNames, subscription.ParentCategoryNames)
 }
 
 func TestParseOpmlWithoutCategories(t *testing.T) {
@@ -222,9 +223,9 @@ func TestParseOpmlVersion1WithSeveralNestedOutlines(t *testing.T) {
 	`
 
 	var expected []subcription
-	expected = append(expected, subcription{Title: "Feed 1", FeedURL: "http://example.org/feed1/", SiteURL: "http://example.org/1", CategoryName: "Some Category"})
-	expected = append(expected, subcription{Title: "Feed 2", FeedURL: "http://example.org/feed2/", SiteURL: "http://example.org/2", CategoryName: "Some Category"})
-	expected = append(expected, subcription{Title: "Feed 3", FeedURL: "http://example.org/feed3/", SiteURL: "http://example.org/3", CategoryName: "Another Category"})
+	expected = append(expected, subcription{Title: "Feed 1", FeedURL: "http://example.org/feed1/", SiteURL: "http://example.org/1", CategoryName: "Some Category", ParentCategoryNames: []string{"My Feeds"}})
+	expected = append(expected, subcription{Title: "Feed 2", FeedURL: "http://example.org/feed2/", SiteURL: "http://example.org/2", CategoryName: "Some Category", ParentCategoryNames: []string{"My Feeds"}})
+	expected = append(expected, subcription{Title: "Feed 3", FeedURL: "http://example.org/feed3/", SiteURL: "http://example.org/3", CategoryName: "Another Category", ParentCategoryNames: []string{"My Feeds"}})
 
 	subscriptions, err := parse(bytes.NewBufferString(data))
 	if err != nil {
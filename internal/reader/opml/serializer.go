@@ -37,16 +37,53 @@ func convertSubscriptionsToOPML(subscriptions []subcription) *opmlDocument {
 	opmlDocument.Header.Title = "Miniflux"
 	opmlDocument.Header.DateCreated = time.Now().Format("Mon, 02 Jan 2006 15:04:05 MST")
 
-	groupedSubs := groupSubscriptionsByFeed(subscriptions)
-	categories := make([]string, 0, len(groupedSubs))
-	for k := range groupedSubs {
+	root := &categoryNode{}
+	for _, subscription := range subscriptions {
+		node := root
+		for _, categoryName := range subscription.ParentCategoryNames {
+			node = node.child(categoryName)
+		}
+		node = node.child(subscription.CategoryName)
+		node.subscriptions = append(node.subscriptions, subscription)
+	}
+
+	opmlDocument.Outlines = root.categoryOutlines()
+
+	return opmlDocument
+}
+
+// categoryNode groups the subscriptions of a category and its subcategories.
+type categoryNode struct {
+	subscriptions []subcription
+	children      map[string]*categoryNode
+}
+
+func (n *categoryNode) child(categoryName string) *categoryNode {
+	if n.children == nil {
+		n.children = make(map[string]*categoryNode)
+	}
+
+	if _, found := n.children[categoryName]; !found {
+		n.children[categoryName] = &categoryNode{}
+	}
+
+	return n.children[categoryName]
+}
+
+// categoryOutlines returns one outline per subcategory sorted by name,
+// each outline contains the nested categories followed by the subscriptions.
+func (n *categoryNode) categoryOutlines() opmlOutlineCollection {
+	categories := make([]string, 0, len(n.children))
+	for k := range n.children {
 		categories = append(categories, k)
 	}
 	sort.Strings(categories)
 
+	outlines := make(opmlOutlineCollection, 0, len(categories))
 	for _, categoryName := range categories {
-		category := opmlOutline{Text: categoryName, Outlines: make(opmlOutlineCollection, 0, len(groupedSubs[categoryName]))}
-		for _, subscription := range groupedSubs[categoryName] {
+		node := n.children[categoryName]
+		category := opmlOutline{Text: categoryName, Outlines: node.categoryOutlines()}
+		for _, subscription := range node.subscriptions {
 			category.Outlines = append(category.Outlines, opmlOutline{
 				Title:       subscription.Title,
 				Text:        subscription.Title,
@@ -56,18 +93,8 @@ func convertSubscriptionsToOPML(subscriptions []subcription) *opmlDocument {
 			})
 		}
 
-		opmlDocument.Outlines = append(opmlDocument.Outlines, category)
-	}
-
-	return opmlDocument
-}
-
-func groupSubscriptionsByFeed(subscriptions []subcription) map[string][]subcription {
-	groups := make(map[string][]subcription)
-
-	for _, subscription := range subscriptions {
-		groups[subscription.CategoryName] = append(groups[subscription.CategoryName], subscription)
+		outlines = append(outlines, category)
 	}
 
-	return groups
+	return outlines
 }
@@ -5,6 +5,7 @@ package opml // import "miniflux.app/v2/internal/reader/opml"
 
 import (
 	"bytes"
+	"slices"
 	"testing"
 )
 
@@ -61,3 +62,35 @@ func TestNormalizedCategoriesOrder(t *testing.T) {
 		}
 	}
 }
+
+func TestSerializeNestedCategories(t *testing.T) {
+	var subscriptions []subcription
+	subscriptions = append(subscriptions, subcription{Title: "Feed 1", FeedURL: "http://example.org/feed/1", SiteURL: "http://example.org/1", CategoryName: "News"})
+	subscriptions = append(subscriptions, subcription{Title: "Feed 2", FeedURL: "http://example.org/feed/2", SiteURL: "http://example.org/2", CategoryName: "World", ParentCategoryNames: []string{"News"}})
+	subscriptions = append(subscriptions, subcription{Title: "Feed 3", FeedURL: "http://example.org/feed/3", SiteURL: "http://example.org/3", CategoryName: "Europe", ParentCategoryNames: []string{"News", "World"}})
+
+	document := convertSubscriptionsToOPML(subscriptions)
+	if len(document.Outlines) != 1 || document.Outlines[0].Text != "News" {
+		t.Fatalf("Expected a single top-level outline, got %+v", document.Outlines)
+	}
+
+	feeds, err := parse(bytes.NewBufferString(serialize(subscriptions)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feeds) != 3 {
+		t.Fatalf("Wrong number of subscriptions: %d instead of %d", len(feeds), 3)
+	}
+
+	for _, expected := range subscriptions {
+		index := slices.IndexFunc(feeds, func(feed subcription) bool { return feed.FeedURL == expected.FeedURL })
+		if index == -1 {
+			t.Fatalf("Subscription %q not found", expected.FeedURL)
+		}
+
+		if feeds[index].CategoryName != expected.CategoryName || !slices.Equal(feeds[index].ParentCategoryNames, expected.ParentCategoryNames) {
+			t.Errorf(`Unexpected category for %q: %q %v`, expected.FeedURL, feeds[index].CategoryName, feeds[index].ParentCategoryNames)
+		}
+	}
+}
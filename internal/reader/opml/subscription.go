@@ -10,4 +10,7 @@ type subcription struct {
 	FeedURL      string
 	CategoryName string
 	Description  string
+
+	// ParentCategoryNames contains the ancestors of the category, starting with the top-level category.
+	ParentCategoryNames []string
 }
@@ -0,0 +1,15 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor // import "miniflux.app/v2/internal/reader/processor"
+
+import (
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/language"
+	"miniflux.app/v2/internal/reader/sanitizer"
+)
+
+// updateEntryLanguage detects the language of the entry, the language declared by the feed is used as a hint.
+func updateEntryLanguage(entry *model.Entry) {
+	entry.Language = language.Detect(entry.Title+"\n"+sanitizer.StripTags(entry.Content), entry.Language)
+}
@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor // import "miniflux.app/v2/internal/reader/processor"
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/integration/llm"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/sanitizer"
+	"miniflux.app/v2/internal/storage"
+)
+
+// updateEntriesWithLanguageModel summarizes and tags the entries with the language model configured by the user.
+//
+// The output of the model is cached on the entry. The model is called only for the analyzable entries (new entries
+// or forced refresh) when the cached output does not match the current content. When the endpoint is unavailable,
+// the previous output is kept if any, otherwise the entry keeps its extractive summary. The same applies to the
+// entries not processed before the time limit of the feed.
+func updateEntriesWithLanguageModel(store *storage.Storage, userID int64, feed *model.Feed, entries model.Entries, analyzableEntries map[string]bool) {
+	userIntegrations, err := store.Integration(userID)
+	if err != nil {
+		slog.Error("Unable to fetch user integrations",
+			slog.Int64("user_id", userID),
+			slog.Any("error", err),
+		)
+		return
+	}
+
+	if !userIntegrations.LLMEnabled {
+		return
+	}
+
+	client := llm.NewClient(
+		userIntegrations.LLMURL,
+		userIntegrations.LLMAPIKey,
+		userIntegrations.LLMModel,
+		userIntegrations.LLMSummarize,
+		userIntegrations.LLMTagging,
+	)
+
+	entryHashes := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		entryHashes = append(entryHashes, entry.Hash)
+	}
+
+	cachedResults, err := store.EntryLLMResults(feed.ID, entryHashes)
+	if err != nil {
+		slog.Error("Unable to fetch the cached language model results",
+			slog.Int64("user_id", userID),
+			slog.Int64("feed_id", feed.ID),
+			slog.Any("error", err),
+		)
+		return
+	}
+
+	type languageModelJob struct {
+		entry         *model.Entry
+		content       string
+		cacheKey      string
+		cachedKey     string
+		cachedSummary string
+		cachedTags    []string
+	}
+
+	var jobs []languageModelJob
+	for _, entry := range entries {
+		content := sanitizer.StripTags(entry.Content)
+		cacheKey := client.CacheKey(entry.Title, content)
+
+		var cachedKey, cachedSummary string
+		var cachedTags []string
+		if cached, found := cachedResults[entry.Hash]; found {
+			cachedKey, cachedSummary, cachedTags = cached.CacheKey, cached.Summary, cached.Tags
+		}
+
+		if cachedKey != "" && (cachedKey == cacheKey || !analyzableEntries[entry.Hash]) {
+			applyLanguageModelResult(entry, cachedKey, cachedSummary, cachedTags)
+			continue
+		}
+
+		if !analyzableEntries[entry.Hash] {
+			continue
+		}
+
+		jobs = append(jobs, languageModelJob{entry, content, cacheKey, cachedKey, cachedSummary, cachedTags})
+	}
+
+	if len(jobs) == 0 {
+		return
+	}
+
+	// The refresh worker waits for the model, the time spent on a feed is limited and the number of
+	// goroutines is bounded by the number of requests that can be sent at the same time.
+	ctx, cancel := context.WithTimeout(context.Background(), config.Opts.LLMFeedProcessingTimeout())
+	defer cancel()
+
+	queue := make(chan languageModelJob)
+	var wg sync.WaitGroup
+	for range min(len(jobs), max(config.Opts.LLMMaxConcurrentRequests(), 1)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for job := range queue {
+				result, err := client.Analyze(ctx, job.entry.Title, job.content)
+				if err != nil {
+					if errors.Is(err, llm.ErrEndpointUnavailable) || ctx.Err() != nil {
+						slog.Debug("Skipping language model processing",
+							slog.Int64("user_id", userID),
+							slog.Int64("feed_id", feed.ID),
+							slog.String("entry_url", job.entry.URL),
+							slog.Any("error", err),
+						)
+					} else {
+						slog.Warn("Unable to process entry with the language model",
+							slog.Int64("user_id", userID),
+							slog.Int64("feed_id", feed.ID),
+							slog.String("entry_url", job.entry.URL),
+							slog.Any("error", err),
+						)
+					}
+
+					if job.cachedKey != "" {
+						applyLanguageModelResult(job.entry, job.cachedKey, job.cachedSummary, job.cachedTags)
+					}
+					continue
+				}
+
+				applyLanguageModelResult(job.entry, job.cacheKey, result.Summary, result.Tags)
+			}
+		}()
+	}
+
+	for _, job := range jobs {
+		queue <- job
+	}
+	close(queue)
+	wg.Wait()
+}
+
+// applyLanguageModelResult merges the output of the model into the entry.
+// The output is untrusted, the summary is stored as plain text like the extractive summaries.
+func applyLanguageModelResult(entry *model.Entry, cacheKey, summary string, tags []string) {
+	summary = strings.Join(strings.Fields(sanitizer.StripTags(summary)), " ")
+
+	entry.LLMCacheKey = cacheKey
+	entry.LLMSummary = summary
+	entry.LLMTags = tags
+
+	if summary != "" {
+		entry.Summary = summary
+	}
+
+	if len(tags) > 0 {
+		entry.Tags = append(entry.Tags, tags...)
+		slices.Sort(entry.Tags)
+		entry.Tags = slices.Compact(entry.Tags)
+	}
+}
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor // import "miniflux.app/v2/internal/reader/processor"
+
+import (
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestApplyLanguageModelResultStoresPlainTextSummary(t *testing.T) {
+	entry := &model.Entry{Summary: "Extractive summary."}
+	applyLanguageModelResult(entry, "key", "<p>A <b>short</b>\n summary &amp; more</p><img src=x onerror=alert(1)>", nil)
+
+	expected := "A short summary & more"
+	if entry.Summary != expected {
+		t.Errorf(`Unexpected summary, got %q instead of %q`, entry.Summary, expected)
+	}
+
+	if entry.LLMSummary != expected {
+		t.Errorf(`Unexpected cached summary, got %q instead of %q`, entry.LLMSummary, expected)
+	}
+}
+
+func TestApplyLanguageModelResultKeepsSummaryWithoutText(t *testing.T) {
+	entry := &model.Entry{Summary: "Extractive summary."}
+	applyLanguageModelResult(entry, "key", "<img src=x onerror=alert(1)>", nil)
+
+	if entry.Summary != "Extractive summary." {
+		t.Errorf(`The extractive summary should be kept, got %q`, entry.Summary)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"miniflux.app/v2/internal/reader/rewrite"
 	"miniflux.app/v2/internal/reader/sanitizer"
 	"miniflux.app/v2/internal/reader/scraper"
+	"miniflux.app/v2/internal/reader/summarizer"
 	"miniflux.app/v2/internal/reader/urlcleaner"
 	"miniflux.app/v2/internal/storage"
 )
@@ -33,17 +34,20 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 		return
 	}
 
+	// Settings left empty on the feed are inherited from its category.
+	effectiveFeed := feed.InheritCategorySettings()
+
 	// The errors are handled in RemoveTrackingParameters.
 	parsedFeedURL, _ := url.Parse(feed.FeedURL)
 	parsedSiteURL, _ := url.Parse(feed.SiteURL)
 
-	blockRules := filter.ParseRules(user.BlockFilterEntryRules, feed.BlockFilterEntryRules)
-	allowRules := filter.ParseRules(user.KeepFilterEntryRules, feed.KeepFilterEntryRules)
+	blockRules := filter.ParseRules(user.BlockFilterEntryRules, effectiveFeed.BlockFilterEntryRules)
+	allowRules := filter.ParseRules(user.KeepFilterEntryRules, effectiveFeed.KeepFilterEntryRules)
 	slog.Debug("Filter rules",
 		slog.String("user_block_filter_rules", user.BlockFilterEntryRules),
-		slog.String("feed_block_filter_rules", feed.BlockFilterEntryRules),
+		slog.String("feed_block_filter_rules", effectiveFeed.BlockFilterEntryRules),
 		slog.String("user_keep_filter_rules", user.KeepFilterEntryRules),
-		slog.String("feed_keep_filter_rules", feed.KeepFilterEntryRules),
+		slog.String("feed_keep_filter_rules", effectiveFeed.KeepFilterEntryRules),
 		slog.Any("block_rules", blockRules),
 		slog.Any("allow_rules", allowRules),
 		slog.Int64("user_id", user.ID),
@@ -51,15 +55,21 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 	)
 
 	requestBuilder := fetcher.NewRequestBuilder()
-	requestBuilder.WithUserAgent(feed.UserAgent, config.Opts.HTTPClientUserAgent())
-	requestBuilder.WithCookie(feed.Cookie)
+	requestBuilder.WithUserAgent(effectiveFeed.UserAgent, config.Opts.HTTPClientUserAgent())
+	requestBuilder.WithCookie(effectiveFeed.Cookie)
 	requestBuilder.WithTimeout(config.Opts.HTTPClientTimeout())
-	requestBuilder.WithProxyRotator(proxyrotator.ProxyRotatorInstance)
-	requestBuilder.WithCustomFeedProxyURL(feed.ProxyURL)
+	requestBuilder.WithProxyRotator(proxyrotator.Pool(feed.ProxyPool))
+	requestBuilder.WithCustomFeedProxyURL(effectiveFeed.ProxyURL)
 	requestBuilder.WithCustomApplicationProxyURL(config.Opts.HTTPClientProxyURL())
-	requestBuilder.UseCustomApplicationProxyURL(feed.FetchViaProxy)
+	requestBuilder.UseCustomApplicationProxyURL(effectiveFeed.FetchViaProxy)
 	requestBuilder.IgnoreTLSErrors(feed.AllowSelfSignedCertificates)
 	requestBuilder.DisableHTTP2(feed.DisableHTTP2)
+	requestBuilder.WithCustomHeaders(feed.RequestHeaders())
+	requestBuilder.WithClientCertificate(feed.ClientCertificate, feed.ClientKey)
+	requestBuilder.WithCACertificates(feed.CACertificates)
+
+	// Entries sent to the language model (new entries or forced refresh).
+	analyzableEntries := make(map[string]bool)
 
 	// Processing older entries first ensures that their creation timestamp is lower than newer entries.
 	for _, entry := range slices.Backward(feed.Entries) {
@@ -72,7 +82,10 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 			slog.String("feed_url", feed.FeedURL),
 		)
 
-		if filter.IsBlockedEntry(blockRules, allowRules, feed, entry) {
+		// The language is detected first to allow filtering entries by language.
+		updateEntryLanguage(entry)
+
+		if filter.IsBlockedEntry(blockRules, allowRules, effectiveFeed, entry) {
 			slog.Debug("Entry is blocked by filter rules",
 				slog.Int64("user_id", user.ID),
 				slog.String("entry_url", entry.URL),
@@ -90,9 +103,10 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 		}
 
 		webpageBaseURL := ""
-		entry.URL = rewrite.RewriteEntryURL(feed, entry)
+		openGraphImageURL := ""
+		entry.URL = rewrite.RewriteEntryURL(effectiveFeed, entry)
 		entryIsNew := store.IsNewEntry(feed.ID, entry.Hash)
-		if feed.Crawler && (entryIsNew || forceRefresh) {
+		if effectiveFeed.Crawler && (entryIsNew || forceRefresh) {
 			slog.Debug("Scraping entry",
 				slog.Int64("user_id", user.ID),
 				slog.String("entry_url", entry.URL),
@@ -106,15 +120,16 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 
 			startTime := time.Now()
 
-			scrapedPageBaseURL, extractedContent, scraperErr := scraper.ScrapeWebsite(
+			scrapedPageBaseURL, extractedContent, scrapedImageURL, scraperErr := scraper.ScrapeWebsite(
 				requestBuilder,
 				entry.URL,
-				feed.ScraperRules,
+				effectiveFeed.ScraperRules,
 			)
 
 			if scrapedPageBaseURL != "" {
 				webpageBaseURL = scrapedPageBaseURL
 			}
+			openGraphImageURL = scrapedImageURL
 
 			if config.Opts.HasMetricsCollector() {
 				status := "success"
@@ -138,7 +153,7 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 			}
 		}
 
-		rewrite.ApplyContentRewriteRules(entry, feed.RewriteRules)
+		rewrite.ApplyContentRewriteRules(entry, effectiveFeed.RewriteRules)
 
 		if webpageBaseURL == "" {
 			webpageBaseURL = entry.URL
@@ -147,11 +162,21 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 		// The sanitizer should always run at the end of the process to make sure unsafe HTML is filtered out.
 		entry.Content = sanitizer.SanitizeHTML(webpageBaseURL, entry.Content, &sanitizer.SanitizerOptions{OpenLinksInNewTab: user.OpenExternalLinksInNewTab})
 
+		entry.Summary = summarizer.Summarize(entry.Content)
 		updateEntryReadingTime(store, feed, entry, entryIsNew, user)
+		updateEntryThumbnail(entry, openGraphImageURL)
+
+		if entryIsNew || forceRefresh {
+			analyzableEntries[entry.Hash] = true
+		}
 
 		filteredEntries = append(filteredEntries, entry)
 	}
 
+	if feed.LLMProcessing {
+		updateEntriesWithLanguageModel(store, user.ID, feed, filteredEntries, analyzableEntries)
+	}
+
 	if user.ShowReadingTime && shouldFetchYouTubeWatchTimeInBulk() {
 		fetchYouTubeWatchTimeInBulk(filteredEntries)
 	}
@@ -162,20 +187,24 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 // ProcessEntryWebPage downloads the entry web page and apply rewrite rules.
 func ProcessEntryWebPage(feed *model.Feed, entry *model.Entry, user *model.User) error {
 	startTime := time.Now()
+	feed = feed.InheritCategorySettings()
 	entry.URL = rewrite.RewriteEntryURL(feed, entry)
 
 	requestBuilder := fetcher.NewRequestBuilder()
 	requestBuilder.WithUserAgent(feed.UserAgent, config.Opts.HTTPClientUserAgent())
 	requestBuilder.WithCookie(feed.Cookie)
 	requestBuilder.WithTimeout(config.Opts.HTTPClientTimeout())
-	requestBuilder.WithProxyRotator(proxyrotator.ProxyRotatorInstance)
+	requestBuilder.WithProxyRotator(proxyrotator.Pool(feed.ProxyPool))
 	requestBuilder.WithCustomFeedProxyURL(feed.ProxyURL)
 	requestBuilder.WithCustomApplicationProxyURL(config.Opts.HTTPClientProxyURL())
 	requestBuilder.UseCustomApplicationProxyURL(feed.FetchViaProxy)
 	requestBuilder.IgnoreTLSErrors(feed.AllowSelfSignedCertificates)
 	requestBuilder.DisableHTTP2(feed.DisableHTTP2)
+	requestBuilder.WithCustomHeaders(feed.RequestHeaders())
+	requestBuilder.WithClientCertificate(feed.ClientCertificate, feed.ClientKey)
+	requestBuilder.WithCACertificates(feed.CACertificates)
 
-	webpageBaseURL, extractedContent, scraperErr := scraper.ScrapeWebsite(
+	webpageBaseURL, extractedContent, openGraphImageURL, scraperErr := scraper.ScrapeWebsite(
 		requestBuilder,
 		entry.URL,
 		feed.ScraperRules,
@@ -200,8 +229,10 @@ func ProcessEntryWebPage(feed *model.Feed, entry *model.Entry, user *model.User)
 		}
 	}
 
-	rewrite.ApplyContentRewriteRules(entry, entry.Feed.RewriteRules)
+	rewrite.ApplyContentRewriteRules(entry, feed.RewriteRules)
 	entry.Content = sanitizer.SanitizeHTML(webpageBaseURL, entry.Content, &sanitizer.SanitizerOptions{OpenLinksInNewTab: user.OpenExternalLinksInNewTab})
+	entry.Summary = summarizer.Summarize(entry.Content)
+	updateEntryThumbnail(entry, openGraphImageURL)
 
 	return nil
 }
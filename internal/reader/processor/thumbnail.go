@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor // import "miniflux.app/v2/internal/reader/processor"
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/urllib"
+)
+
+// updateEntryThumbnail picks the lead image of the entry.
+//
+// The thumbnail found by the feed parser (media:thumbnail, itunes:image, JSON Feed image) has priority,
+// then the first image enclosure, the Open Graph image of the web page and finally the first image of the content.
+func updateEntryThumbnail(entry *model.Entry, openGraphImageURL string) {
+	if entry.ThumbnailURL != "" {
+		return
+	}
+
+	for _, enclosure := range entry.Enclosures {
+		if enclosure.IsImage() && urllib.IsAbsoluteURL(enclosure.URL) {
+			entry.ThumbnailURL = enclosure.URL
+			return
+		}
+	}
+
+	if openGraphImageURL != "" {
+		entry.ThumbnailURL = openGraphImageURL
+		return
+	}
+
+	entry.ThumbnailURL = findFirstContentImage(entry.Content)
+}
+
+func findFirstContentImage(content string) string {
+	if content == "" {
+		return ""
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return ""
+	}
+
+	imageURL := ""
+	doc.Find("img[src]").EachWithBreak(func(_ int, img *goquery.Selection) bool {
+		src := strings.TrimSpace(img.AttrOr("src", ""))
+		if strings.HasPrefix(src, "https://") || strings.HasPrefix(src, "http://") {
+			imageURL = src
+			return false
+		}
+		return true
+	})
+
+	return imageURL
+}
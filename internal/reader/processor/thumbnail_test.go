@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor // import "miniflux.app/v2/internal/reader/processor"
+
+import (
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestUpdateEntryThumbnail(t *testing.T) {
+	var scenarios = []struct {
+		name              string
+		entry             *model.Entry
+		openGraphImageURL string
+		expected          string
+	}{
+		{
+			name:     "thumbnail from the feed parser is kept",
+			entry:    &model.Entry{ThumbnailURL: "https://example.org/feed.jpg", Content: `<img src="https://example.org/content.jpg">`},
+			expected: "https://example.org/feed.jpg",
+		},
+		{
+			name: "first image enclosure",
+			entry: &model.Entry{Enclosures: model.EnclosureList{
+				{URL: "https://example.org/episode.mp3", MimeType: "audio/mpeg"},
+				{URL: "https://example.org/cover.png", MimeType: "image/png"},
+			}},
+			openGraphImageURL: "https://example.org/og.jpg",
+			expected:          "https://example.org/cover.png",
+		},
+		{
+			name:              "open graph image",
+			entry:             &model.Entry{Content: `<img src="https://example.org/content.jpg">`},
+			openGraphImageURL: "https://example.org/og.jpg",
+			expected:          "https://example.org/og.jpg",
+		},
+		{
+			name:     "first content image",
+			entry:    &model.Entry{Content: `<p>Text</p><img src="data:image/gif;base64,R0lGODlhAQABAAAAACw="><img src="https://example.org/content.jpg">`},
+			expected: "https://example.org/content.jpg",
+		},
+		{
+			name:     "no image",
+			entry:    &model.Entry{Content: `<p>Text</p>`},
+			expected: "",
+		},
+	}
+
+	for _, tc := range scenarios {
+		updateEntryThumbnail(tc.entry, tc.openGraphImageURL)
+		if tc.entry.ThumbnailURL != tc.expected {
+			t.Errorf(`%s: got %q instead of %q`, tc.name, tc.entry.ThumbnailURL, tc.expected)
+		}
+	}
+}
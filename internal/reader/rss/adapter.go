@@ -15,6 +15,7 @@ import (
 	"miniflux.app/v2/internal/crypto"
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/reader/date"
+	"miniflux.app/v2/internal/reader/language"
 	"miniflux.app/v2/internal/reader/sanitizer"
 	"miniflux.app/v2/internal/urllib"
 )
@@ -71,6 +72,8 @@ func (r *rssAdapter) buildFeed(baseURL string) *model.Feed {
 		entry.Date = findEntryDate(&item)
 		entry.Content = findEntryContent(&item)
 		entry.Enclosures = findEntryEnclosures(&item, feed.SiteURL)
+		entry.ThumbnailURL = findEntryThumbnail(&item, feed.SiteURL)
+		entry.Language = language.Normalize(r.rss.Channel.Language)
 
 		// Populate the entry URL.
 		entryURL := findEntryURL(&item)
@@ -299,6 +302,26 @@ func findEntryTags(rssItem *rssItem) []string {
 	return tags
 }
 
+func findEntryThumbnail(rssItem *rssItem, siteURL string) string {
+	candidates := make([]string, 0)
+	for _, mediaThumbnail := range rssItem.AllMediaThumbnails() {
+		candidates = append(candidates, mediaThumbnail.URL)
+	}
+	candidates = append(candidates, rssItem.ItunesImage.Href)
+
+	for _, candidate := range candidates {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "" {
+			continue
+		}
+		if absoluteURL, err := urllib.AbsoluteURL(siteURL, candidate); err == nil {
+			return absoluteURL
+		}
+	}
+
+	return ""
+}
+
 func findEntryEnclosures(rssItem *rssItem, siteURL string) model.EnclosureList {
 	enclosures := make(model.EnclosureList, 0)
 	duplicates := make(map[string]bool)
@@ -1798,6 +1798,59 @@ func TestParseItunesDuration(t *testing.T) {
 	}
 }
 
+func TestParseEntryThumbnailFromItunesImage(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+		<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">
+		<channel>
+			<title>Podcast Example</title>
+			<link>http://www.example.com/index.html</link>
+			<item>
+				<title>Podcast Episode</title>
+				<guid>http://example.com/episode.m4a</guid>
+				<itunes:image href="/images/episode.jpg"/>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse("https://example.org/", bytes.NewReader([]byte(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "http://www.example.com/images/episode.jpg"
+	result := feed.Entries[0].ThumbnailURL
+	if expected != result {
+		t.Errorf(`Unexpected entry thumbnail, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestParseEntryThumbnailPrefersMediaThumbnail(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+		<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd" xmlns:media="http://search.yahoo.com/mrss/">
+		<channel>
+			<title>Podcast Example</title>
+			<link>http://www.example.com/index.html</link>
+			<item>
+				<title>Podcast Episode</title>
+				<guid>http://example.com/episode.m4a</guid>
+				<itunes:image href="https://example.com/itunes.jpg"/>
+				<media:thumbnail url="https://example.com/thumbnail.jpg"/>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse("https://example.org/", bytes.NewReader([]byte(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "https://example.com/thumbnail.jpg"
+	result := feed.Entries[0].ThumbnailURL
+	if expected != result {
+		t.Errorf(`Unexpected entry thumbnail, got %q instead of %q`, result, expected)
+	}
+}
+
 func TestParseIncorrectItunesDuration(t *testing.T) {
 	data := `<?xml version="1.0" encoding="UTF-8"?>
 		<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">
@@ -4,6 +4,7 @@
 package scraper // import "miniflux.app/v2/internal/reader/scraper"
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"log/slog"
@@ -18,17 +19,19 @@ import (
 	"github.com/PuerkitoBio/goquery"
 )
 
-func ScrapeWebsite(requestBuilder *fetcher.RequestBuilder, pageURL, rules string) (baseURL string, extractedContent string, err error) {
+// ScrapeWebsite downloads the given page and extracts its main content.
+// The Open Graph image of the page is also returned when available.
+func ScrapeWebsite(requestBuilder *fetcher.RequestBuilder, pageURL, rules string) (baseURL, extractedContent, imageURL string, err error) {
 	responseHandler := fetcher.NewResponseHandler(requestBuilder.ExecuteRequest(pageURL))
 	defer responseHandler.Close()
 
 	if localizedError := responseHandler.LocalizedError(); localizedError != nil {
 		slog.Warn("Unable to scrape website", slog.String("website_url", pageURL), slog.Any("error", localizedError.Error()))
-		return "", "", localizedError.Error()
+		return "", "", "", localizedError.Error()
 	}
 
 	if !isAllowedContentType(responseHandler.ContentType()) {
-		return "", "", fmt.Errorf("scraper: this resource is not a HTML document (%s)", responseHandler.ContentType())
+		return "", "", "", fmt.Errorf("scraper: this resource is not a HTML document (%s)", responseHandler.ContentType())
 	}
 
 	// The entry URL could redirect somewhere else.
@@ -45,20 +48,27 @@ func ScrapeWebsite(requestBuilder *fetcher.RequestBuilder, pageURL, rules string
 	)
 
 	if err != nil {
-		return "", "", fmt.Errorf("scraper: unable to read HTML document with charset reader: %v", err)
+		return "", "", "", fmt.Errorf("scraper: unable to read HTML document with charset reader: %v", err)
 	}
 
+	htmlDocument, err := io.ReadAll(htmlDocumentReader)
+	if err != nil {
+		return "", "", "", fmt.Errorf("scraper: unable to read HTML document: %v", err)
+	}
+
+	imageURL = findOpenGraphImage(pageURL, bytes.NewReader(htmlDocument))
+
 	if sameSite && rules != "" {
 		slog.Debug("Extracting content with custom rules",
 			"url", pageURL,
 			"rules", rules,
 		)
-		baseURL, extractedContent, err = findContentUsingCustomRules(htmlDocumentReader, rules)
+		baseURL, extractedContent, err = findContentUsingCustomRules(bytes.NewReader(htmlDocument), rules)
 	} else {
 		slog.Debug("Extracting content with readability",
 			"url", pageURL,
 		)
-		baseURL, extractedContent, err = readability.ExtractContent(htmlDocumentReader)
+		baseURL, extractedContent, err = readability.ExtractContent(bytes.NewReader(htmlDocument))
 	}
 
 	if baseURL == "" {
@@ -67,7 +77,35 @@ func ScrapeWebsite(requestBuilder *fetcher.RequestBuilder, pageURL, rules string
 		slog.Debug("Using base URL from HTML document", "base_url", baseURL)
 	}
 
-	return baseURL, extractedContent, nil
+	return baseURL, extractedContent, imageURL, nil
+}
+
+// findOpenGraphImage returns the absolute URL of the "og:image" (or "twitter:image") meta tag.
+func findOpenGraphImage(pageURL string, page io.Reader) string {
+	document, err := goquery.NewDocumentFromReader(page)
+	if err != nil {
+		return ""
+	}
+
+	for _, query := range []string{
+		`meta[property="og:image:secure_url"]`,
+		`meta[property="og:image"]`,
+		`meta[property="og:image:url"]`,
+		`meta[name="twitter:image"]`,
+		`meta[name="twitter:image:src"]`,
+	} {
+		if content, exists := document.FindMatcher(goquery.Single(query)).Attr("content"); exists {
+			content = strings.TrimSpace(content)
+			if content == "" {
+				continue
+			}
+			if absoluteURL, err := urllib.AbsoluteURL(pageURL, content); err == nil {
+				return absoluteURL
+			}
+		}
+	}
+
+	return ""
 }
 
 func findContentUsingCustomRules(page io.Reader, rules string) (baseURL string, extractedContent string, err error) {
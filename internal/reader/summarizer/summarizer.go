@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package summarizer builds extractive summaries of long articles.
+//
+// Sentences are scored by the frequency of their words in the whole article, with a bonus for the first
+// sentences of the text. The best sentences are returned in their original order.
+package summarizer // import "miniflux.app/v2/internal/reader/summarizer"
+
+import (
+	"io"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+const (
+	// minWords is the number of words required to summarize an article, shorter articles are left as is.
+	minWords = 200
+
+	// maxSentences is the maximum number of sentences in a summary.
+	maxSentences = 3
+
+	// maxSummaryLength is the maximum number of characters in a summary.
+	maxSummaryLength = 500
+
+	// minSentenceLength and maxSentenceLength exclude captions, headings and run-on sentences.
+	minSentenceLength = 40
+	maxSentenceLength = 300
+
+	// minWordLength excludes most articles, pronouns and prepositions from the word frequencies.
+	minWordLength = 4
+)
+
+var blockElements = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true, "br": true, "dd": true, "div": true,
+	"dl": true, "dt": true, "footer": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"header": true, "hr": true, "li": true, "ol": true, "p": true, "section": true, "td": true, "th": true,
+	"tr": true, "ul": true,
+}
+
+var ignoredElements = map[string]bool{
+	"audio": true, "code": true, "figcaption": true, "figure": true, "iframe": true, "noscript": true,
+	"pre": true, "script": true, "style": true, "table": true, "video": true,
+}
+
+type sentence struct {
+	text     string
+	position int
+	score    float64
+}
+
+// Summarize returns a short extractive summary of the given sanitized HTML content.
+// It returns an empty string when the content is too short to need a summary.
+func Summarize(content string) string {
+	paragraphs := extractParagraphs(content)
+
+	var sentences []*sentence
+	frequencies := make(map[string]int)
+	totalWords := 0
+
+	for _, paragraph := range paragraphs {
+		for _, text := range splitSentences(paragraph) {
+			words := extractWords(text)
+			totalWords += len(words)
+			for _, word := range words {
+				frequencies[word]++
+			}
+			sentences = append(sentences, &sentence{text: text, position: len(sentences)})
+		}
+	}
+
+	if totalWords < minWords {
+		return ""
+	}
+
+	candidates := make([]*sentence, 0, len(sentences))
+	seen := make(map[string]bool, len(sentences))
+	for _, s := range sentences {
+		length := utf8.RuneCountInString(s.text)
+		if length < minSentenceLength || length > maxSentenceLength || seen[s.text] {
+			continue
+		}
+		seen[s.text] = true
+
+		words := extractWords(s.text)
+		if len(words) == 0 {
+			continue
+		}
+
+		for _, word := range words {
+			s.score += float64(frequencies[word])
+		}
+
+		// Favor sentences dense in frequent words and the lead of the article.
+		s.score /= float64(len(words))
+		s.score *= 1 + 1/float64(s.position+2)
+		candidates = append(candidates, s)
+	}
+
+	slices.SortStableFunc(candidates, func(a, b *sentence) int {
+		switch {
+		case a.score > b.score:
+			return -1
+		case a.score < b.score:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	candidates = candidates[:min(len(candidates), maxSentences)]
+	slices.SortFunc(candidates, func(a, b *sentence) int {
+		return a.position - b.position
+	})
+
+	var summary strings.Builder
+	for _, s := range candidates {
+		if summary.Len() > 0 && utf8.RuneCountInString(summary.String())+1+utf8.RuneCountInString(s.text) > maxSummaryLength {
+			break
+		}
+		if summary.Len() > 0 {
+			summary.WriteString(" ")
+		}
+		summary.WriteString(s.text)
+	}
+
+	return summary.String()
+}
+
+// extractParagraphs returns the text of the block elements of the document.
+func extractParagraphs(content string) []string {
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+	var paragraphs []string
+	var buffer strings.Builder
+	ignoredDepth := 0
+
+	flush := func() {
+		if text := strings.Join(strings.Fields(buffer.String()), " "); text != "" {
+			paragraphs = append(paragraphs, text)
+		}
+		buffer.Reset()
+	}
+
+	for {
+		if tokenizer.Next() == html.ErrorToken {
+			if tokenizer.Err() == io.EOF {
+				flush()
+				return paragraphs
+			}
+			return nil
+		}
+
+		token := tokenizer.Token()
+		switch token.Type {
+		case html.StartTagToken:
+			if ignoredElements[token.Data] {
+				ignoredDepth++
+			}
+			if blockElements[token.Data] {
+				flush()
+			}
+		case html.EndTagToken:
+			if ignoredElements[token.Data] && ignoredDepth > 0 {
+				ignoredDepth--
+			}
+			if blockElements[token.Data] {
+				flush()
+			}
+		case html.SelfClosingTagToken:
+			if blockElements[token.Data] {
+				flush()
+			}
+		case html.TextToken:
+			if ignoredDepth == 0 {
+				buffer.WriteString(token.Data)
+			}
+		}
+	}
+}
+
+// splitSentences splits a paragraph after each terminal punctuation followed by a space.
+func splitSentences(paragraph string) []string {
+	var sentences []string
+	runes := []rune(paragraph)
+	start := 0
+
+	for i, r := range runes {
+		isLast := i == len(runes)-1
+		switch r {
+		case '。', '！', '？':
+		case '.', '!', '?', '…':
+			if !isLast && !unicode.IsSpace(runes[i+1]) {
+				continue
+			}
+		default:
+			if !isLast {
+				continue
+			}
+		}
+
+		if text := strings.TrimSpace(string(runes[start : i+1])); text != "" {
+			sentences = append(sentences, text)
+		}
+		start = i + 1
+	}
+
+	return sentences
+}
+
+func extractWords(text string) []string {
+	var words []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}) {
+		if utf8.RuneCountInString(word) >= minWordLength {
+			words = append(words, word)
+		}
+	}
+	return words
+}
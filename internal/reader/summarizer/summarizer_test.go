@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package summarizer // import "miniflux.app/v2/internal/reader/summarizer"
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSummarizeShortContent(t *testing.T) {
+	if summary := Summarize("<p>A short article does not need any summary at all.</p>"); summary != "" {
+		t.Errorf(`Unexpected summary for a short content: %q`, summary)
+	}
+}
+
+func TestSummarizeLongContent(t *testing.T) {
+	var content strings.Builder
+	content.WriteString("<h1>Solar energy</h1>")
+	content.WriteString("<pre>solar panels electricity solar panels electricity solar panels electricity.</pre>")
+	for i := range 15 {
+		fmt.Fprintf(&content, "<p>Solar panels installed on roof %d produce clean electricity from sunlight. ", i)
+		fmt.Fprintf(&content, "Filler sentence %d mentions words such as apple%d, river%d and mountain%d.</p>", i, i, i, i)
+	}
+
+	summary := Summarize(content.String())
+	if summary == "" {
+		t.Fatal(`The summary should not be empty`)
+	}
+
+	if !strings.HasPrefix(summary, "Solar panels installed on roof 0") {
+		t.Errorf(`The summary should start with the lead sentence, got %q`, summary)
+	}
+
+	if strings.Contains(summary, "Filler") {
+		t.Errorf(`The summary should not contain unrelated sentences, got %q`, summary)
+	}
+
+	if strings.Contains(summary, "<") || strings.Contains(summary, "Solar energy") {
+		t.Errorf(`The summary should not contain markup or headings, got %q`, summary)
+	}
+
+	if utf8.RuneCountInString(summary) > maxSummaryLength {
+		t.Errorf(`The summary is too long: %d characters`, utf8.RuneCountInString(summary))
+	}
+}
+
+func TestSplitSentences(t *testing.T) {
+	sentences := splitSentences("Version 2.0 is out! Is it stable? Yes. 今日は晴れです。明日は雨です。")
+	expected := []string{"Version 2.0 is out!", "Is it stable?", "Yes.", "今日は晴れです。", "明日は雨です。"}
+
+	if len(sentences) != len(expected) {
+		t.Fatalf(`Unexpected sentences: %q`, sentences)
+	}
+
+	for i := range expected {
+		if sentences[i] != expected[i] {
+			t.Errorf(`Unexpected sentence at position %d: got %q instead of %q`, i, sentences[i], expected[i])
+		}
+	}
+}
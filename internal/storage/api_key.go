@@ -4,10 +4,17 @@
 package storage // import "miniflux.app/v2/internal/storage"
 
 import (
+	"crypto/md5"
+	"database/sql"
+	"encoding/hex"
 	"fmt"
+	"slices"
+	"strings"
 
 	"miniflux.app/v2/internal/crypto"
 	"miniflux.app/v2/internal/model"
+
+	"github.com/lib/pq"
 )
 
 var ErrAPIKeyNotFound = fmt.Errorf("store: API Key not found")
@@ -21,9 +28,9 @@ func (s *Storage) APIKeyExists(userID int64, description string) bool {
 }
 
 // SetAPIKeyUsedTimestamp updates the last used date of an API Key.
-func (s *Storage) SetAPIKeyUsedTimestamp(userID int64, token string) error {
-	query := `UPDATE api_keys SET last_used_at=now() WHERE user_id=$1 and token=$2`
-	_, err := s.db.Exec(query, userID, token)
+func (s *Storage) SetAPIKeyUsedTimestamp(userID, keyID int64) error {
+	query := `UPDATE api_keys SET last_used_at=now() WHERE user_id=$1 and id=$2`
+	_, err := s.db.Exec(query, userID, keyID)
 	if err != nil {
 		return fmt.Errorf(`store: unable to update last used date for API key: %v`, err)
 	}
@@ -31,11 +38,58 @@ func (s *Storage) SetAPIKeyUsedTimestamp(userID int64, token string) error {
 	return nil
 }
 
+// APIKey returns the API Key of the user with the given ID.
+func (s *Storage) APIKey(userID, keyID int64) (*model.APIKey, error) {
+	return s.apiKeyBy(`user_id=$1 AND id=$2`, userID, keyID)
+}
+
+// APIKeyByToken returns the API Key matching the given token, expired keys included.
+func (s *Storage) APIKeyByToken(token string) (*model.APIKey, error) {
+	return s.apiKeyBy(`token_hash=$1`, crypto.SHA256(token))
+}
+
+// APIKeyByFeverToken returns the Fever API Key matching the token sent by Fever clients, expired keys included.
+// Fever clients send the MD5 checksum of "username:password", the API key being used as password.
+// Only a hash of the checksum is stored and the token cannot be recovered, so Fever keys stop working when the user is renamed.
+func (s *Storage) APIKeyByFeverToken(feverToken string) (*model.APIKey, error) {
+	return s.apiKeyBy(`fever_token_hash=$1`, crypto.SHA256(strings.ToLower(feverToken)))
+}
+
+func (s *Storage) apiKeyBy(condition string, args ...any) (*model.APIKey, error) {
+	query := `
+		SELECT
+			id, user_id, description, scopes, expires_at, last_used_at, created_at
+		FROM
+			api_keys
+		WHERE
+	` + condition
+
+	var apiKey model.APIKey
+	err := s.db.QueryRow(query, args...).Scan(
+		&apiKey.ID,
+		&apiKey.UserID,
+		&apiKey.Description,
+		pq.Array(&apiKey.Scopes),
+		&apiKey.ExpiresAt,
+		&apiKey.LastUsedAt,
+		&apiKey.CreatedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch API Key: %v`, err)
+	}
+
+	return &apiKey, nil
+}
+
 // APIKeys returns all API Keys that belongs to the given user.
 func (s *Storage) APIKeys(userID int64) (model.APIKeys, error) {
 	query := `
 		SELECT
-			id, user_id, token, description, last_used_at, created_at
+			id, user_id, description, scopes, expires_at, last_used_at, created_at
 		FROM
 			api_keys
 		WHERE
@@ -54,8 +108,9 @@ func (s *Storage) APIKeys(userID int64) (model.APIKeys, error) {
 		if err := rows.Scan(
 			&apiKey.ID,
 			&apiKey.UserID,
-			&apiKey.Token,
 			&apiKey.Description,
+			pq.Array(&apiKey.Scopes),
+			&apiKey.ExpiresAt,
 			&apiKey.LastUsedAt,
 			&apiKey.CreatedAt,
 		); err != nil {
@@ -68,27 +123,49 @@ func (s *Storage) APIKeys(userID int64) (model.APIKeys, error) {
 	return apiKeys, nil
 }
 
-// CreateAPIKey inserts a new API key.
-func (s *Storage) CreateAPIKey(userID int64, description string) (*model.APIKey, error) {
+// CreateAPIKey inserts a new API key, the generated token is only returned by this function.
+func (s *Storage) CreateAPIKey(userID int64, request *model.APIKeyCreationRequest) (*model.APIKey, error) {
+	token := crypto.GenerateRandomStringHex(32)
+
+	scopes := request.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{model.APIKeyScopeFull}
+	}
+
+	var feverTokenHash string
+	if slices.Contains(scopes, model.APIKeyScopeFever) {
+		var username string
+		if err := s.db.QueryRow(`SELECT username FROM users WHERE id=$1`, userID).Scan(&username); err != nil {
+			return nil, fmt.Errorf(`store: unable to create API Key: %v`, err)
+		}
+
+		feverToken := md5.Sum([]byte(username + ":" + token))
+		feverTokenHash = crypto.SHA256(hex.EncodeToString(feverToken[:]))
+	}
+
 	query := `
 		INSERT INTO api_keys
-			(user_id, token, description)
+			(user_id, token_hash, fever_token_hash, description, scopes, expires_at)
 		VALUES
-			($1, $2, $3)
+			($1, $2, $3, $4, $5, $6)
 		RETURNING
-			id, user_id, token, description, last_used_at, created_at
+			id, user_id, description, scopes, expires_at, last_used_at, created_at
 	`
-	var apiKey model.APIKey
+	apiKey := model.APIKey{Token: token}
 	err := s.db.QueryRow(
 		query,
 		userID,
-		crypto.GenerateRandomStringHex(32),
-		description,
+		crypto.SHA256(token),
+		feverTokenHash,
+		request.Description,
+		pq.Array(scopes),
+		request.ExpiresAt,
 	).Scan(
 		&apiKey.ID,
 		&apiKey.UserID,
-		&apiKey.Token,
 		&apiKey.Description,
+		pq.Array(&apiKey.Scopes),
+		&apiKey.ExpiresAt,
 		&apiKey.LastUsedAt,
 		&apiKey.CreatedAt,
 	)
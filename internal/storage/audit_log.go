@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// AuditLogFilter restricts the audit log entries returned by AuditLogs and CountAuditLogs.
+// Empty fields are ignored.
+type AuditLogFilter struct {
+	Action string
+	Actor  string
+
+	// Search matches the action, the actor, the target or the client IP.
+	Search string
+}
+
+// Audit records an action in the audit log, the actor ID is 0 for the actions not performed by a user.
+// A failure is logged but not returned since the action has already been performed.
+func (s *Storage) Audit(actorID int64, action, target, clientIP string) {
+	if err := s.CreateAuditLog(model.NewAuditLog(actorID, action, target, clientIP)); err != nil {
+		slog.Error("Unable to write audit log entry",
+			slog.Int64("actor_id", actorID),
+			slog.String("action", action),
+			slog.String("target", target),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// CreateAuditLog appends an entry to the audit log. The username of the actor is copied
+// so the entry remains readable after the user has been removed.
+// Audit log entries are never modified, they are only removed by the cleanup tasks.
+func (s *Storage) CreateAuditLog(auditLog *model.AuditLog) error {
+	query := `
+		INSERT INTO audit_logs
+			(actor_id, actor_username, action, target, client_ip)
+		VALUES
+			($1, COALESCE((SELECT username FROM users WHERE id=$1), ''), $2, $3, $4)
+		RETURNING
+			id, actor_username, created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		auditLog.ActorID,
+		auditLog.Action,
+		auditLog.Target,
+		auditLog.ClientIP,
+	).Scan(&auditLog.ID, &auditLog.ActorUsername, &auditLog.CreatedAt)
+	if err != nil {
+		return fmt.Errorf(`store: unable to create audit log entry: %v`, err)
+	}
+
+	return nil
+}
+
+// AuditLogs returns the audit log entries matching the filter, the most recent first.
+func (s *Storage) AuditLogs(filter AuditLogFilter, offset, limit int) (model.AuditLogs, error) {
+	conditions, args := filter.conditions()
+	query := `
+		SELECT
+			id,
+			actor_id,
+			actor_username,
+			action,
+			target,
+			client_ip,
+			created_at
+		FROM
+			audit_logs
+		WHERE
+			` + conditions + `
+		ORDER BY
+			created_at DESC, id DESC
+		OFFSET $` + strconv.Itoa(len(args)+1) + `
+		LIMIT $` + strconv.Itoa(len(args)+2)
+
+	rows, err := s.db.Query(query, append(args, offset, limit)...)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch audit logs: %v`, err)
+	}
+	defer rows.Close()
+
+	auditLogs := make(model.AuditLogs, 0)
+	for rows.Next() {
+		var auditLog model.AuditLog
+		if err := rows.Scan(
+			&auditLog.ID,
+			&auditLog.ActorID,
+			&auditLog.ActorUsername,
+			&auditLog.Action,
+			&auditLog.Target,
+			&auditLog.ClientIP,
+			&auditLog.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch audit log row: %v`, err)
+		}
+
+		auditLogs = append(auditLogs, &auditLog)
+	}
+
+	return auditLogs, nil
+}
+
+// CountAuditLogs returns the number of audit log entries matching the filter.
+func (s *Storage) CountAuditLogs(filter AuditLogFilter) int {
+	conditions, args := filter.conditions()
+
+	var result int
+	s.db.QueryRow(`SELECT count(*) FROM audit_logs WHERE `+conditions, args...).Scan(&result)
+	return result
+}
+
+// CleanOldAuditLogs removes the audit log entries older than the given interval.
+func (s *Storage) CleanOldAuditLogs(interval time.Duration) (int64, error) {
+	query := `
+		DELETE FROM
+			audit_logs
+		WHERE
+			created_at < now() - $1::interval
+	`
+
+	days := max(int(interval/(24*time.Hour)), 1)
+
+	result, err := s.db.Exec(query, fmt.Sprintf("%d days", days))
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to remove old audit logs: %v`, err)
+	}
+
+	n, _ := result.RowsAffected()
+	return n, nil
+}
+
+func (f AuditLogFilter) conditions() (string, []any) {
+	conditions := []string{"true"}
+	var args []any
+
+	if f.Action != "" {
+		args = append(args, f.Action)
+		conditions = append(conditions, fmt.Sprintf("action=$%d", len(args)))
+	}
+
+	if f.Actor != "" {
+		args = append(args, f.Actor)
+		conditions = append(conditions, fmt.Sprintf("actor_username=LOWER($%d)", len(args)))
+	}
+
+	if f.Search != "" {
+		args = append(args, "%"+escapeLikePattern(f.Search)+"%")
+		conditions = append(conditions, fmt.Sprintf("(action ILIKE $%[1]d OR actor_username ILIKE $%[1]d OR target ILIKE $%[1]d OR client_ip ILIKE $%[1]d)", len(args)))
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
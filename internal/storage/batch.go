@@ -60,6 +60,7 @@ func (b *BatchBuilder) WithNextCheckExpired() *BatchBuilder {
 
 func (b *BatchBuilder) WithoutDisabledFeeds() *BatchBuilder {
 	b.conditions = append(b.conditions, "disabled IS false")
+	b.conditions = append(b.conditions, "(paused_until IS NULL OR paused_until <= now())")
 	return b
 }
 
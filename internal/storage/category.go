@@ -12,19 +12,105 @@ import (
 	"miniflux.app/v2/internal/model"
 )
 
-// AnotherCategoryExists checks if another category exists with the same title.
-func (s *Storage) AnotherCategoryExists(userID, categoryID int64, title string) bool {
+// ErrSubcategoryTitleConflict is returned when removing a category would move a subcategory next to a category with the same title.
+var ErrSubcategoryTitleConflict = errors.New("store: a subcategory has the same title as a category of the parent category")
+
+// categoryColumns lists the columns scanned by categoryFields.
+const categoryColumns = `
+	id,
+	user_id,
+	title,
+	hide_globally,
+	COALESCE(parent_id, 0),
+	retention_policy,
+	retention_value,
+	scraper_rules,
+	rewrite_rules,
+	url_rewrite_rules,
+	blocklist_rules,
+	keeplist_rules,
+	block_filter_entry_rules,
+	keep_filter_entry_rules,
+	user_agent,
+	cookie,
+	proxy_url,
+	fetch_via_proxy,
+	crawler,
+	apprise_service_urls,
+	webhook_url,
+	ntfy_enabled,
+	ntfy_priority,
+	ntfy_topic,
+	pushover_enabled,
+	pushover_priority
+`
+
+// categorySecrets lists the category fields encrypted in the database.
+var categorySecrets = []struct {
+	column string
+	field  func(*model.Category) *string
+}{
+	{"cookie", func(c *model.Category) *string { return &c.Cookie }},
+	{"proxy_url", func(c *model.Category) *string { return &c.ProxyURL }},
+}
+
+// decryptCategorySecrets decrypts the secrets of a category read from the database.
+func (s *Storage) decryptCategorySecrets(category *model.Category) {
+	if category == nil {
+		return
+	}
+
+	for _, secret := range categorySecrets {
+		s.decryptSecretField(secret.column, secret.field(category), &category.UndecryptableSecrets)
+	}
+}
+
+func categoryFields(category *model.Category) []any {
+	return []any{
+		&category.ID,
+		&category.UserID,
+		&category.Title,
+		&category.HideGlobally,
+		&category.ParentID,
+		&category.RetentionPolicy,
+		&category.RetentionValue,
+		&category.ScraperRules,
+		&category.RewriteRules,
+		&category.UrlRewriteRules,
+		&category.BlocklistRules,
+		&category.KeeplistRules,
+		&category.BlockFilterEntryRules,
+		&category.KeepFilterEntryRules,
+		&category.UserAgent,
+		&category.Cookie,
+		&category.ProxyURL,
+		&category.FetchViaProxy,
+		&category.Crawler,
+		&category.AppriseServiceURLs,
+		&category.WebhookURL,
+		&category.NtfyEnabled,
+		&category.NtfyPriority,
+		&category.NtfyTopic,
+		&category.PushoverEnabled,
+		&category.PushoverPriority,
+	}
+}
+
+// AnotherCategoryExists checks if another category exists with the same title under the same parent category.
+// The parent ID is 0 for top-level categories.
+func (s *Storage) AnotherCategoryExists(userID, categoryID, parentID int64, title string) bool {
 	var result bool
-	query := `SELECT true FROM categories WHERE user_id=$1 AND id != $2 AND lower(title)=lower($3) LIMIT 1`
-	s.db.QueryRow(query, userID, categoryID, title).Scan(&result)
+	query := `SELECT true FROM categories WHERE user_id=$1 AND id != $2 AND COALESCE(parent_id, 0)=$3 AND lower(title)=lower($4) LIMIT 1`
+	s.db.QueryRow(query, userID, categoryID, parentID, title).Scan(&result)
 	return result
 }
 
-// CategoryTitleExists checks if the given category exists into the database.
-func (s *Storage) CategoryTitleExists(userID int64, title string) bool {
+// CategoryTitleExists checks if a category with the given title exists under the parent category.
+// The parent ID is 0 for top-level categories.
+func (s *Storage) CategoryTitleExists(userID, parentID int64, title string) bool {
 	var result bool
-	query := `SELECT true FROM categories WHERE user_id=$1 AND lower(title)=lower($2) LIMIT 1`
-	s.db.QueryRow(query, userID, title).Scan(&result)
+	query := `SELECT true FROM categories WHERE user_id=$1 AND COALESCE(parent_id, 0)=$2 AND lower(title)=lower($3) LIMIT 1`
+	s.db.QueryRow(query, userID, parentID, title).Scan(&result)
 	return result
 }
 
@@ -36,12 +122,42 @@ func (s *Storage) CategoryIDExists(userID, categoryID int64) bool {
 	return result
 }
 
+// SubcategoryTitleConflicts checks if a subcategory has the same title as a sibling of the given category.
+// The subcategories are moved to the parent category when the category is removed.
+func (s *Storage) SubcategoryTitleConflicts(userID, categoryID int64) bool {
+	var result bool
+	s.db.QueryRow(subcategoryTitleConflictQuery, userID, categoryID).Scan(&result)
+	return result
+}
+
+const subcategoryTitleConflictQuery = `
+	SELECT
+		true
+	FROM
+		categories c
+	JOIN
+		categories p ON p.id=c.parent_id
+	JOIN
+		categories s ON s.user_id=p.user_id AND COALESCE(s.parent_id, 0)=COALESCE(p.parent_id, 0) AND s.id != p.id
+	WHERE
+		c.user_id=$1 AND c.parent_id=$2 AND lower(s.title)=lower(c.title)
+	LIMIT 1
+`
+
+// CategoryHasDescendant checks if the given category is an ancestor of the descendant category.
+func (s *Storage) CategoryHasDescendant(userID, categoryID, descendantID int64) bool {
+	var result bool
+	query := `SELECT true FROM categories WHERE user_id=$1 AND ` + categoryTreeCondition("id", 2) + ` AND id != $2 AND id=$3 LIMIT 1`
+	s.db.QueryRow(query, userID, categoryID, descendantID).Scan(&result)
+	return result
+}
+
 // Category returns a category from the database.
 func (s *Storage) Category(userID, categoryID int64) (*model.Category, error) {
 	var category model.Category
 
-	query := `SELECT id, user_id, title, hide_globally FROM categories WHERE user_id=$1 AND id=$2`
-	err := s.db.QueryRow(query, userID, categoryID).Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally)
+	query := `SELECT ` + categoryColumns + ` FROM categories WHERE user_id=$1 AND id=$2`
+	err := s.db.QueryRow(query, userID, categoryID).Scan(categoryFields(&category)...)
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -49,16 +165,17 @@ func (s *Storage) Category(userID, categoryID int64) (*model.Category, error) {
 	case err != nil:
 		return nil, fmt.Errorf(`store: unable to fetch category: %v`, err)
 	default:
+		s.decryptCategorySecrets(&category)
 		return &category, nil
 	}
 }
 
 // FirstCategory returns the first category for the given user.
 func (s *Storage) FirstCategory(userID int64) (*model.Category, error) {
-	query := `SELECT id, user_id, title, hide_globally FROM categories WHERE user_id=$1 ORDER BY title ASC LIMIT 1`
+	query := `SELECT ` + categoryColumns + ` FROM categories WHERE user_id=$1 ORDER BY title ASC LIMIT 1`
 
 	var category model.Category
-	err := s.db.QueryRow(query, userID).Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally)
+	err := s.db.QueryRow(query, userID).Scan(categoryFields(&category)...)
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -66,16 +183,18 @@ func (s *Storage) FirstCategory(userID int64) (*model.Category, error) {
 	case err != nil:
 		return nil, fmt.Errorf(`store: unable to fetch category: %v`, err)
 	default:
+		s.decryptCategorySecrets(&category)
 		return &category, nil
 	}
 }
 
-// CategoryByTitle finds a category by the title.
-func (s *Storage) CategoryByTitle(userID int64, title string) (*model.Category, error) {
+// CategoryByTitle finds a category by the title under the parent category.
+// The parent ID is 0 for top-level categories, titles are only unique among the subcategories of a parent.
+func (s *Storage) CategoryByTitle(userID, parentID int64, title string) (*model.Category, error) {
 	var category model.Category
 
-	query := `SELECT id, user_id, title, hide_globally FROM categories WHERE user_id=$1 AND title=$2`
-	err := s.db.QueryRow(query, userID, title).Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally)
+	query := `SELECT ` + categoryColumns + ` FROM categories WHERE user_id=$1 AND COALESCE(parent_id, 0)=$2 AND title=$3`
+	err := s.db.QueryRow(query, userID, parentID, title).Scan(categoryFields(&category)...)
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -83,13 +202,14 @@ func (s *Storage) CategoryByTitle(userID int64, title string) (*model.Category,
 	case err != nil:
 		return nil, fmt.Errorf(`store: unable to fetch category: %v`, err)
 	default:
+		s.decryptCategorySecrets(&category)
 		return &category, nil
 	}
 }
 
 // Categories returns all categories that belongs to the given user.
 func (s *Storage) Categories(userID int64) (model.Categories, error) {
-	query := `SELECT id, user_id, title, hide_globally FROM categories WHERE user_id=$1 ORDER BY title ASC`
+	query := `SELECT ` + categoryColumns + ` FROM categories WHERE user_id=$1 ORDER BY title ASC`
 	rows, err := s.db.Query(query, userID)
 	if err != nil {
 		return nil, fmt.Errorf(`store: unable to fetch categories: %v`, err)
@@ -99,10 +219,11 @@ func (s *Storage) Categories(userID int64) (model.Categories, error) {
 	categories := make(model.Categories, 0)
 	for rows.Next() {
 		var category model.Category
-		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally); err != nil {
+		if err := rows.Scan(categoryFields(&category)...); err != nil {
 			return nil, fmt.Errorf(`store: unable to fetch category row: %v`, err)
 		}
 
+		s.decryptCategorySecrets(&category)
 		categories = append(categories, category)
 	}
 
@@ -110,6 +231,7 @@ func (s *Storage) Categories(userID int64) (model.Categories, error) {
 }
 
 // CategoriesWithFeedCount returns all categories with the number of feeds.
+// The number of unread entries includes the entries of the subcategories.
 func (s *Storage) CategoriesWithFeedCount(userID int64) (model.Categories, error) {
 	user, err := s.UserByID(userID)
 	if err != nil {
@@ -117,16 +239,19 @@ func (s *Storage) CategoriesWithFeedCount(userID int64) (model.Categories, error
 	}
 
 	query := `
+		WITH RECURSIVE category_tree(root_id, id) AS (
+			SELECT id, id FROM categories WHERE user_id=$2
+			UNION
+			SELECT t.root_id, sc.id FROM categories sc JOIN category_tree t ON sc.parent_id = t.id
+		)
 		SELECT
-			c.id,
-			c.user_id,
-			c.title,
-			c.hide_globally,
+			` + categoryColumns + `,
 			(SELECT count(*) FROM feeds WHERE feeds.category_id=c.id) AS count,
 			(SELECT count(*)
-			   FROM feeds
+			   FROM category_tree t
+			     JOIN feeds ON (feeds.category_id = t.id)
 			     JOIN entries ON (feeds.id = entries.feed_id)
-			   WHERE feeds.category_id = c.id AND entries.status = $1) AS count_unread
+			   WHERE t.root_id = c.id AND entries.status = $1) AS count_unread
 		FROM categories c
 		WHERE
 			user_id=$2
@@ -154,10 +279,11 @@ func (s *Storage) CategoriesWithFeedCount(userID int64) (model.Categories, error
 	categories := make(model.Categories, 0)
 	for rows.Next() {
 		var category model.Category
-		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally, &category.FeedCount, &category.TotalUnread); err != nil {
+		if err := rows.Scan(append(categoryFields(&category), &category.FeedCount, &category.TotalUnread)...); err != nil {
 			return nil, fmt.Errorf(`store: unable to fetch category row: %v`, err)
 		}
 
+		s.decryptCategorySecrets(&category)
 		categories = append(categories, category)
 	}
 
@@ -170,26 +296,20 @@ func (s *Storage) CreateCategory(userID int64, request *model.CategoryCreationRe
 
 	query := `
 		INSERT INTO categories
-			(user_id, title, hide_globally)
+			(user_id, title, hide_globally, parent_id, retention_policy, retention_value)
 		VALUES
-			($1, $2, $3)
+			($1, $2, $3, NULLIF($4, 0), $5, $6)
 		RETURNING
-			id,
-			user_id,
-			title,
-			hide_globally
-	`
+			` + categoryColumns
 	err := s.db.QueryRow(
 		query,
 		userID,
 		request.Title,
 		request.HideGlobally,
-	).Scan(
-		&category.ID,
-		&category.UserID,
-		&category.Title,
-		&category.HideGlobally,
-	)
+		request.ParentID,
+		request.RetentionPolicy,
+		request.RetentionValue,
+	).Scan(categoryFields(&category)...)
 
 	if err != nil {
 		return nil, fmt.Errorf(`store: unable to create category %q for user ID %d: %v`, request.Title, userID, err)
@@ -200,11 +320,73 @@ func (s *Storage) CreateCategory(userID int64, request *model.CategoryCreationRe
 
 // UpdateCategory updates an existing category.
 func (s *Storage) UpdateCategory(category *model.Category) error {
-	query := `UPDATE categories SET title=$1, hide_globally=$2 WHERE id=$3 AND user_id=$4`
+	encryptedCategory := *category
+	for _, secret := range categorySecrets {
+		field := secret.field(&encryptedCategory)
+		encryptedValue, err := s.encryptSecretField(secret.column, *field, category.UndecryptableSecrets)
+		if err != nil {
+			return err
+		}
+		*field = encryptedValue
+	}
+
+	query := `
+		UPDATE
+			categories
+		SET
+			title=$1,
+			hide_globally=$2,
+			parent_id=NULLIF($3, 0),
+			retention_policy=$4,
+			retention_value=$5,
+			scraper_rules=$6,
+			rewrite_rules=$7,
+			url_rewrite_rules=$8,
+			blocklist_rules=$9,
+			keeplist_rules=$10,
+			block_filter_entry_rules=$11,
+			keep_filter_entry_rules=$12,
+			user_agent=$13,
+			cookie=$14,
+			proxy_url=$15,
+			fetch_via_proxy=$16,
+			crawler=$17,
+			apprise_service_urls=$18,
+			webhook_url=$19,
+			ntfy_enabled=$20,
+			ntfy_priority=$21,
+			ntfy_topic=$22,
+			pushover_enabled=$23,
+			pushover_priority=$24
+		WHERE
+			id=$25 AND user_id=$26
+	`
 	_, err := s.db.Exec(
 		query,
 		category.Title,
 		category.HideGlobally,
+		category.ParentID,
+		category.RetentionPolicy,
+		category.RetentionValue,
+		category.ScraperRules,
+		category.RewriteRules,
+		category.UrlRewriteRules,
+		category.BlocklistRules,
+		category.KeeplistRules,
+		category.BlockFilterEntryRules,
+		category.KeepFilterEntryRules,
+		category.UserAgent,
+		encryptedCategory.Cookie,
+		encryptedCategory.ProxyURL,
+		category.FetchViaProxy,
+		category.Crawler,
+		category.AppriseServiceURLs,
+		category.WebhookURL,
+		category.NtfyEnabled,
+		category.NtfyPriority,
+		category.NtfyTopic,
+		category.PushoverEnabled,
+		category.PushoverPriority,
 		category.ID,
 		category.UserID,
 	)
@@ -216,38 +398,61 @@ func (s *Storage) UpdateCategory(category *model.Category) error {
 	return nil
 }
 
-// RemoveCategory deletes a category.
+// RemoveCategory deletes a category, its subcategories are moved to its parent category.
 func (s *Storage) RemoveCategory(userID, categoryID int64) error {
-	query := `DELETE FROM categories WHERE id = $1 AND user_id = $2`
-	result, err := s.db.Exec(query, categoryID, userID)
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	query := `
+		UPDATE categories
+		SET parent_id=(SELECT parent_id FROM categories WHERE id = $1 AND user_id = $2)
+		WHERE parent_id = $1 AND user_id = $2
+	`
+	if _, err := tx.Exec(query, categoryID, userID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to move subcategories: %v`, err)
+	}
+
+	query = `DELETE FROM categories WHERE id = $1 AND user_id = $2`
+	result, err := tx.Exec(query, categoryID, userID)
 	if err != nil {
+		tx.Rollback()
 		return fmt.Errorf(`store: unable to remove this category: %v`, err)
 	}
 
 	count, err := result.RowsAffected()
 	if err != nil {
+		tx.Rollback()
 		return fmt.Errorf(`store: unable to remove this category: %v`, err)
 	}
 
 	if count == 0 {
+		tx.Rollback()
 		return errors.New(`store: no category has been removed`)
 	}
 
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
 	return nil
 }
 
-// RemoveAndReplaceCategoriesByName deletes the given categories, replacing those categories with the user's first
-// category on affected feeds.
-func (s *Storage) RemoveAndReplaceCategoriesByName(userid int64, titles []string) error {
+// RemoveAndReplaceCategories deletes the given categories, replacing those categories with the user's first
+// category on affected feeds. Subcategories are moved to the parent category, ErrSubcategoryTitleConflict
+// is returned if one of them has the same title as a category already there.
+func (s *Storage) RemoveAndReplaceCategories(userid int64, categoryIDs []int64) error {
 	tx, err := s.db.Begin()
 	if err != nil {
 		return errors.New("store: unable to begin transaction")
 	}
 
-	titleParam := pq.Array(titles)
+	categoryIDsParam := pq.Array(categoryIDs)
 	var count int
-	query := "SELECT count(*) FROM categories WHERE user_id = $1 and title != ANY($2)"
-	err = tx.QueryRow(query, userid, titleParam).Scan(&count)
+	query := "SELECT count(*) FROM categories WHERE user_id = $1 and NOT id = ANY($2)"
+	err = tx.QueryRow(query, userid, categoryIDsParam).Scan(&count)
 	if err != nil {
 		tx.Rollback()
 		return errors.New("store: unable to retrieve category count")
@@ -258,7 +463,7 @@ func (s *Storage) RemoveAndReplaceCategoriesByName(userid int64, titles []string
 	}
 
 	query = `
-		WITH d_cats AS (SELECT id FROM categories WHERE user_id = $1 AND title = ANY($2))
+		WITH d_cats AS (SELECT id FROM categories WHERE user_id = $1 AND id = ANY($2))
 		UPDATE feeds
 		 SET category_id =
 		  (SELECT id
@@ -268,18 +473,58 @@ func (s *Storage) RemoveAndReplaceCategoriesByName(userid int64, titles []string
 			LIMIT 1)
 		WHERE user_id = $1 AND category_id IN (SELECT id FROM d_cats)
 	`
-	_, err = tx.Exec(query, userid, titleParam)
+	_, err = tx.Exec(query, userid, categoryIDsParam)
 	if err != nil {
 		tx.Rollback()
 		return fmt.Errorf("store: unable to replace categories: %v", err)
 	}
 
-	query = "DELETE FROM categories WHERE user_id = $1 AND title = ANY($2)"
-	_, err = tx.Exec(query, userid, titleParam)
-	if err != nil {
-		tx.Rollback()
-		return fmt.Errorf("store: unable to delete categories: %v", err)
+	// Subcategories are moved to the parent of each removed category, one category at a time,
+	// so that nested categories removed together end up under the closest remaining ancestor.
+	for _, categoryID := range categoryIDs {
+		var conflict bool
+		if err := tx.QueryRow(subcategoryTitleConflictQuery, userid, categoryID).Scan(&conflict); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			tx.Rollback()
+			return fmt.Errorf("store: unable to check subcategory titles: %v", err)
+		}
+
+		if conflict {
+			tx.Rollback()
+			return ErrSubcategoryTitleConflict
+		}
+
+		query = `
+			UPDATE categories
+			SET parent_id=(SELECT parent_id FROM categories WHERE id = $1 AND user_id = $2)
+			WHERE parent_id = $1 AND user_id = $2
+		`
+		if _, err := tx.Exec(query, categoryID, userid); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("store: unable to move subcategories: %v", err)
+		}
+
+		query = "DELETE FROM categories WHERE user_id = $1 AND id = $2"
+		if _, err := tx.Exec(query, userid, categoryID); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("store: unable to delete categories: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("store: unable to commit transaction: %v", err)
 	}
-	tx.Commit()
+
 	return nil
 }
+
+// categoryTreeCondition returns a condition matching the category given at the argument position and its subcategories.
+func categoryTreeCondition(column string, argPosition int) string {
+	return fmt.Sprintf(`%s IN (
+		WITH RECURSIVE category_tree(id) AS (
+			SELECT id FROM categories WHERE id = $%[2]d
+			UNION
+			SELECT sc.id FROM categories sc JOIN category_tree t ON sc.parent_id = t.id
+		)
+		SELECT id FROM category_tree
+	)`, column, argPosition)
+}
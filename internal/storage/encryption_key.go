@@ -0,0 +1,320 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/crypto"
+)
+
+type dataKey struct {
+	id       int64
+	key      []byte
+	isActive bool
+}
+
+// masterKeys returns the configured master keys, the current one first.
+func masterKeys() []string {
+	keys := []string{config.Opts.EncryptionKey()}
+	if previousKey := config.Opts.EncryptionPreviousKey(); previousKey != "" {
+		keys = append(keys, previousKey)
+	}
+	return keys
+}
+
+// loadKeyring returns the data keys used to encrypt secrets, a first data key is created when needed.
+// It returns nil when no master key is configured.
+func (s *Storage) loadKeyring() (*crypto.Keyring, error) {
+	if config.Opts.EncryptionKey() == "" {
+		return nil, nil
+	}
+
+	s.keyringMutex.Lock()
+	defer s.keyringMutex.Unlock()
+
+	if s.keyring != nil {
+		return s.keyring, nil
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+	defer tx.Rollback()
+
+	keyring, _, err := readKeyring(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !keyring.HasActiveDataKey() {
+		if err := createDataKey(tx, keyring); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	s.keyring = keyring
+	return keyring, nil
+}
+
+// resetKeyring forces the data keys to be loaded again from the database.
+func (s *Storage) resetKeyring() {
+	s.keyringMutex.Lock()
+	defer s.keyringMutex.Unlock()
+
+	s.keyring = nil
+}
+
+// RotateEncryptionKey creates a new data key and encrypts again all secrets with it.
+// The previous data keys are kept and encrypted with the current master key,
+// which makes this function also suitable to change the master key.
+func (s *Storage) RotateEncryptionKey() error {
+	if config.Opts.EncryptionKey() == "" {
+		return fmt.Errorf(`store: unable to rotate encryption key: %v`, crypto.ErrMissingEncryptionKey)
+	}
+
+	s.keyringMutex.Lock()
+	defer s.keyringMutex.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+	defer tx.Rollback()
+
+	keyring, dataKeys, err := readKeyring(tx)
+	if err != nil {
+		return err
+	}
+
+	for _, dataKey := range dataKeys {
+		wrappedKey, err := crypto.WrapDataKey(config.Opts.EncryptionKey(), dataKey.key)
+		if err != nil {
+			return fmt.Errorf(`store: unable to encrypt data key: %v`, err)
+		}
+
+		if _, err := tx.Exec(`UPDATE encryption_keys SET wrapped_key=$1, is_active='f' WHERE id=$2`, wrappedKey, dataKey.id); err != nil {
+			return fmt.Errorf(`store: unable to update data key: %v`, err)
+		}
+	}
+
+	if err := createDataKey(tx, keyring); err != nil {
+		return err
+	}
+
+	feedCount, err := reencryptColumns(tx, keyring, "feeds", "id", feedSecretColumns())
+	if err != nil {
+		return err
+	}
+
+	categoryCount, err := reencryptColumns(tx, keyring, "categories", "id", categorySecretColumns())
+	if err != nil {
+		return err
+	}
+
+	integrationCount, err := reencryptColumns(tx, keyring, "integrations", "user_id", integrationSecretColumns())
+	if err != nil {
+		return err
+	}
+
+	userCount, err := reencryptColumns(tx, keyring, "users", "id", []string{"totp_secret"})
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	slog.Info("Encryption key rotated",
+		slog.Int("data_keys_count", len(dataKeys)+1),
+		slog.Int("feeds_count", feedCount),
+		slog.Int("categories_count", categoryCount),
+		slog.Int("integrations_count", integrationCount),
+		slog.Int("users_count", userCount),
+	)
+
+	s.keyring = nil
+	return nil
+}
+
+// readKeyring locks the encryption keys table and decrypts all data keys with the master keys.
+func readKeyring(tx *sql.Tx) (*crypto.Keyring, []dataKey, error) {
+	if _, err := tx.Exec(`LOCK TABLE encryption_keys IN EXCLUSIVE MODE`); err != nil {
+		return nil, nil, fmt.Errorf(`store: unable to lock encryption keys: %v`, err)
+	}
+
+	rows, err := tx.Query(`SELECT id, wrapped_key, is_active FROM encryption_keys ORDER BY id ASC`)
+	if err != nil {
+		return nil, nil, fmt.Errorf(`store: unable to fetch encryption keys: %v`, err)
+	}
+	defer rows.Close()
+
+	keyring := crypto.NewKeyring(masterKeys()...)
+	var dataKeys []dataKey
+
+	for rows.Next() {
+		var (
+			key        dataKey
+			wrappedKey string
+		)
+
+		if err := rows.Scan(&key.id, &wrappedKey, &key.isActive); err != nil {
+			return nil, nil, fmt.Errorf(`store: unable to fetch encryption key row: %v`, err)
+		}
+
+		for _, masterKey := range masterKeys() {
+			if key.key, err = crypto.UnwrapDataKey(masterKey, wrappedKey); err == nil {
+				break
+			}
+		}
+
+		if err != nil {
+			return nil, nil, fmt.Errorf(`store: unable to decrypt data key #%d, the encryption key is probably wrong: %v`, key.id, err)
+		}
+
+		if err := keyring.AddDataKey(key.id, key.key, key.isActive); err != nil {
+			return nil, nil, fmt.Errorf(`store: unable to load data key #%d: %v`, key.id, err)
+		}
+
+		dataKeys = append(dataKeys, key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf(`store: unable to fetch encryption keys: %v`, err)
+	}
+
+	return keyring, dataKeys, nil
+}
+
+// createDataKey generates a new active data key, stored encrypted with the master key.
+func createDataKey(tx *sql.Tx, keyring *crypto.Keyring) error {
+	key, err := crypto.GenerateDataKey()
+	if err != nil {
+		return fmt.Errorf(`store: unable to generate data key: %v`, err)
+	}
+
+	wrappedKey, err := crypto.WrapDataKey(config.Opts.EncryptionKey(), key)
+	if err != nil {
+		return fmt.Errorf(`store: unable to encrypt data key: %v`, err)
+	}
+
+	var keyID int64
+	err = tx.QueryRow(
+		`INSERT INTO encryption_keys (wrapped_key, is_active) VALUES ($1, 't') RETURNING id`,
+		wrappedKey,
+	).Scan(&keyID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to create data key: %v`, err)
+	}
+
+	return keyring.AddDataKey(keyID, key, true)
+}
+
+// reencryptColumns decrypts the given columns of all rows, and encrypts them again with the active data key.
+// Values stored in plain text are encrypted as well.
+func reencryptColumns(tx *sql.Tx, keyring *crypto.Keyring, table, idColumn string, columns []string) (int, error) {
+	query := fmt.Sprintf(`SELECT %s, %s FROM %s`, idColumn, strings.Join(columns, ", "), table)
+	rows, err := tx.Query(query)
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to fetch %s secrets: %v`, table, err)
+	}
+
+	type secretsRow struct {
+		id     int64
+		values []string
+	}
+
+	var secretsRows []secretsRow
+	for rows.Next() {
+		row := secretsRow{values: make([]string, len(columns))}
+		dest := []any{&row.id}
+		for i := range row.values {
+			dest = append(dest, &row.values[i])
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf(`store: unable to fetch %s secrets row: %v`, table, err)
+		}
+
+		secretsRows = append(secretsRows, row)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf(`store: unable to fetch %s secrets: %v`, table, err)
+	}
+
+	assignments := make([]string, len(columns))
+	for i, column := range columns {
+		assignments[i] = fmt.Sprintf(`%s=$%d`, column, i+1)
+	}
+	update := fmt.Sprintf(`UPDATE %s SET %s WHERE %s=$%d`, table, strings.Join(assignments, ", "), idColumn, len(columns)+1)
+
+	count := 0
+	for _, row := range secretsRows {
+		args := make([]any, 0, len(columns)+1)
+		hasSecrets := false
+
+		for _, value := range row.values {
+			plaintext, err := keyring.Decrypt(value)
+			if err != nil {
+				return 0, fmt.Errorf(`store: unable to decrypt secret of %s #%d: %v`, table, row.id, err)
+			}
+
+			encryptedValue, err := keyring.Encrypt(plaintext)
+			if err != nil {
+				return 0, fmt.Errorf(`store: unable to encrypt secret of %s #%d: %v`, table, row.id, err)
+			}
+
+			hasSecrets = hasSecrets || encryptedValue != ""
+			args = append(args, encryptedValue)
+		}
+
+		if !hasSecrets {
+			continue
+		}
+
+		if _, err := tx.Exec(update, append(args, row.id)...); err != nil {
+			return 0, fmt.Errorf(`store: unable to update secrets of %s #%d: %v`, table, row.id, err)
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+func feedSecretColumns() []string {
+	columns := make([]string, len(feedSecrets))
+	for i, secret := range feedSecrets {
+		columns[i] = secret.column
+	}
+	return columns
+}
+
+func categorySecretColumns() []string {
+	columns := make([]string, len(categorySecrets))
+	for i, secret := range categorySecrets {
+		columns[i] = secret.column
+	}
+	return columns
+}
+
+func integrationSecretColumns() []string {
+	columns := make([]string, len(integrationSecrets))
+	for i, secret := range integrationSecrets {
+		columns[i] = secret.column
+	}
+	return columns
+}
@@ -67,7 +67,7 @@ func (s *Storage) NewEntryQueryBuilder(userID int64) *EntryQueryBuilder {
 	return NewEntryQueryBuilder(s, userID)
 }
 
-// UpdateEntryTitleAndContent updates entry title and content.
+// UpdateEntryTitleAndContent updates entry title, content and thumbnail.
 func (s *Storage) UpdateEntryTitleAndContent(entry *model.Entry) error {
 	truncatedTitle, truncatedContent := truncateTitleAndContentForTSVectorField(entry.Title, entry.Content)
 	query := `
@@ -77,7 +77,9 @@ func (s *Storage) UpdateEntryTitleAndContent(entry *model.Entry) error {
 			title=$1,
 			content=$2,
 			reading_time=$3,
-			document_vectors = setweight(to_tsvector($4), 'A') || setweight(to_tsvector($5), 'B')
+			document_vectors = setweight(to_tsvector(search_configuration::regconfig, $4), 'A') || setweight(to_tsvector(search_configuration::regconfig, $5), 'B'),
+			summary=$8,
+			thumbnail_url=$9
 		WHERE
 			id=$6 AND user_id=$7
 	`
@@ -90,7 +92,9 @@ func (s *Storage) UpdateEntryTitleAndContent(entry *model.Entry) error {
 		truncatedTitle,
 		truncatedContent,
 		entry.ID,
-		entry.UserID); err != nil {
+		entry.UserID,
+		entry.Summary,
+		entry.ThumbnailURL); err != nil {
 		return fmt.Errorf(`store: unable to update entry #%d: %v`, entry.ID, err)
 	}
 
@@ -115,7 +119,14 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 				reading_time,
 				changed_at,
 				document_vectors,
-				tags
+				tags,
+				thumbnail_url,
+				language,
+				search_configuration,
+				summary,
+				llm_cache_key,
+				llm_summary,
+				llm_tags
 			)
 		VALUES
 			(
@@ -130,8 +141,15 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 				$9,
 				$10,
 				now(),
-				setweight(to_tsvector($11), 'A') || setweight(to_tsvector($12), 'B'),
-				$13
+				setweight(to_tsvector($16::regconfig, $11), 'A') || setweight(to_tsvector($16::regconfig, $12), 'B'),
+				$13,
+				$14,
+				$15,
+				$16,
+				$17,
+				$18,
+				$19,
+				COALESCE($20::text[], '{}')
 			)
 		RETURNING
 			id, status, created_at, changed_at
@@ -151,6 +169,13 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 		truncatedTitle,
 		truncatedContent,
 		pq.Array(entry.Tags),
+		entry.ThumbnailURL,
+		entry.Language,
+		textSearchConfiguration(entry.Language),
+		entry.Summary,
+		entry.LLMCacheKey,
+		entry.LLMSummary,
+		pq.Array(entry.LLMTags),
 	).Scan(
 		&entry.ID,
 		&entry.Status,
@@ -188,8 +213,15 @@ func (s *Storage) updateEntry(tx *sql.Tx, entry *model.Entry) error {
 			content=$4,
 			author=$5,
 			reading_time=$6,
-			document_vectors = setweight(to_tsvector($7), 'A') || setweight(to_tsvector($8), 'B'),
-			tags=$12
+			document_vectors = setweight(to_tsvector($15::regconfig, $7), 'A') || setweight(to_tsvector($15::regconfig, $8), 'B'),
+			tags=$12,
+			thumbnail_url=$13,
+			language=$14,
+			search_configuration=$15,
+			summary=$16,
+			llm_cache_key=CASE WHEN $17 = '' THEN llm_cache_key ELSE $17 END,
+			llm_summary=CASE WHEN $17 = '' THEN llm_summary ELSE $18 END,
+			llm_tags=CASE WHEN $17 = '' THEN llm_tags ELSE COALESCE($19::text[], '{}') END
 		WHERE
 			user_id=$9 AND feed_id=$10 AND hash=$11
 		RETURNING
@@ -209,6 +241,13 @@ func (s *Storage) updateEntry(tx *sql.Tx, entry *model.Entry) error {
 		entry.FeedID,
 		entry.Hash,
 		pq.Array(entry.Tags),
+		entry.ThumbnailURL,
+		entry.Language,
+		textSearchConfiguration(entry.Language),
+		entry.Summary,
+		entry.LLMCacheKey,
+		entry.LLMSummary,
+		pq.Array(entry.LLMTags),
 	).Scan(&entry.ID)
 	if err != nil {
 		return fmt.Errorf(`store: unable to update entry %q: %v`, entry.URL, err)
@@ -261,6 +300,50 @@ func (s *Storage) GetReadTime(feedID int64, entryHash string) int {
 	return result
 }
 
+// EntryLLMResult is the cached output of the language model for an entry.
+type EntryLLMResult struct {
+	CacheKey string
+	Summary  string
+	Tags     []string
+}
+
+// EntryLLMResults returns the cached output of the language model for the entries of the feed, indexed by entry hash.
+// Entries without cached output are not included.
+func (s *Storage) EntryLLMResults(feedID int64, entryHashes []string) (map[string]*EntryLLMResult, error) {
+	rows, err := s.db.Query(
+		`SELECT
+			hash,
+			llm_cache_key,
+			llm_summary,
+			llm_tags
+		FROM
+			entries
+		WHERE
+			feed_id=$1 AND
+			hash=ANY($2) AND
+			llm_cache_key <> ''
+		`,
+		feedID,
+		pq.Array(entryHashes),
+	)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch language model results: %v`, err)
+	}
+	defer rows.Close()
+
+	results := make(map[string]*EntryLLMResult)
+	for rows.Next() {
+		var hash string
+		var result EntryLLMResult
+		if err := rows.Scan(&hash, &result.CacheKey, &result.Summary, pq.Array(&result.Tags)); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch language model result row: %v`, err)
+		}
+		results[hash] = &result
+	}
+
+	return results, nil
+}
+
 // cleanupRemovedEntriesNotInFeed deletes from the database entries marked as "removed" and not visible anymore in the feed.
 func (s *Storage) cleanupRemovedEntriesNotInFeed(feedID int64, entryHashes []string) error {
 	query := `
@@ -310,6 +393,9 @@ func (s *Storage) ClearRemovedEntriesContent(limit int) (int64, error) {
 			url='',
 			author=NULL,
 			comments_url=NULL,
+			thumbnail_url='',
+			summary='',
+			llm_summary='',
 			document_vectors=NULL
 		WHERE id IN (
 			SELECT id
@@ -393,12 +479,13 @@ func (s *Storage) RefreshFeedEntries(userID, feedID int64, entries model.Entries
 }
 
 // ArchiveEntries changes the status of entries to "removed" after the interval (24h minimum).
+// Feeds with a retention policy are handled by ArchiveEntriesByRetentionPolicy.
 func (s *Storage) ArchiveEntries(status string, interval time.Duration, limit int) (int64, error) {
 	if interval < 0 || limit <= 0 {
 		return 0, nil
 	}
 
-	query := `
+	query := feedRetentionQuery + `
 		UPDATE
 			entries
 		SET
@@ -412,7 +499,10 @@ func (s *Storage) ArchiveEntries(status string, interval time.Duration, limit in
 				WHERE
 					status=$2 AND
 					starred is false AND
+					read_later is false AND
+					(snoozed_until IS NULL OR snoozed_until <= now()) AND
 					share_code='' AND
+					feed_id NOT IN (SELECT feed_id FROM feed_retention WHERE policy <> '') AND
 					created_at < now () - $3::interval
 				ORDER BY
 					created_at ASC LIMIT $4
@@ -442,6 +532,7 @@ func (s *Storage) SetEntriesStatus(userID int64, entryIDs []int64, status string
 			entries
 		SET
 			status=$1,
+			snoozed_until=NULL,
 			changed_at=now()
 		WHERE
 			user_id=$2 AND
@@ -519,6 +610,131 @@ func (s *Storage) ToggleStarred(userID int64, entryID int64) error {
 	return nil
 }
 
+// SetEntriesReadLaterState adds or removes the given entries from the read later queue.
+func (s *Storage) SetEntriesReadLaterState(userID int64, entryIDs []int64, readLater bool) error {
+	query := `UPDATE entries SET read_later=$1, changed_at=now() WHERE user_id=$2 AND id=ANY($3)`
+	result, err := s.db.Exec(query, readLater, userID, pq.Array(entryIDs))
+	if err != nil {
+		return fmt.Errorf(`store: unable to update the read later state %v: %v`, entryIDs, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to update these entries %v: %v`, entryIDs, err)
+	}
+
+	if count == 0 {
+		return errors.New(`store: nothing has been updated`)
+	}
+
+	return nil
+}
+
+// ToggleReadLater adds or removes the entry from the read later queue.
+func (s *Storage) ToggleReadLater(userID int64, entryID int64) error {
+	query := `UPDATE entries SET read_later = NOT read_later, changed_at=now() WHERE user_id=$1 AND id=$2`
+	result, err := s.db.Exec(query, userID, entryID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to toggle read later flag for entry #%d: %v`, entryID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to toggle read later flag for entry #%d: %v`, entryID, err)
+	}
+
+	if count == 0 {
+		return errors.New(`store: nothing has been updated`)
+	}
+
+	return nil
+}
+
+// SnoozeEntry hides the entry until the given time, the entry is marked as read in the meantime.
+func (s *Storage) SnoozeEntry(userID, entryID int64, snoozedUntil time.Time) error {
+	query := `
+		UPDATE
+			entries
+		SET
+			status=$1,
+			snoozed_until=$2,
+			changed_at=now()
+		WHERE
+			user_id=$3 AND id=$4 AND status!=$5
+	`
+	result, err := s.db.Exec(query, model.EntryStatusRead, snoozedUntil, userID, entryID, model.EntryStatusRemoved)
+	if err != nil {
+		return fmt.Errorf(`store: unable to snooze entry #%d: %v`, entryID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to snooze entry #%d: %v`, entryID, err)
+	}
+
+	if count == 0 {
+		return errors.New(`store: nothing has been updated`)
+	}
+
+	return nil
+}
+
+// UnsnoozeEntry cancels the snooze of an entry and marks it as unread.
+func (s *Storage) UnsnoozeEntry(userID, entryID int64) error {
+	query := `
+		UPDATE
+			entries
+		SET
+			status=$1,
+			snoozed_until=NULL,
+			changed_at=now()
+		WHERE
+			user_id=$2 AND id=$3 AND status=$4 AND snoozed_until > now()
+	`
+	result, err := s.db.Exec(query, model.EntryStatusUnread, userID, entryID, model.EntryStatusRead)
+	if err != nil {
+		return fmt.Errorf(`store: unable to unsnooze entry #%d: %v`, entryID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to unsnooze entry #%d: %v`, entryID, err)
+	}
+
+	if count == 0 {
+		return errors.New(`store: nothing has been updated`)
+	}
+
+	return nil
+}
+
+// WakeUpSnoozedEntries marks as unread the snoozed entries whose time has come.
+//
+// The snooze time is kept on the entry to show it at the top of the unread list,
+// it is cleared as soon as the entry status changes.
+func (s *Storage) WakeUpSnoozedEntries() (int64, error) {
+	query := `
+		UPDATE
+			entries
+		SET
+			status=$1,
+			changed_at=now()
+		WHERE
+			status=$2 AND snoozed_until <= now()
+	`
+	result, err := s.db.Exec(query, model.EntryStatusUnread, model.EntryStatusRead)
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to wake up snoozed entries: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to get the number of rows affected: %v`, err)
+	}
+
+	return count, nil
+}
+
 // FlushHistory changes all entries with the status "read" to "removed".
 func (s *Storage) FlushHistory(userID int64) error {
 	query := `
@@ -528,7 +744,7 @@ func (s *Storage) FlushHistory(userID int64) error {
 			status=$1,
 			changed_at=now()
 		WHERE
-			user_id=$2 AND status=$3 AND starred is false AND share_code=''
+			user_id=$2 AND status=$3 AND starred is false AND read_later is false AND (snoozed_until IS NULL OR snoozed_until <= now()) AND share_code=''
 	`
 	_, err := s.db.Exec(query, model.EntryStatusRemoved, userID, model.EntryStatusRead)
 	if err != nil {
@@ -540,7 +756,7 @@ func (s *Storage) FlushHistory(userID int64) error {
 
 // MarkAllAsRead updates all user entries to the read status.
 func (s *Storage) MarkAllAsRead(userID int64) error {
-	query := `UPDATE entries SET status=$1, changed_at=now() WHERE user_id=$2 AND status=$3`
+	query := `UPDATE entries SET status=$1, snoozed_until=NULL, changed_at=now() WHERE user_id=$2 AND status=$3`
 	result, err := s.db.Exec(query, model.EntryStatusRead, userID, model.EntryStatusUnread)
 	if err != nil {
 		return fmt.Errorf(`store: unable to mark all entries as read: %v`, err)
@@ -562,6 +778,7 @@ func (s *Storage) MarkAllAsReadBeforeDate(userID int64, before time.Time) error
 			entries
 		SET
 			status=$1,
+			snoozed_until=NULL,
 			changed_at=now()
 		WHERE
 			user_id=$2 AND status=$3 AND published_at < $4
@@ -586,6 +803,7 @@ func (s *Storage) MarkGloballyVisibleFeedsAsRead(userID int64) error {
 			entries
 		SET
 			status=$1,
+			snoozed_until=NULL,
 			changed_at=now()
 		FROM
 			feeds
@@ -616,6 +834,7 @@ func (s *Storage) MarkFeedAsRead(userID, feedID int64, before time.Time) error {
 			entries
 		SET
 			status=$1,
+			snoozed_until=NULL,
 			changed_at=now()
 		WHERE
 			user_id=$2 AND feed_id=$3 AND status=$4 AND published_at < $5
@@ -636,13 +855,14 @@ func (s *Storage) MarkFeedAsRead(userID, feedID int64, before time.Time) error {
 	return nil
 }
 
-// MarkCategoryAsRead updates all category entries to the read status.
+// MarkCategoryAsRead updates all entries of the category and its subcategories to the read status.
 func (s *Storage) MarkCategoryAsRead(userID, categoryID int64, before time.Time) error {
 	query := `
 		UPDATE
 			entries
 		SET
 			status=$1,
+			snoozed_until=NULL,
 			changed_at=now()
 		FROM
 			feeds
@@ -655,7 +875,7 @@ func (s *Storage) MarkCategoryAsRead(userID, categoryID int64, before time.Time)
 		AND
 			published_at < $4
 		AND
-			feeds.category_id=$5
+			` + categoryTreeCondition("feeds.category_id", 5) + `
 	`
 	result, err := s.db.Exec(query, model.EntryStatusRead, userID, model.EntryStatusUnread, before, categoryID)
 	if err != nil {
@@ -20,12 +20,14 @@ type EntryPaginationBuilder struct {
 	entryID    int64
 	order      string
 	direction  string
+
+	wokenUpEntriesFirst bool
 }
 
 // WithSearchQuery adds full-text search query to the condition.
 func (e *EntryPaginationBuilder) WithSearchQuery(query string) {
 	if query != "" {
-		e.conditions = append(e.conditions, fmt.Sprintf("e.document_vectors @@ plainto_tsquery($%d)", len(e.args)+1))
+		e.conditions = append(e.conditions, textSearchCondition(len(e.args)+1))
 		e.args = append(e.args, query)
 	}
 }
@@ -35,6 +37,11 @@ func (e *EntryPaginationBuilder) WithStarred() {
 	e.conditions = append(e.conditions, "e.starred is true")
 }
 
+// WithReadLater adds read later to the condition.
+func (e *EntryPaginationBuilder) WithReadLater() {
+	e.conditions = append(e.conditions, "e.read_later is true")
+}
+
 // WithFeedID adds feed_id to the condition.
 func (e *EntryPaginationBuilder) WithFeedID(feedID int64) {
 	if feedID != 0 {
@@ -43,14 +50,22 @@ func (e *EntryPaginationBuilder) WithFeedID(feedID int64) {
 	}
 }
 
-// WithCategoryID adds category_id to the condition.
+// WithCategoryID adds category_id and its subcategories to the condition.
 func (e *EntryPaginationBuilder) WithCategoryID(categoryID int64) {
 	if categoryID != 0 {
-		e.conditions = append(e.conditions, "f.category_id = $"+strconv.Itoa(len(e.args)+1))
+		e.conditions = append(e.conditions, categoryTreeCondition("f.category_id", len(e.args)+1))
 		e.args = append(e.args, categoryID)
 	}
 }
 
+// WithLabelID adds label_id to the condition.
+func (e *EntryPaginationBuilder) WithLabelID(labelID int64) {
+	if labelID != 0 {
+		e.conditions = append(e.conditions, "e.id IN (SELECT entry_id FROM entry_labels WHERE label_id = $"+strconv.Itoa(len(e.args)+1)+")")
+		e.args = append(e.args, labelID)
+	}
+}
+
 // WithStatus adds status to the condition.
 func (e *EntryPaginationBuilder) WithStatus(status string) {
 	if status != "" {
@@ -72,6 +87,12 @@ func (e *EntryPaginationBuilder) WithTags(tags []string) {
 func (e *EntryPaginationBuilder) WithGloballyVisible() {
 	e.conditions = append(e.conditions, "not c.hide_globally")
 	e.conditions = append(e.conditions, "not f.hide_globally")
+	e.conditions = append(e.conditions, "not (f.hide_entries_while_paused and f.paused_until > now())")
+}
+
+// WithWokenUpEntriesFirst puts the entries woken up from snooze before the others, like the unread list.
+func (e *EntryPaginationBuilder) WithWokenUpEntriesFirst() {
+	e.wokenUpEntriesFirst = true
 }
 
 // Entries returns previous and next entries.
@@ -113,20 +134,31 @@ func (e *EntryPaginationBuilder) getPrevNextID(tx *sql.Tx) (prevID int64, nextID
 		WITH entry_pagination AS (
 			SELECT
 				e.id,
-				lag(e.id) over (order by e.%[1]s asc, e.created_at asc, e.id desc) as prev_id,
-				lead(e.id) over (order by e.%[1]s asc, e.created_at asc, e.id desc) as next_id
+				lag(e.id) over (order by %[4]se.%[1]s asc, e.created_at asc, e.id desc) as prev_id,
+				lead(e.id) over (order by %[4]se.%[1]s asc, e.created_at asc, e.id desc) as next_id
 			FROM entries AS e
 			JOIN feeds AS f ON f.id=e.feed_id
 			JOIN categories c ON c.id = f.category_id
 			WHERE %[2]s
-			ORDER BY e.%[1]s asc, e.created_at asc, e.id desc
+			ORDER BY %[4]se.%[1]s asc, e.created_at asc, e.id desc
 		)
 		SELECT prev_id, next_id FROM entry_pagination AS ep WHERE %[3]s;
 	`
 
+	// The window is sorted in ascending order and reversed afterward for the descending direction,
+	// the woken up entries must end up first in both cases.
+	var wokenUpOrder string
+	if e.wokenUpEntriesFirst {
+		if e.direction == "desc" {
+			wokenUpOrder = "e.snoozed_until asc nulls first, "
+		} else {
+			wokenUpOrder = "e.snoozed_until desc nulls last, "
+		}
+	}
+
 	subCondition := strings.Join(e.conditions, " AND ")
 	finalCondition := "ep.id = $" + strconv.Itoa(len(e.args)+1)
-	query := fmt.Sprintf(cte, e.order, subCondition, finalCondition)
+	query := fmt.Sprintf(cte, e.order, subCondition, finalCondition, wokenUpOrder)
 	e.args = append(e.args, e.entryID)
 
 	var pID, nID sql.NullInt64
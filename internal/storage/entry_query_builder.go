@@ -25,6 +25,7 @@ type EntryQueryBuilder struct {
 	limit           int
 	offset          int
 	fetchEnclosures bool
+	fetchHighlights bool
 }
 
 // WithEnclosures fetches enclosures for each entry.
@@ -33,16 +34,22 @@ func (e *EntryQueryBuilder) WithEnclosures() *EntryQueryBuilder {
 	return e
 }
 
+// WithHighlights fetches highlights for each entry.
+func (e *EntryQueryBuilder) WithHighlights() *EntryQueryBuilder {
+	e.fetchHighlights = true
+	return e
+}
+
 // WithSearchQuery adds full-text search query to the condition.
 func (e *EntryQueryBuilder) WithSearchQuery(query string) *EntryQueryBuilder {
 	if query != "" {
 		nArgs := len(e.args) + 1
-		e.conditions = append(e.conditions, fmt.Sprintf("e.document_vectors @@ plainto_tsquery($%d)", nArgs))
+		e.conditions = append(e.conditions, textSearchCondition(nArgs))
 		e.args = append(e.args, query)
 
 		// 0.0000001 = 0.1 / (seconds_in_a_day)
 		e.WithSorting(
-			fmt.Sprintf("ts_rank(document_vectors, plainto_tsquery($%d)) - extract (epoch from now() - published_at)::float * 0.0000001", nArgs),
+			textSearchRanking(nArgs)+" - extract (epoch from now() - published_at)::float * 0.0000001",
 			"DESC",
 		)
 	}
@@ -59,6 +66,26 @@ func (e *EntryQueryBuilder) WithStarred(starred bool) *EntryQueryBuilder {
 	return e
 }
 
+// WithReadLater adds read later filter.
+func (e *EntryQueryBuilder) WithReadLater(readLater bool) *EntryQueryBuilder {
+	if readLater {
+		e.conditions = append(e.conditions, "e.read_later is true")
+	} else {
+		e.conditions = append(e.conditions, "e.read_later is false")
+	}
+	return e
+}
+
+// WithSnoozed adds snoozed filter, woken up entries are not considered snoozed.
+func (e *EntryQueryBuilder) WithSnoozed(snoozed bool) *EntryQueryBuilder {
+	if snoozed {
+		e.conditions = append(e.conditions, "e.snoozed_until > now()")
+	} else {
+		e.conditions = append(e.conditions, "(e.snoozed_until IS NULL OR e.snoozed_until <= now())")
+	}
+	return e
+}
+
 // BeforeChangedDate adds a condition < changed_at
 func (e *EntryQueryBuilder) BeforeChangedDate(date time.Time) *EntryQueryBuilder {
 	e.conditions = append(e.conditions, "e.changed_at < $"+strconv.Itoa(len(e.args)+1))
@@ -130,10 +157,10 @@ func (e *EntryQueryBuilder) WithFeedID(feedID int64) *EntryQueryBuilder {
 	return e
 }
 
-// WithCategoryID filter by category ID.
+// WithCategoryID filter by category ID, including the entries of the subcategories.
 func (e *EntryQueryBuilder) WithCategoryID(categoryID int64) *EntryQueryBuilder {
 	if categoryID > 0 {
-		e.conditions = append(e.conditions, "f.category_id = $"+strconv.Itoa(len(e.args)+1))
+		e.conditions = append(e.conditions, categoryTreeCondition("f.category_id", len(e.args)+1))
 		e.args = append(e.args, categoryID)
 	}
 	return e
@@ -168,6 +195,24 @@ func (e *EntryQueryBuilder) WithTags(tags []string) *EntryQueryBuilder {
 	return e
 }
 
+// WithLabelID filter by label ID.
+func (e *EntryQueryBuilder) WithLabelID(labelID int64) *EntryQueryBuilder {
+	if labelID > 0 {
+		e.conditions = append(e.conditions, "e.id IN (SELECT entry_id FROM entry_labels WHERE label_id = $"+strconv.Itoa(len(e.args)+1)+")")
+		e.args = append(e.args, labelID)
+	}
+	return e
+}
+
+// WithLanguage filter by entry language.
+func (e *EntryQueryBuilder) WithLanguage(language string) *EntryQueryBuilder {
+	if language != "" {
+		e.conditions = append(e.conditions, "e.language = $"+strconv.Itoa(len(e.args)+1))
+		e.args = append(e.args, language)
+	}
+	return e
+}
+
 // WithoutStatus set the entry status that should not be returned.
 func (e *EntryQueryBuilder) WithoutStatus(status string) *EntryQueryBuilder {
 	if status != "" {
@@ -196,6 +241,12 @@ func (e *EntryQueryBuilder) WithSorting(column, direction string) *EntryQueryBui
 	return e
 }
 
+// WithWokenUpEntriesFirst sorts the entries woken up from snooze before the others.
+// It must be called before the other sort expressions, EntryPaginationBuilder.WithWokenUpEntriesFirst uses the same order.
+func (e *EntryQueryBuilder) WithWokenUpEntriesFirst() *EntryQueryBuilder {
+	return e.WithSorting("e.snoozed_until", "DESC NULLS LAST")
+}
+
 // WithLimit set the limit.
 func (e *EntryQueryBuilder) WithLimit(limit int) *EntryQueryBuilder {
 	if limit > 0 {
@@ -215,6 +266,7 @@ func (e *EntryQueryBuilder) WithOffset(offset int) *EntryQueryBuilder {
 func (e *EntryQueryBuilder) WithGloballyVisible() *EntryQueryBuilder {
 	e.conditions = append(e.conditions, "c.hide_globally IS FALSE")
 	e.conditions = append(e.conditions, "f.hide_globally IS FALSE")
+	e.conditions = append(e.conditions, "NOT (f.hide_entries_while_paused IS TRUE AND f.paused_until > now())")
 	return e
 }
 
@@ -238,6 +290,7 @@ func (e *EntryQueryBuilder) CountEntries() (count int, err error) {
 // GetEntry returns a single entry that match the condition.
 func (e *EntryQueryBuilder) GetEntry() (*model.Entry, error) {
 	e.limit = 1
+	e.fetchHighlights = true
 	entries, err := e.GetEntries()
 	if err != nil {
 		return nil, err
@@ -272,10 +325,15 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			e.content,
 			e.status,
 			e.starred,
+			e.read_later,
+			e.snoozed_until,
 			e.reading_time,
 			e.created_at,
 			e.changed_at,
 			e.tags,
+			e.thumbnail_url,
+			e.language,
+			e.summary,
 			f.title as feed_title,
 			f.feed_url,
 			f.site_url,
@@ -322,6 +380,8 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 	for rows.Next() {
 		var iconID sql.NullInt64
 		var externalIconID sql.NullString
+		var snoozedUntil sql.NullTime
+		var crawler sql.NullBool
 		var tz string
 
 		entry := model.NewEntry()
@@ -340,10 +400,15 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			&entry.Content,
 			&entry.Status,
 			&entry.Starred,
+			&entry.ReadLater,
+			&snoozedUntil,
 			&entry.ReadingTime,
 			&entry.CreatedAt,
 			&entry.ChangedAt,
 			pq.Array(&entry.Tags),
+			&entry.ThumbnailURL,
+			&entry.Language,
+			&entry.Summary,
 			&entry.Feed.Title,
 			&entry.Feed.FeedURL,
 			&entry.Feed.SiteURL,
@@ -354,7 +419,7 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			&entry.Feed.Category.HideGlobally,
 			&entry.Feed.ScraperRules,
 			&entry.Feed.RewriteRules,
-			&entry.Feed.Crawler,
+			&crawler,
 			&entry.Feed.UserAgent,
 			&entry.Feed.Cookie,
 			&entry.Feed.HideGlobally,
@@ -383,6 +448,13 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 		entry.ChangedAt = timezone.Convert(tz, entry.ChangedAt)
 		entry.Feed.CheckedAt = timezone.Convert(tz, entry.Feed.CheckedAt)
 
+		if snoozedUntil.Valid {
+			snoozedUntil.Time = timezone.Convert(tz, snoozedUntil.Time)
+			entry.SnoozedUntil = &snoozedUntil.Time
+		}
+
+		entry.Feed.Crawler, entry.Feed.InheritCrawler = crawler.Bool, !crawler.Valid
+		entry.Feed.Cookie, _ = e.store.decryptSecret(entry.Feed.Cookie)
 		entry.Feed.ID = entry.FeedID
 		entry.Feed.UserID = entry.UserID
 		entry.Feed.Icon.FeedID = entry.FeedID
@@ -393,6 +465,32 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 		entryIDs = append(entryIDs, entry.ID)
 	}
 
+	if len(entryIDs) > 0 {
+		labels, err := e.store.LabelsForEntries(entryIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		for entryID, entryLabels := range labels {
+			if entry, exists := entryMap[entryID]; exists {
+				entry.Labels = entryLabels
+			}
+		}
+	}
+
+	if e.fetchHighlights && len(entryIDs) > 0 {
+		highlights, err := e.store.HighlightsForEntries(entryIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		for entryID, entryHighlights := range highlights {
+			if entry, exists := entryMap[entryID]; exists {
+				entry.Highlights = entryHighlights
+			}
+		}
+	}
+
 	if e.fetchEnclosures && len(entryIDs) > 0 {
 		enclosures, err := e.store.GetEnclosuresForEntries(entryIDs)
 		if err != nil {
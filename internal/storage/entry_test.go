@@ -95,3 +95,30 @@ func TestTruncateStringForTSVectorField(t *testing.T) {
 		t.Errorf("Invalid UTF-8 continuation bytes should return empty string, got %d bytes", len(result))
 	}
 }
+
+func TestTextSearchConfiguration(t *testing.T) {
+	scenarios := map[string]string{
+		"en": "english",
+		"fr": "french",
+		"nb": "norwegian",
+		"ja": "simple",
+		"":   "simple",
+	}
+
+	for language, expected := range scenarios {
+		if configuration := textSearchConfiguration(language); configuration != expected {
+			t.Errorf(`Unexpected configuration for %q, got %q instead of %q`, language, configuration, expected)
+		}
+	}
+}
+
+func TestTextSearchConditionCoversAllConfigurations(t *testing.T) {
+	condition := textSearchCondition(3)
+
+	for _, configuration := range availableTextSearchConfigurations() {
+		expected := "e.search_configuration = '" + configuration + "' AND e.document_vectors @@ plainto_tsquery('" + configuration + "', $3)"
+		if !strings.Contains(condition, expected) {
+			t.Errorf(`The condition %q does not handle the configuration %q`, condition, configuration)
+		}
+	}
+}
@@ -13,6 +13,8 @@ import (
 
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/model"
+
+	"github.com/lib/pq"
 )
 
 type byStateAndName struct{ f model.Feeds }
@@ -148,6 +150,14 @@ func (s *Storage) Feeds(userID int64) (model.Feeds, error) {
 	return builder.GetFeeds()
 }
 
+// FeedsByIDs returns the feeds of the user matching the given IDs.
+func (s *Storage) FeedsByIDs(userID int64, feedIDs []int64) (model.Feeds, error) {
+	builder := NewFeedQueryBuilder(s, userID)
+	builder.WithFeedIDs(feedIDs)
+	builder.WithSorting(model.DefaultFeedSorting, model.DefaultFeedSortingDirection)
+	return builder.GetFeeds()
+}
+
 func getFeedsSorted(builder *FeedQueryBuilder) (model.Feeds, error) {
 	result, err := builder.GetFeeds()
 	if err == nil {
@@ -230,8 +240,41 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 	return feed, nil
 }
 
+// feedSecrets lists the feed fields encrypted in the database.
+var feedSecrets = []struct {
+	column string
+	field  func(*model.Feed) *string
+}{
+	{"cookie", func(f *model.Feed) *string { return &f.Cookie }},
+	{"username", func(f *model.Feed) *string { return &f.Username }},
+	{"password", func(f *model.Feed) *string { return &f.Password }},
+	{"http_headers", func(f *model.Feed) *string { return &f.HTTPHeaders }},
+	{"client_certificate", func(f *model.Feed) *string { return &f.ClientCertificate }},
+	{"client_key", func(f *model.Feed) *string { return &f.ClientKey }},
+	{"proxy_url", func(f *model.Feed) *string { return &f.ProxyURL }},
+}
+
+// encryptFeedSecrets returns a copy of the feed with encrypted secrets.
+func (s *Storage) encryptFeedSecrets(feed *model.Feed) (*model.Feed, error) {
+	encryptedFeed := *feed
+	for _, secret := range feedSecrets {
+		field := secret.field(&encryptedFeed)
+		encryptedValue, err := s.encryptSecretField(secret.column, *field, feed.UndecryptableSecrets)
+		if err != nil {
+			return nil, err
+		}
+		*field = encryptedValue
+	}
+	return &encryptedFeed, nil
+}
+
 // CreateFeed creates a new feed.
 func (s *Storage) CreateFeed(feed *model.Feed) error {
+	encryptedFeed, err := s.encryptFeedSecrets(feed)
+	if err != nil {
+		return err
+	}
+
 	sql := `
 		INSERT INTO feeds (
 			feed_url,
@@ -263,14 +306,20 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 			webhook_url,
 			disable_http2,
 			description,
-			proxy_url
+			proxy_url,
+			llm_processing,
+			http_headers,
+			client_certificate,
+			client_key,
+			ca_certificates,
+			proxy_pool
 		)
 		VALUES
-			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30)
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34, $35, $36)
 		RETURNING
 			id
 	`
-	err := s.db.QueryRow(
+	err = s.db.QueryRow(
 		sql,
 		feed.FeedURL,
 		feed.SiteURL,
@@ -279,11 +328,11 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 		feed.UserID,
 		feed.EtagHeader,
 		feed.LastModifiedHeader,
-		feed.Crawler,
+		inheritableFlag(feed.Crawler, feed.InheritCrawler),
 		feed.UserAgent,
-		feed.Cookie,
-		feed.Username,
-		feed.Password,
+		encryptedFeed.Cookie,
+		encryptedFeed.Username,
+		encryptedFeed.Password,
 		feed.Disabled,
 		feed.ScraperRules,
 		feed.RewriteRules,
@@ -293,7 +342,7 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 		feed.KeepFilterEntryRules,
 		feed.IgnoreHTTPCache,
 		feed.AllowSelfSignedCertificates,
-		feed.FetchViaProxy,
+		inheritableFlag(feed.FetchViaProxy, feed.InheritFetchViaProxy),
 		feed.HideGlobally,
 		feed.UrlRewriteRules,
 		feed.NoMediaPlayer,
@@ -301,7 +350,13 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 		feed.WebhookURL,
 		feed.DisableHTTP2,
 		feed.Description,
-		feed.ProxyURL,
+		encryptedFeed.ProxyURL,
+		feed.LLMProcessing,
+		encryptedFeed.HTTPHeaders,
+		encryptedFeed.ClientCertificate,
+		encryptedFeed.ClientKey,
+		feed.CACertificates,
+		feed.ProxyPool,
 	).Scan(&feed.ID)
 	if err != nil {
 		return fmt.Errorf(`store: unable to create feed %q: %v`, feed.FeedURL, err)
@@ -341,54 +396,77 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 	return nil
 }
 
-// UpdateFeed updates an existing feed.
-func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
-	query := `
-		UPDATE
-			feeds
-		SET
-			feed_url=$1,
-			site_url=$2,
-			title=$3,
-			category_id=$4,
-			etag_header=$5,
-			last_modified_header=$6,
-			checked_at=$7,
-			parsing_error_msg=$8,
-			parsing_error_count=$9,
-			scraper_rules=$10,
-			rewrite_rules=$11,
-			blocklist_rules=$12,
-			keeplist_rules=$13,
-			block_filter_entry_rules=$14,
-			keep_filter_entry_rules=$15,
-			crawler=$16,
-			user_agent=$17,
-			cookie=$18,
-			username=$19,
-			password=$20,
-			disabled=$21,
-			next_check_at=$22,
-			ignore_http_cache=$23,
-			allow_self_signed_certificates=$24,
-			fetch_via_proxy=$25,
-			hide_globally=$26,
-			url_rewrite_rules=$27,
-			no_media_player=$28,
-			apprise_service_urls=$29,
-			webhook_url=$30,
-			disable_http2=$31,
-			description=$32,
-			ntfy_enabled=$33,
-			ntfy_priority=$34,
-			ntfy_topic=$35,
-			pushover_enabled=$36,
-			pushover_priority=$37,
-			proxy_url=$38
-		WHERE
-			id=$39 AND user_id=$40
-	`
-	_, err = s.db.Exec(query,
+const updateFeedQuery = `
+	UPDATE
+		feeds
+	SET
+		feed_url=$1,
+		site_url=$2,
+		title=$3,
+		category_id=$4,
+		etag_header=$5,
+		last_modified_header=$6,
+		checked_at=$7,
+		parsing_error_msg=$8,
+		parsing_error_count=$9,
+		scraper_rules=$10,
+		rewrite_rules=$11,
+		blocklist_rules=$12,
+		keeplist_rules=$13,
+		block_filter_entry_rules=$14,
+		keep_filter_entry_rules=$15,
+		crawler=$16,
+		user_agent=$17,
+		cookie=$18,
+		username=$19,
+		password=$20,
+		disabled=$21,
+		next_check_at=$22,
+		ignore_http_cache=$23,
+		allow_self_signed_certificates=$24,
+		fetch_via_proxy=$25,
+		hide_globally=$26,
+		url_rewrite_rules=$27,
+		no_media_player=$28,
+		apprise_service_urls=$29,
+		webhook_url=$30,
+		disable_http2=$31,
+		description=$32,
+		ntfy_enabled=$33,
+		ntfy_priority=$34,
+		ntfy_topic=$35,
+		pushover_enabled=$36,
+		pushover_priority=$37,
+		proxy_url=$38,
+		llm_processing=$39,
+		paused_until=$40,
+		hide_entries_while_paused=$41,
+		retention_policy=$42,
+		retention_value=$43,
+		http_headers=$44,
+		client_certificate=$45,
+		client_key=$46,
+		ca_certificates=$47,
+		proxy_pool=$48
+	WHERE
+		id=$49 AND user_id=$50
+`
+
+// inheritableFlag returns the stored value of a feed flag, NULL when the flag of the category is used.
+func inheritableFlag(value, inherited bool) *bool {
+	if inherited {
+		return nil
+	}
+	return &value
+}
+
+func (s *Storage) updateFeedArgs(feed *model.Feed) ([]any, error) {
+	encryptedFeed, err := s.encryptFeedSecrets(feed)
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{
 		feed.FeedURL,
 		feed.SiteURL,
 		feed.Title,
@@ -404,16 +482,16 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 		feed.KeeplistRules,
 		feed.BlockFilterEntryRules,
 		feed.KeepFilterEntryRules,
-		feed.Crawler,
+		inheritableFlag(feed.Crawler, feed.InheritCrawler),
 		feed.UserAgent,
-		feed.Cookie,
-		feed.Username,
-		feed.Password,
+		encryptedFeed.Cookie,
+		encryptedFeed.Username,
+		encryptedFeed.Password,
 		feed.Disabled,
 		feed.NextCheckAt,
 		feed.IgnoreHTTPCache,
 		feed.AllowSelfSignedCertificates,
-		feed.FetchViaProxy,
+		inheritableFlag(feed.FetchViaProxy, feed.InheritFetchViaProxy),
 		feed.HideGlobally,
 		feed.UrlRewriteRules,
 		feed.NoMediaPlayer,
@@ -421,23 +499,68 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 		feed.WebhookURL,
 		feed.DisableHTTP2,
 		feed.Description,
-		feed.NtfyEnabled,
+		inheritableFlag(feed.NtfyEnabled, feed.InheritNtfyEnabled),
 		feed.NtfyPriority,
 		feed.NtfyTopic,
-		feed.PushoverEnabled,
+		inheritableFlag(feed.PushoverEnabled, feed.InheritPushoverEnabled),
 		feed.PushoverPriority,
-		feed.ProxyURL,
+		encryptedFeed.ProxyURL,
+		feed.LLMProcessing,
+		feed.PausedUntil,
+		feed.HideEntriesWhilePaused,
+		feed.RetentionPolicy,
+		feed.RetentionValue,
+		encryptedFeed.HTTPHeaders,
+		encryptedFeed.ClientCertificate,
+		encryptedFeed.ClientKey,
+		feed.CACertificates,
+		feed.ProxyPool,
 		feed.ID,
 		feed.UserID,
-	)
+	}, nil
+}
 
+// UpdateFeed updates an existing feed.
+func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
+	args, err := s.updateFeedArgs(feed)
 	if err != nil {
+		return err
+	}
+
+	if _, err = s.db.Exec(updateFeedQuery, args...); err != nil {
 		return fmt.Errorf(`store: unable to update feed #%d (%s): %v`, feed.ID, feed.FeedURL, err)
 	}
 
 	return nil
 }
 
+// UpdateFeeds updates many feeds in a single transaction.
+func (s *Storage) UpdateFeeds(feeds model.Feeds) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	for _, feed := range feeds {
+		args, err := s.updateFeedArgs(feed)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if _, err := tx.Exec(updateFeedQuery, args...); err != nil {
+			tx.Rollback()
+			return fmt.Errorf(`store: unable to update feed #%d (%s): %v`, feed.ID, feed.FeedURL, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return nil
+}
+
 // UpdateFeedError updates feed errors.
 func (s *Storage) UpdateFeedError(feed *model.Feed) (err error) {
 	query := `
@@ -500,6 +623,30 @@ func (s *Storage) RemoveFeed(userID, feedID int64) error {
 	return nil
 }
 
+// RemoveFeeds removes many feeds and all their entries in a single transaction.
+func (s *Storage) RemoveFeeds(userID int64, feedIDs []int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	if _, err := tx.Exec(`DELETE FROM entries WHERE user_id=$1 AND feed_id = ANY($2)`, userID, pq.Array(feedIDs)); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to delete feed entries: %v`, err)
+	}
+
+	if _, err := tx.Exec(`DELETE FROM feeds WHERE user_id=$1 AND id = ANY($2)`, userID, pq.Array(feedIDs)); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to delete feeds: %v`, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return nil
+}
+
 // ResetFeedErrors removes all feed errors.
 func (s *Storage) ResetFeedErrors() error {
 	_, err := s.db.Exec(`UPDATE feeds SET parsing_error_count=0, parsing_error_msg=''`)
@@ -11,6 +11,8 @@ import (
 
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/timezone"
+
+	"github.com/lib/pq"
 )
 
 // FeedQueryBuilder builds a SQL query to fetch feeds.
@@ -59,6 +61,13 @@ func (f *FeedQueryBuilder) WithFeedID(feedID int64) *FeedQueryBuilder {
 	return f
 }
 
+// WithFeedIDs filter by feed IDs, an empty list matches no feed.
+func (f *FeedQueryBuilder) WithFeedIDs(feedIDs []int64) *FeedQueryBuilder {
+	f.conditions = append(f.conditions, "f.id = ANY($"+strconv.Itoa(len(f.args)+1)+")")
+	f.args = append(f.args, pq.Array(feedIDs))
+	return f
+}
+
 // WithCounters let the builder return feeds with counters of statuses of entries.
 func (f *FeedQueryBuilder) WithCounters() *FeedQueryBuilder {
 	f.withCounters = true
@@ -165,6 +174,25 @@ func (f *FeedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			f.category_id,
 			c.title as category_title,
 			c.hide_globally as category_hidden,
+			c.scraper_rules as category_scraper_rules,
+			c.rewrite_rules as category_rewrite_rules,
+			c.url_rewrite_rules as category_url_rewrite_rules,
+			c.blocklist_rules as category_blocklist_rules,
+			c.keeplist_rules as category_keeplist_rules,
+			c.block_filter_entry_rules as category_block_filter_entry_rules,
+			c.keep_filter_entry_rules as category_keep_filter_entry_rules,
+			c.user_agent as category_user_agent,
+			c.cookie as category_cookie,
+			c.proxy_url as category_proxy_url,
+			c.fetch_via_proxy as category_fetch_via_proxy,
+			c.crawler as category_crawler,
+			c.apprise_service_urls as category_apprise_service_urls,
+			c.webhook_url as category_webhook_url,
+			c.ntfy_enabled as category_ntfy_enabled,
+			c.ntfy_priority as category_ntfy_priority,
+			c.ntfy_topic as category_ntfy_topic,
+			c.pushover_enabled as category_pushover_enabled,
+			c.pushover_priority as category_pushover_priority,
 			fi.icon_id,
 			i.external_id,
 			u.timezone,
@@ -176,7 +204,17 @@ func (f *FeedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			f.ntfy_topic,
 			f.pushover_enabled,
 			f.pushover_priority,
-			f.proxy_url
+			f.proxy_url,
+			f.llm_processing,
+			f.paused_until,
+			f.hide_entries_while_paused,
+			f.retention_policy,
+			f.retention_value,
+			f.http_headers,
+			f.client_certificate,
+			f.client_key,
+			f.ca_certificates,
+			f.proxy_pool
 		FROM
 			feeds f
 		LEFT JOIN
@@ -210,6 +248,7 @@ func (f *FeedQueryBuilder) GetFeeds() (model.Feeds, error) {
 		var iconID sql.NullInt64
 		var externalIconID sql.NullString
 		var tz string
+		var crawler, fetchViaProxy, ntfyEnabled, pushoverEnabled sql.NullBool
 		feed.Category = &model.Category{}
 
 		err := rows.Scan(
@@ -232,38 +271,72 @@ func (f *FeedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			&feed.KeeplistRules,
 			&feed.BlockFilterEntryRules,
 			&feed.KeepFilterEntryRules,
-			&feed.Crawler,
+			&crawler,
 			&feed.UserAgent,
 			&feed.Cookie,
 			&feed.Username,
 			&feed.Password,
 			&feed.IgnoreHTTPCache,
 			&feed.AllowSelfSignedCertificates,
-			&feed.FetchViaProxy,
+			&fetchViaProxy,
 			&feed.Disabled,
 			&feed.NoMediaPlayer,
 			&feed.HideGlobally,
 			&feed.Category.ID,
 			&feed.Category.Title,
 			&feed.Category.HideGlobally,
+			&feed.Category.ScraperRules,
+			&feed.Category.RewriteRules,
+			&feed.Category.UrlRewriteRules,
+			&feed.Category.BlocklistRules,
+			&feed.Category.KeeplistRules,
+			&feed.Category.BlockFilterEntryRules,
+			&feed.Category.KeepFilterEntryRules,
+			&feed.Category.UserAgent,
+			&feed.Category.Cookie,
+			&feed.Category.ProxyURL,
+			&feed.Category.FetchViaProxy,
+			&feed.Category.Crawler,
+			&feed.Category.AppriseServiceURLs,
+			&feed.Category.WebhookURL,
+			&feed.Category.NtfyEnabled,
+			&feed.Category.NtfyPriority,
+			&feed.Category.NtfyTopic,
+			&feed.Category.PushoverEnabled,
+			&feed.Category.PushoverPriority,
 			&iconID,
 			&externalIconID,
 			&tz,
 			&feed.AppriseServiceURLs,
 			&feed.WebhookURL,
 			&feed.DisableHTTP2,
-			&feed.NtfyEnabled,
+			&ntfyEnabled,
 			&feed.NtfyPriority,
 			&feed.NtfyTopic,
-			&feed.PushoverEnabled,
+			&pushoverEnabled,
 			&feed.PushoverPriority,
 			&feed.ProxyURL,
+			&feed.LLMProcessing,
+			&feed.PausedUntil,
+			&feed.HideEntriesWhilePaused,
+			&feed.RetentionPolicy,
+			&feed.RetentionValue,
+			&feed.HTTPHeaders,
+			&feed.ClientCertificate,
+			&feed.ClientKey,
+			&feed.CACertificates,
+			&feed.ProxyPool,
 		)
 
 		if err != nil {
 			return nil, fmt.Errorf(`store: unable to fetch feeds row: %w`, err)
 		}
 
+		feed.Crawler, feed.InheritCrawler = crawler.Bool, !crawler.Valid
+		feed.FetchViaProxy, feed.InheritFetchViaProxy = fetchViaProxy.Bool, !fetchViaProxy.Valid
+		feed.NtfyEnabled, feed.InheritNtfyEnabled = ntfyEnabled.Bool, !ntfyEnabled.Valid
+		feed.PushoverEnabled, feed.InheritPushoverEnabled = pushoverEnabled.Bool, !pushoverEnabled.Valid
+
 		if iconID.Valid && externalIconID.Valid {
 			feed.Icon = &model.FeedIcon{FeedID: feed.ID, IconID: iconID.Int64, ExternalIconID: externalIconID.String}
 		} else {
@@ -281,9 +354,17 @@ func (f *FeedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			}
 		}
 
+		for _, secret := range feedSecrets {
+			f.store.decryptSecretField(secret.column, secret.field(&feed), &feed.UndecryptableSecrets)
+		}
+		f.store.decryptCategorySecrets(feed.Category)
 		feed.NumberOfVisibleEntries = feed.ReadCount + feed.UnreadCount
 		feed.CheckedAt = timezone.Convert(tz, feed.CheckedAt)
 		feed.NextCheckAt = timezone.Convert(tz, feed.NextCheckAt)
+		if feed.PausedUntil != nil {
+			pausedUntil := timezone.Convert(tz, *feed.PausedUntil)
+			feed.PausedUntil = &pausedUntil
+		}
 		feed.Category.UserID = feed.UserID
 		feeds = append(feeds, &feed)
 	}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/model"
+)
+
+func TestUpdateFeedQueryArguments(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ENCRYPTION_KEY", "secret")
+
+	var err error
+	if config.Opts, err = config.NewParser().ParseEnvironmentVariables(); err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	dataKey, err := crypto.GenerateDataKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := NewStorage(nil)
+	store.keyring = crypto.NewKeyring()
+	if err := store.keyring.AddDataKey(1, dataKey, true); err != nil {
+		t.Fatal(err)
+	}
+
+	feed := &model.Feed{Category: &model.Category{}, HTTPHeaders: "X-Api-Key: abc", ClientKey: "key", Username: "user", Password: "password", Cookie: "session=abc"}
+	args, err := store.updateFeedArgs(feed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	placeholders := regexp.MustCompile(`\$\d+`).FindAllString(updateFeedQuery, -1)
+	if len(placeholders) != len(args) {
+		t.Fatalf(`The query has %d placeholders but %d arguments`, len(placeholders), len(args))
+	}
+
+	for _, arg := range args {
+		switch arg {
+		case "X-Api-Key: abc", "key", "user", "password", "session=abc":
+			t.Errorf(`Secrets should be encrypted, got %q`, arg)
+		}
+	}
+
+	if feed.Password != "password" {
+		t.Errorf(`The feed should not be modified, got %q`, feed.Password)
+	}
+}
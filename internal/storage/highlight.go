@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"miniflux.app/v2/internal/model"
+)
+
+// Highlight returns a highlight of the given user.
+func (s *Storage) Highlight(userID, highlightID int64) (*model.Highlight, error) {
+	query := `
+		SELECT
+			id, user_id, entry_id, quote, note, start_offset, end_offset, created_at, changed_at
+		FROM
+			entry_highlights
+		WHERE
+			user_id=$1 AND id=$2
+	`
+
+	var highlight model.Highlight
+	err := s.db.QueryRow(query, userID, highlightID).Scan(
+		&highlight.ID,
+		&highlight.UserID,
+		&highlight.EntryID,
+		&highlight.Quote,
+		&highlight.Note,
+		&highlight.StartOffset,
+		&highlight.EndOffset,
+		&highlight.CreatedAt,
+		&highlight.ChangedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch highlight: %v`, err)
+	default:
+		return &highlight, nil
+	}
+}
+
+// HighlightsForEntries returns the highlights of the given entries, indexed by entry ID.
+func (s *Storage) HighlightsForEntries(entryIDs []int64) (map[int64]model.Highlights, error) {
+	query := `
+		SELECT
+			id, user_id, entry_id, quote, note, start_offset, end_offset, created_at, changed_at
+		FROM
+			entry_highlights
+		WHERE
+			entry_id = ANY($1)
+		ORDER BY
+			start_offset ASC, id ASC
+	`
+
+	rows, err := s.db.Query(query, pq.Array(entryIDs))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch highlights: %v`, err)
+	}
+	defer rows.Close()
+
+	highlightsByEntryID := make(map[int64]model.Highlights)
+	for rows.Next() {
+		var highlight model.Highlight
+		if err := rows.Scan(
+			&highlight.ID,
+			&highlight.UserID,
+			&highlight.EntryID,
+			&highlight.Quote,
+			&highlight.Note,
+			&highlight.StartOffset,
+			&highlight.EndOffset,
+			&highlight.CreatedAt,
+			&highlight.ChangedAt,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch highlight row: %v`, err)
+		}
+
+		highlightsByEntryID[highlight.EntryID] = append(highlightsByEntryID[highlight.EntryID], &highlight)
+	}
+
+	return highlightsByEntryID, nil
+}
+
+// Highlights returns the highlights of the user with their entry, the most recent first.
+func (s *Storage) Highlights(userID int64, offset, limit int) (model.Highlights, error) {
+	query := `
+		SELECT
+			h.id,
+			h.user_id,
+			h.entry_id,
+			h.quote,
+			h.note,
+			h.start_offset,
+			h.end_offset,
+			h.created_at,
+			h.changed_at,
+			e.title,
+			e.url,
+			e.feed_id,
+			f.title
+		FROM
+			entry_highlights h
+		JOIN
+			entries e ON e.id=h.entry_id
+		JOIN
+			feeds f ON f.id=e.feed_id
+		WHERE
+			h.user_id=$1
+		ORDER BY
+			h.created_at DESC, h.id DESC
+		OFFSET $2
+		LIMIT $3
+	`
+
+	rows, err := s.db.Query(query, userID, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch highlights: %v`, err)
+	}
+	defer rows.Close()
+
+	highlights := make(model.Highlights, 0)
+	for rows.Next() {
+		highlight := model.Highlight{Entry: model.NewEntry()}
+		if err := rows.Scan(
+			&highlight.ID,
+			&highlight.UserID,
+			&highlight.EntryID,
+			&highlight.Quote,
+			&highlight.Note,
+			&highlight.StartOffset,
+			&highlight.EndOffset,
+			&highlight.CreatedAt,
+			&highlight.ChangedAt,
+			&highlight.Entry.Title,
+			&highlight.Entry.URL,
+			&highlight.Entry.FeedID,
+			&highlight.Entry.Feed.Title,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch highlight row: %v`, err)
+		}
+
+		highlight.Entry.ID = highlight.EntryID
+		highlight.Entry.UserID = highlight.UserID
+		highlight.Entry.Feed.ID = highlight.Entry.FeedID
+		highlights = append(highlights, &highlight)
+	}
+
+	return highlights, nil
+}
+
+// CountHighlights returns the number of highlights of the user.
+func (s *Storage) CountHighlights(userID int64) int {
+	var result int
+	s.db.QueryRow(`SELECT count(*) FROM entry_highlights WHERE user_id=$1`, userID).Scan(&result)
+	return result
+}
+
+// CreateHighlight saves a new highlight on an entry of the user.
+func (s *Storage) CreateHighlight(userID, entryID int64, request *model.HighlightCreationRequest) (*model.Highlight, error) {
+	query := `
+		INSERT INTO entry_highlights
+			(user_id, entry_id, quote, note, start_offset, end_offset)
+		SELECT
+			$1, id, $3, $4, $5, $6
+		FROM
+			entries
+		WHERE
+			id=$2 AND user_id=$1
+		RETURNING
+			id, user_id, entry_id, quote, note, start_offset, end_offset, created_at, changed_at
+	`
+
+	var highlight model.Highlight
+	err := s.db.QueryRow(
+		query,
+		userID,
+		entryID,
+		request.Quote,
+		request.Note,
+		request.StartOffset,
+		request.EndOffset,
+	).Scan(
+		&highlight.ID,
+		&highlight.UserID,
+		&highlight.EntryID,
+		&highlight.Quote,
+		&highlight.Note,
+		&highlight.StartOffset,
+		&highlight.EndOffset,
+		&highlight.CreatedAt,
+		&highlight.ChangedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to create highlight for entry #%d: %v`, entryID, err)
+	}
+
+	return &highlight, nil
+}
+
+// UpdateHighlight updates the note of a highlight.
+func (s *Storage) UpdateHighlight(highlight *model.Highlight) error {
+	query := `
+		UPDATE entry_highlights SET
+			note=$1,
+			changed_at=now()
+		WHERE
+			id=$2 AND user_id=$3
+		RETURNING
+			changed_at
+	`
+	if err := s.db.QueryRow(query, highlight.Note, highlight.ID, highlight.UserID).Scan(&highlight.ChangedAt); err != nil {
+		return fmt.Errorf(`store: unable to update highlight #%d: %v`, highlight.ID, err)
+	}
+
+	return nil
+}
+
+// RemoveHighlight deletes a highlight.
+func (s *Storage) RemoveHighlight(userID, highlightID int64) error {
+	result, err := s.db.Exec(`DELETE FROM entry_highlights WHERE id=$1 AND user_id=$2`, highlightID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove highlight #%d: %v`, highlightID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove highlight #%d: %v`, highlightID, err)
+	}
+
+	if count == 0 {
+		return errors.New(`store: no highlight has been removed`)
+	}
+
+	return nil
+}
@@ -11,6 +11,44 @@ import (
 	"miniflux.app/v2/internal/model"
 )
 
+// integrationSecrets lists the integration fields encrypted in the database.
+// The Fever token and the Google Reader password are not listed: they are hashes used for authentication.
+var integrationSecrets = []struct {
+	column string
+	field  func(*model.Integration) *string
+}{
+	{"apprise_services_url", func(i *model.Integration) *string { return &i.AppriseServicesURL }},
+	{"betula_token", func(i *model.Integration) *string { return &i.BetulaToken }},
+	{"discord_webhook_link", func(i *model.Integration) *string { return &i.DiscordWebhookLink }},
+	{"espial_api_key", func(i *model.Integration) *string { return &i.EspialAPIKey }},
+	{"instapaper_password", func(i *model.Integration) *string { return &i.InstapaperPassword }},
+	{"karakeep_api_key", func(i *model.Integration) *string { return &i.KarakeepAPIKey }},
+	{"linkace_api_key", func(i *model.Integration) *string { return &i.LinkAceAPIKey }},
+	{"linkding_api_key", func(i *model.Integration) *string { return &i.LinkdingAPIKey }},
+	{"linktaco_api_token", func(i *model.Integration) *string { return &i.LinktacoAPIToken }},
+	{"linkwarden_api_key", func(i *model.Integration) *string { return &i.LinkwardenAPIKey }},
+	{"llm_api_key", func(i *model.Integration) *string { return &i.LLMAPIKey }},
+	{"matrix_bot_password", func(i *model.Integration) *string { return &i.MatrixBotPassword }},
+	{"notion_token", func(i *model.Integration) *string { return &i.NotionToken }},
+	{"ntfy_api_token", func(i *model.Integration) *string { return &i.NtfyAPIToken }},
+	{"ntfy_password", func(i *model.Integration) *string { return &i.NtfyPassword }},
+	{"nunux_keeper_api_key", func(i *model.Integration) *string { return &i.NunuxKeeperAPIKey }},
+	{"omnivore_api_key", func(i *model.Integration) *string { return &i.OmnivoreAPIKey }},
+	{"pinboard_token", func(i *model.Integration) *string { return &i.PinboardToken }},
+	{"pushover_token", func(i *model.Integration) *string { return &i.PushoverToken }},
+	{"raindrop_token", func(i *model.Integration) *string { return &i.RaindropToken }},
+	{"readeck_api_key", func(i *model.Integration) *string { return &i.ReadeckAPIKey }},
+	{"readwise_api_key", func(i *model.Integration) *string { return &i.ReadwiseAPIKey }},
+	{"rssbridge_token", func(i *model.Integration) *string { return &i.RSSBridgeToken }},
+	{"shaarli_api_secret", func(i *model.Integration) *string { return &i.ShaarliAPISecret }},
+	{"shiori_password", func(i *model.Integration) *string { return &i.ShioriPassword }},
+	{"slack_webhook_link", func(i *model.Integration) *string { return &i.SlackWebhookLink }},
+	{"telegram_bot_token", func(i *model.Integration) *string { return &i.TelegramBotToken }},
+	{"wallabag_client_secret", func(i *model.Integration) *string { return &i.WallabagClientSecret }},
+	{"wallabag_password", func(i *model.Integration) *string { return &i.WallabagPassword }},
+	{"webhook_secret", func(i *model.Integration) *string { return &i.WebhookSecret }},
+}
+
 // HasDuplicateFeverUsername checks if another user have the same Fever username.
 func (s *Storage) HasDuplicateFeverUsername(userID int64, feverUsername string) bool {
 	query := `SELECT true FROM integrations WHERE user_id != $1 AND fever_username=$2 LIMIT 1`
@@ -225,7 +263,13 @@ func (s *Storage) Integration(userID int64) (*model.Integration, error) {
 			linktaco_api_token,
 			linktaco_org_slug,
 			linktaco_tags,
-			linktaco_visibility
+			linktaco_visibility,
+			llm_enabled,
+			llm_url,
+			llm_api_key,
+			llm_model,
+			llm_summarize,
+			llm_tagging
 		FROM
 			integrations
 		WHERE
@@ -350,19 +394,34 @@ func (s *Storage) Integration(userID int64) (*model.Integration, error) {
 		&integration.LinktacoOrgSlug,
 		&integration.LinktacoTags,
 		&integration.LinktacoVisibility,
+		&integration.LLMEnabled,
+		&integration.LLMURL,
+		&integration.LLMAPIKey,
+		&integration.LLMModel,
+		&integration.LLMSummarize,
+		&integration.LLMTagging,
 	)
 	switch {
 	case err == sql.ErrNoRows:
 		return &integration, nil
 	case err != nil:
 		return &integration, fmt.Errorf(`store: unable to fetch integration row: %v`, err)
-	default:
-		return &integration, nil
 	}
+
+	for _, secret := range integrationSecrets {
+		s.decryptSecretField(secret.column, secret.field(&integration), &integration.UndecryptableSecrets)
+	}
+
+	return &integration, nil
 }
 
 // UpdateIntegration saves user integration settings.
 func (s *Storage) UpdateIntegration(integration *model.Integration) error {
+	integration, err := s.encryptIntegrationSecrets(integration)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		UPDATE
 			integrations
@@ -482,11 +541,17 @@ func (s *Storage) UpdateIntegration(integration *model.Integration) error {
 			linktaco_api_token=$113,
 			linktaco_org_slug=$114,
 			linktaco_tags=$115,
-			linktaco_visibility=$116
+			linktaco_visibility=$116,
+			llm_enabled=$117,
+			llm_url=$118,
+			llm_api_key=$119,
+			llm_model=$120,
+			llm_summarize=$121,
+			llm_tagging=$122
 		WHERE
-			user_id=$117
+			user_id=$123
 	`
-	_, err := s.db.Exec(
+	_, err = s.db.Exec(
 		query,
 		integration.PinboardEnabled,
 		integration.PinboardToken,
@@ -604,6 +669,12 @@ func (s *Storage) UpdateIntegration(integration *model.Integration) error {
 		integration.LinktacoOrgSlug,
 		integration.LinktacoTags,
 		integration.LinktacoVisibility,
+		integration.LLMEnabled,
+		integration.LLMURL,
+		integration.LLMAPIKey,
+		integration.LLMModel,
+		integration.LLMSummarize,
+		integration.LLMTagging,
 		integration.UserID,
 	)
 
@@ -614,6 +685,20 @@ func (s *Storage) UpdateIntegration(integration *model.Integration) error {
 	return nil
 }
 
+// encryptIntegrationSecrets returns a copy of the integration with encrypted secrets.
+func (s *Storage) encryptIntegrationSecrets(integration *model.Integration) (*model.Integration, error) {
+	encryptedIntegration := *integration
+	for _, secret := range integrationSecrets {
+		field := secret.field(&encryptedIntegration)
+		encryptedValue, err := s.encryptSecretField(secret.column, *field, integration.UndecryptableSecrets)
+		if err != nil {
+			return nil, err
+		}
+		*field = encryptedValue
+	}
+	return &encryptedIntegration, nil
+}
+
 // HasSaveEntry returns true if the given user can save articles to third-parties.
 func (s *Storage) HasSaveEntry(userID int64) (result bool) {
 	query := `
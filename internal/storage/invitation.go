@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/model"
+
+	"github.com/lib/pq"
+)
+
+var (
+	ErrInvitationNotFound = errors.New("store: invitation not found")
+	ErrInvitationUnusable = errors.New("store: invitation expired or already used")
+)
+
+const invitationColumns = `id, description, language, categories, max_uses, use_count, expires_at, created_at`
+
+func invitationFields(invitation *model.Invitation) []any {
+	return []any{
+		&invitation.ID,
+		&invitation.Description,
+		&invitation.Language,
+		pq.Array(&invitation.Categories),
+		&invitation.MaxUses,
+		&invitation.UseCount,
+		&invitation.ExpiresAt,
+		&invitation.CreatedAt,
+	}
+}
+
+// Invitation returns the invitation with the given ID.
+func (s *Storage) Invitation(invitationID int64) (*model.Invitation, error) {
+	return s.invitationBy(`id=$1`, invitationID)
+}
+
+// InvitationByToken returns the invitation matching the given token, expired and exhausted invitations included.
+func (s *Storage) InvitationByToken(token string) (*model.Invitation, error) {
+	return s.invitationBy(`token_hash=$1`, crypto.SHA256(token))
+}
+
+func (s *Storage) invitationBy(condition string, args ...any) (*model.Invitation, error) {
+	query := `SELECT ` + invitationColumns + ` FROM invitations WHERE ` + condition
+
+	var invitation model.Invitation
+	err := s.db.QueryRow(query, args...).Scan(invitationFields(&invitation)...)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch invitation: %v`, err)
+	}
+
+	return &invitation, nil
+}
+
+// Invitations returns all the invitations, the most recent first.
+func (s *Storage) Invitations() (model.Invitations, error) {
+	query := `SELECT ` + invitationColumns + ` FROM invitations ORDER BY created_at DESC, id DESC`
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch invitations: %v`, err)
+	}
+	defer rows.Close()
+
+	invitations := make(model.Invitations, 0)
+	for rows.Next() {
+		var invitation model.Invitation
+		if err := rows.Scan(invitationFields(&invitation)...); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch invitation row: %v`, err)
+		}
+
+		invitations = append(invitations, &invitation)
+	}
+
+	return invitations, nil
+}
+
+// CreateInvitation inserts a new invitation, the generated token is only returned by this function.
+func (s *Storage) CreateInvitation(request *model.InvitationCreationRequest) (*model.Invitation, error) {
+	token := crypto.GenerateRandomStringHex(32)
+
+	query := `
+		INSERT INTO invitations
+			(token_hash, description, language, categories, max_uses, expires_at)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+		RETURNING
+			` + invitationColumns
+
+	invitation := model.Invitation{Token: token}
+	err := s.db.QueryRow(
+		query,
+		crypto.SHA256(token),
+		request.Description,
+		request.Language,
+		pq.Array(request.Categories),
+		request.MaxUses,
+		request.ExpiresAt,
+	).Scan(invitationFields(&invitation)...)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to create invitation: %v`, err)
+	}
+
+	return &invitation, nil
+}
+
+// DeleteInvitation deletes an invitation, the accounts created with it are kept.
+func (s *Storage) DeleteInvitation(invitationID int64) error {
+	result, err := s.db.Exec(`DELETE FROM invitations WHERE id=$1`, invitationID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to delete invitation: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to delete invitation: %v`, err)
+	}
+
+	if count == 0 {
+		return ErrInvitationNotFound
+	}
+
+	return nil
+}
+
+// CreateUserWithInvitation creates a user with the settings of the invitation and counts the use of the invitation.
+// The invitation is locked during the transaction so concurrent registrations cannot exceed its usage limit.
+func (s *Storage) CreateUserWithInvitation(invitationID int64, userCreationRequest *model.UserCreationRequest) (*model.User, error) {
+	hashedPassword, err := crypto.HashPassword(userCreationRequest.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	var invitation model.Invitation
+	err = tx.QueryRow(`SELECT `+invitationColumns+` FROM invitations WHERE id=$1 FOR UPDATE`, invitationID).Scan(invitationFields(&invitation)...)
+	switch {
+	case err == sql.ErrNoRows:
+		tx.Rollback()
+		return nil, ErrInvitationNotFound
+	case err != nil:
+		tx.Rollback()
+		return nil, fmt.Errorf(`store: unable to fetch invitation: %v`, err)
+	case !invitation.IsUsable():
+		tx.Rollback()
+		return nil, ErrInvitationUnusable
+	}
+
+	user, err := createUser(tx, userCreationRequest, hashedPassword)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if invitation.Language != "" {
+		if _, err := tx.Exec(`UPDATE users SET language=$1 WHERE id=$2`, invitation.Language, user.ID); err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf(`store: unable to set user language: %v`, err)
+		}
+		user.Language = invitation.Language
+	}
+
+	for _, title := range invitation.Categories {
+		_, err := tx.Exec(`INSERT INTO categories (user_id, title) VALUES ($1, $2) ON CONFLICT DO NOTHING`, user.ID, title)
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf(`store: unable to create category %q for user ID %d: %v`, title, user.ID, err)
+		}
+	}
+
+	if _, err := tx.Exec(`UPDATE invitations SET use_count=use_count+1 WHERE id=$1`, invitationID); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf(`store: unable to update invitation: %v`, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return user, nil
+}
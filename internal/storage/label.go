@@ -0,0 +1,292 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"miniflux.app/v2/internal/model"
+)
+
+// LabelTitleExists checks if the given label exists into the database.
+func (s *Storage) LabelTitleExists(userID int64, title string) bool {
+	var result bool
+	query := `SELECT true FROM labels WHERE user_id=$1 AND lower(title)=lower($2) LIMIT 1`
+	s.db.QueryRow(query, userID, title).Scan(&result)
+	return result
+}
+
+// AnotherLabelExists checks if another label exists with the same title.
+func (s *Storage) AnotherLabelExists(userID, labelID int64, title string) bool {
+	var result bool
+	query := `SELECT true FROM labels WHERE user_id=$1 AND id != $2 AND lower(title)=lower($3) LIMIT 1`
+	s.db.QueryRow(query, userID, labelID, title).Scan(&result)
+	return result
+}
+
+// LabelIDExists checks if the given label exists into the database.
+func (s *Storage) LabelIDExists(userID, labelID int64) bool {
+	var result bool
+	query := `SELECT true FROM labels WHERE user_id=$1 AND id=$2 LIMIT 1`
+	s.db.QueryRow(query, userID, labelID).Scan(&result)
+	return result
+}
+
+// Label returns a label from the database.
+func (s *Storage) Label(userID, labelID int64) (*model.Label, error) {
+	var label model.Label
+
+	query := `SELECT id, user_id, title FROM labels WHERE user_id=$1 AND id=$2`
+	err := s.db.QueryRow(query, userID, labelID).Scan(&label.ID, &label.UserID, &label.Title)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch label: %v`, err)
+	default:
+		return &label, nil
+	}
+}
+
+// LabelByTitle finds a label by the title.
+func (s *Storage) LabelByTitle(userID int64, title string) (*model.Label, error) {
+	var label model.Label
+
+	query := `SELECT id, user_id, title FROM labels WHERE user_id=$1 AND lower(title)=lower($2)`
+	err := s.db.QueryRow(query, userID, title).Scan(&label.ID, &label.UserID, &label.Title)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch label: %v`, err)
+	default:
+		return &label, nil
+	}
+}
+
+// Labels returns all labels that belongs to the given user.
+func (s *Storage) Labels(userID int64) (model.Labels, error) {
+	query := `SELECT id, user_id, title FROM labels WHERE user_id=$1 ORDER BY lower(title) ASC`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch labels: %v`, err)
+	}
+	defer rows.Close()
+
+	labels := make(model.Labels, 0)
+	for rows.Next() {
+		var label model.Label
+		if err := rows.Scan(&label.ID, &label.UserID, &label.Title); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch label row: %v`, err)
+		}
+
+		labels = append(labels, label)
+	}
+
+	return labels, nil
+}
+
+// LabelsWithEntryCount returns all labels with the number of entries and unread entries.
+func (s *Storage) LabelsWithEntryCount(userID int64) (model.Labels, error) {
+	query := `
+		SELECT
+			l.id,
+			l.user_id,
+			l.title,
+			count(e.id) AS entry_count,
+			count(e.id) FILTER (WHERE e.status=$1) AS unread_count
+		FROM
+			labels l
+		LEFT JOIN
+			entry_labels el ON el.label_id=l.id
+		LEFT JOIN
+			entries e ON e.id=el.entry_id AND e.status != $2
+		WHERE
+			l.user_id=$3
+		GROUP BY
+			l.id
+		ORDER BY
+			lower(l.title) ASC
+	`
+
+	rows, err := s.db.Query(query, model.EntryStatusUnread, model.EntryStatusRemoved, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch labels: %v`, err)
+	}
+	defer rows.Close()
+
+	labels := make(model.Labels, 0)
+	for rows.Next() {
+		var label model.Label
+		if err := rows.Scan(&label.ID, &label.UserID, &label.Title, &label.EntryCount, &label.UnreadCount); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch label row: %v`, err)
+		}
+
+		labels = append(labels, label)
+	}
+
+	return labels, nil
+}
+
+// CreateLabel creates a new label.
+func (s *Storage) CreateLabel(userID int64, request *model.LabelCreationRequest) (*model.Label, error) {
+	var label model.Label
+
+	query := `
+		INSERT INTO labels
+			(user_id, title)
+		VALUES
+			($1, $2)
+		RETURNING
+			id,
+			user_id,
+			title
+	`
+	err := s.db.QueryRow(query, userID, request.Title).Scan(&label.ID, &label.UserID, &label.Title)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to create label %q for user ID %d: %v`, request.Title, userID, err)
+	}
+
+	return &label, nil
+}
+
+// UpdateLabel updates an existing label.
+func (s *Storage) UpdateLabel(label *model.Label) error {
+	query := `UPDATE labels SET title=$1 WHERE id=$2 AND user_id=$3`
+	if _, err := s.db.Exec(query, label.Title, label.ID, label.UserID); err != nil {
+		return fmt.Errorf(`store: unable to update label: %v`, err)
+	}
+
+	return nil
+}
+
+// RemoveLabel deletes a label, the entries are kept.
+func (s *Storage) RemoveLabel(userID, labelID int64) error {
+	query := `DELETE FROM labels WHERE id = $1 AND user_id = $2`
+	result, err := s.db.Exec(query, labelID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this label: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this label: %v`, err)
+	}
+
+	if count == 0 {
+		return errors.New(`store: no label has been removed`)
+	}
+
+	return nil
+}
+
+// LabelsForEntries returns the labels attached to the given entries, indexed by entry ID.
+func (s *Storage) LabelsForEntries(entryIDs []int64) (map[int64]model.Labels, error) {
+	query := `
+		SELECT
+			el.entry_id,
+			l.id,
+			l.user_id,
+			l.title
+		FROM
+			entry_labels el
+		JOIN
+			labels l ON l.id=el.label_id
+		WHERE
+			el.entry_id = ANY($1)
+		ORDER BY
+			lower(l.title) ASC
+	`
+
+	rows, err := s.db.Query(query, pq.Array(entryIDs))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch entry labels: %v`, err)
+	}
+	defer rows.Close()
+
+	labelsByEntryID := make(map[int64]model.Labels)
+	for rows.Next() {
+		var entryID int64
+		var label model.Label
+		if err := rows.Scan(&entryID, &label.ID, &label.UserID, &label.Title); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch entry label row: %v`, err)
+		}
+
+		labelsByEntryID[entryID] = append(labelsByEntryID[entryID], label)
+	}
+
+	return labelsByEntryID, nil
+}
+
+// SetEntryLabels replaces the labels attached to an entry.
+func (s *Storage) SetEntryLabels(userID, entryID int64, labelIDs []int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	query := `
+		DELETE FROM entry_labels
+		WHERE
+			entry_id=$1 AND
+			label_id IN (SELECT id FROM labels WHERE user_id=$2) AND
+			NOT (label_id=ANY($3))
+	`
+	if _, err := tx.Exec(query, entryID, userID, pq.Array(labelIDs)); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to remove entry labels: %v`, err)
+	}
+
+	query = `
+		INSERT INTO entry_labels (entry_id, label_id)
+		SELECT e.id, l.id FROM entries e, labels l
+		WHERE e.id=$1 AND e.user_id=$2 AND l.user_id=$2 AND l.id=ANY($3)
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := tx.Exec(query, entryID, userID, pq.Array(labelIDs)); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to add entry labels: %v`, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return nil
+}
+
+// AddLabelToEntries attaches a label to the given entries.
+func (s *Storage) AddLabelToEntries(userID, labelID int64, entryIDs []int64) error {
+	query := `
+		INSERT INTO entry_labels (entry_id, label_id)
+		SELECT e.id, l.id FROM entries e, labels l
+		WHERE e.id=ANY($1) AND e.user_id=$2 AND l.user_id=$2 AND l.id=$3
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := s.db.Exec(query, pq.Array(entryIDs), userID, labelID); err != nil {
+		return fmt.Errorf(`store: unable to add label #%d to entries: %v`, labelID, err)
+	}
+
+	return nil
+}
+
+// RemoveLabelFromEntries detaches a label from the given entries.
+func (s *Storage) RemoveLabelFromEntries(userID, labelID int64, entryIDs []int64) error {
+	query := `
+		DELETE FROM entry_labels
+		WHERE
+			entry_id=ANY($1) AND
+			label_id=(SELECT id FROM labels WHERE user_id=$2 AND id=$3)
+	`
+	if _, err := s.db.Exec(query, pq.Array(entryIDs), userID, labelID); err != nil {
+		return fmt.Errorf(`store: unable to remove label #%d from entries: %v`, labelID, err)
+	}
+
+	return nil
+}
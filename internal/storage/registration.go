@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/model"
+)
+
+var (
+	ErrRegistrationRequestNotFound = errors.New("store: registration request not found")
+	ErrTooManyRegistrationRequests = errors.New("store: too many registration requests")
+)
+
+// IsOpenRegistrationEnabled returns true if anyone can request an account.
+func (s *Storage) IsOpenRegistrationEnabled() bool {
+	var enabled bool
+	s.db.QueryRow(`SELECT open_registration FROM registration_settings`).Scan(&enabled)
+	return enabled
+}
+
+// SetOpenRegistration enables or disables the open registration form.
+func (s *Storage) SetOpenRegistration(enabled bool) error {
+	if _, err := s.db.Exec(`UPDATE registration_settings SET open_registration=$1`, enabled); err != nil {
+		return fmt.Errorf(`store: unable to update registration settings: %v`, err)
+	}
+
+	return nil
+}
+
+// RegistrationRequestExists checks if an account has already been requested with the given username.
+func (s *Storage) RegistrationRequestExists(username string) bool {
+	var result bool
+	s.db.QueryRow(`SELECT true FROM registration_requests WHERE lower(username)=lower($1) LIMIT 1`, username).Scan(&result)
+	return result
+}
+
+// CountRegistrationRequests returns the number of registration requests waiting for approval.
+func (s *Storage) CountRegistrationRequests() int {
+	var count int
+	s.db.QueryRow(`SELECT count(*) FROM registration_requests`).Scan(&count)
+	return count
+}
+
+// RegistrationRequest returns the registration request with the given ID.
+func (s *Storage) RegistrationRequest(requestID int64) (*model.RegistrationRequest, error) {
+	var registrationRequest model.RegistrationRequest
+	err := s.db.QueryRow(
+		`SELECT id, username, created_at FROM registration_requests WHERE id=$1`,
+		requestID,
+	).Scan(&registrationRequest.ID, &registrationRequest.Username, &registrationRequest.CreatedAt)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch registration request: %v`, err)
+	}
+
+	return &registrationRequest, nil
+}
+
+// RegistrationRequests returns the registration requests waiting for approval, the oldest first.
+func (s *Storage) RegistrationRequests() (model.RegistrationRequests, error) {
+	rows, err := s.db.Query(`SELECT id, username, created_at FROM registration_requests ORDER BY created_at ASC, id ASC`)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch registration requests: %v`, err)
+	}
+	defer rows.Close()
+
+	registrationRequests := make(model.RegistrationRequests, 0)
+	for rows.Next() {
+		var registrationRequest model.RegistrationRequest
+		if err := rows.Scan(&registrationRequest.ID, &registrationRequest.Username, &registrationRequest.CreatedAt); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch registration request row: %v`, err)
+		}
+
+		registrationRequests = append(registrationRequests, &registrationRequest)
+	}
+
+	return registrationRequests, nil
+}
+
+// CreateRegistrationRequest stores an account request until an administrator approves it.
+// Only the hash of the password is stored, like for users.
+// ErrTooManyRegistrationRequests is returned when maxPendingRequests requests are already waiting for approval.
+func (s *Storage) CreateRegistrationRequest(username, password string, maxPendingRequests int) (*model.RegistrationRequest, error) {
+	hashedPassword, err := crypto.HashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO registration_requests
+			(username, password)
+		SELECT
+			LOWER($1), $2
+		WHERE
+			(SELECT count(*) FROM registration_requests) < $3
+		RETURNING
+			id, username, created_at
+	`
+	var registrationRequest model.RegistrationRequest
+	err = s.db.QueryRow(
+		query,
+		username,
+		hashedPassword,
+		maxPendingRequests,
+	).Scan(&registrationRequest.ID, &registrationRequest.Username, &registrationRequest.CreatedAt)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, ErrTooManyRegistrationRequests
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to create registration request: %v`, err)
+	}
+
+	return &registrationRequest, nil
+}
+
+// ApproveRegistrationRequest creates the requested account and removes the registration request.
+func (s *Storage) ApproveRegistrationRequest(requestID int64) (*model.User, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	var username, hashedPassword string
+	err = tx.QueryRow(
+		`DELETE FROM registration_requests WHERE id=$1 RETURNING username, password`,
+		requestID,
+	).Scan(&username, &hashedPassword)
+	switch {
+	case err == sql.ErrNoRows:
+		tx.Rollback()
+		return nil, ErrRegistrationRequestNotFound
+	case err != nil:
+		tx.Rollback()
+		return nil, fmt.Errorf(`store: unable to remove registration request: %v`, err)
+	}
+
+	user, err := createUser(tx, &model.UserCreationRequest{Username: username}, hashedPassword)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return user, nil
+}
+
+// DeleteRegistrationRequest rejects a registration request.
+func (s *Storage) DeleteRegistrationRequest(requestID int64) error {
+	result, err := s.db.Exec(`DELETE FROM registration_requests WHERE id=$1`, requestID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to delete registration request: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to delete registration request: %v`, err)
+	}
+
+	if count == 0 {
+		return ErrRegistrationRequestNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// feedRetentionQuery resolves the retention policy of each feed in the "feed_retention" table:
+// the policy of the feed, then the policy of the nearest category in the hierarchy and finally the policy of the user.
+const feedRetentionQuery = `
+	WITH RECURSIVE category_retention(id, policy, value) AS (
+		SELECT id, retention_policy, retention_value FROM categories WHERE parent_id IS NULL
+		UNION ALL
+		SELECT
+			c.id,
+			CASE WHEN c.retention_policy <> '' THEN c.retention_policy ELSE cr.policy END,
+			CASE WHEN c.retention_policy <> '' THEN c.retention_value ELSE cr.value END
+		FROM categories c JOIN category_retention cr ON c.parent_id = cr.id
+	),
+	feed_retention(feed_id, policy, value) AS (
+		SELECT
+			f.id,
+			CASE
+				WHEN f.retention_policy <> '' THEN f.retention_policy
+				WHEN cr.policy <> '' THEN cr.policy
+				ELSE u.retention_policy
+			END,
+			CASE
+				WHEN f.retention_policy <> '' THEN f.retention_value
+				WHEN cr.policy <> '' THEN cr.value
+				ELSE u.retention_value
+			END
+		FROM feeds f
+			JOIN users u ON u.id = f.user_id
+			LEFT JOIN category_retention cr ON cr.id = f.category_id
+	)
+`
+
+// ArchiveEntriesByRetentionPolicy changes the status of entries to "removed" according to the retention policy of their feed.
+// Starred, saved for later, snoozed and shared entries are never archived.
+func (s *Storage) ArchiveEntriesByRetentionPolicy(limit int) (int64, error) {
+	if limit <= 0 {
+		return 0, nil
+	}
+
+	query := feedRetentionQuery + `,
+		ranked_entries AS (
+			SELECT
+				e.id,
+				e.created_at,
+				fr.policy,
+				fr.value,
+				row_number() OVER (PARTITION BY e.feed_id ORDER BY e.published_at DESC, e.id DESC) AS position
+			FROM entries e
+				JOIN feed_retention fr ON fr.feed_id = e.feed_id
+			WHERE
+				fr.policy IN ($2, $3) AND fr.value > 0 AND e.status <> $1
+		)
+		UPDATE
+			entries
+		SET
+			status=$1
+		WHERE
+			id IN (
+				SELECT
+					re.id
+				FROM
+					ranked_entries re
+					JOIN entries e ON e.id = re.id
+				WHERE
+					(
+						(re.policy=$2 AND re.created_at < now() - make_interval(days => re.value)) OR
+						(re.policy=$3 AND re.position > re.value)
+					) AND
+					e.starred is false AND
+					e.read_later is false AND
+					(e.snoozed_until IS NULL OR e.snoozed_until <= now()) AND
+					e.share_code=''
+				ORDER BY
+					re.created_at ASC LIMIT $4
+			)
+	`
+
+	result, err := s.db.Exec(query, model.EntryStatusRemoved, model.RetentionPolicyDays, model.RetentionPolicyCount, limit)
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to archive entries by retention policy: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to get the number of rows affected: %v`, err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"miniflux.app/v2/internal/crypto"
+)
+
+// encryptSecret encrypts a sensitive value before storing it in the database.
+// Values are stored as-is when no encryption key is configured.
+func (s *Storage) encryptSecret(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	keyring, err := s.loadKeyring()
+	if err != nil {
+		return "", err
+	}
+
+	if keyring == nil {
+		return value, nil
+	}
+
+	encryptedValue, err := keyring.Encrypt(value)
+	if err != nil {
+		return "", fmt.Errorf(`store: unable to encrypt value: %v`, err)
+	}
+	return encryptedValue, nil
+}
+
+// decryptSecret decrypts a sensitive value read from the database.
+// An error is returned when the value cannot be decrypted, for example after a change of key.
+func (s *Storage) decryptSecret(value string) (string, error) {
+	if !crypto.IsEncrypted(value) {
+		return value, nil
+	}
+
+	decryptedValue, err := s.decryptSecretWithKeyring(value)
+
+	// The value may have been encrypted with a data key created by another process.
+	if errors.Is(err, crypto.ErrUnknownDataKey) {
+		s.resetKeyring()
+		decryptedValue, err = s.decryptSecretWithKeyring(value)
+	}
+
+	if err != nil {
+		slog.Warn("Unable to decrypt value stored in the database", slog.Any("error", err))
+		return "", err
+	}
+	return decryptedValue, nil
+}
+
+// decryptSecretField decrypts a secret column in place.
+// Values that cannot be decrypted are cleared and remembered in undecryptable, see encryptSecretField.
+func (s *Storage) decryptSecretField(column string, field *string, undecryptable *map[string]string) {
+	decryptedValue, err := s.decryptSecret(*field)
+	if err != nil {
+		if *undecryptable == nil {
+			*undecryptable = make(map[string]string)
+		}
+		(*undecryptable)[column] = *field
+	}
+	*field = decryptedValue
+}
+
+// encryptSecretField encrypts the value of a secret column before storing it in the database.
+// The stored value is kept when it could not be decrypted and no new value has been set,
+// so a wrong encryption key never erases secrets.
+func (s *Storage) encryptSecretField(column, value string, undecryptable map[string]string) (string, error) {
+	if storedValue, found := undecryptable[column]; found && value == "" {
+		return storedValue, nil
+	}
+	return s.encryptSecret(value)
+}
+
+func (s *Storage) decryptSecretWithKeyring(value string) (string, error) {
+	keyring, err := s.loadKeyring()
+	if err != nil {
+		return "", err
+	}
+
+	if keyring == nil {
+		return "", crypto.ErrMissingEncryptionKey
+	}
+
+	return keyring.Decrypt(value)
+}
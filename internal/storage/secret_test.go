@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"os"
+	"testing"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/model"
+)
+
+func TestIntegrationSecrets(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ENCRYPTION_KEY", "secret")
+
+	var err error
+	if config.Opts, err = config.NewParser().ParseEnvironmentVariables(); err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	dataKey, err := crypto.GenerateDataKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := NewStorage(nil)
+	store.keyring = crypto.NewKeyring()
+	if err := store.keyring.AddDataKey(1, dataKey, true); err != nil {
+		t.Fatal(err)
+	}
+
+	integration := &model.Integration{PinboardToken: "token", FeverToken: "fever", LLMAPIKey: "llm"}
+	encryptedIntegration, err := store.encryptIntegrationSecrets(integration)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !crypto.IsEncrypted(encryptedIntegration.PinboardToken) || !crypto.IsEncrypted(encryptedIntegration.LLMAPIKey) {
+		t.Fatalf(`Integration secrets should be encrypted, got %+v`, encryptedIntegration)
+	}
+
+	if encryptedIntegration.FeverToken != "fever" || integration.PinboardToken != "token" {
+		t.Fatalf(`Unexpected integration values: %+v`, encryptedIntegration)
+	}
+
+	if result, err := store.decryptSecret(encryptedIntegration.PinboardToken); err != nil || result != "token" {
+		t.Fatalf(`Unexpected decrypted value, got %q (%v)`, result, err)
+	}
+
+	if result, err := store.decryptSecret("stored before encryption"); err != nil || result != "stored before encryption" {
+		t.Fatalf(`Plain text values should be returned as-is, got %q (%v)`, result, err)
+	}
+
+	columns := make(map[string]bool)
+	for _, column := range integrationSecretColumns() {
+		if columns[column] {
+			t.Errorf(`The column %q is listed twice`, column)
+		}
+		columns[column] = true
+	}
+}
+
+func TestUndecryptableSecretsAreKept(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ENCRYPTION_KEY", "secret")
+
+	var err error
+	if config.Opts, err = config.NewParser().ParseEnvironmentVariables(); err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	newStore := func() *Storage {
+		dataKey, err := crypto.GenerateDataKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		store := NewStorage(nil)
+		store.keyring = crypto.NewKeyring()
+		if err := store.keyring.AddDataKey(1, dataKey, true); err != nil {
+			t.Fatal(err)
+		}
+		return store
+	}
+
+	// The secrets are stored with a data key, and read back with another key.
+	storedFeed, err := newStore().encryptFeedSecrets(&model.Feed{Cookie: "session=abc", Password: "password"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := newStore()
+	feed := &model.Feed{Category: &model.Category{}, Cookie: storedFeed.Cookie, Password: storedFeed.Password, Username: "plain"}
+	for _, secret := range feedSecrets {
+		store.decryptSecretField(secret.column, secret.field(feed), &feed.UndecryptableSecrets)
+	}
+
+	if feed.Cookie != "" || feed.Password != "" || feed.Username != "plain" {
+		t.Fatalf(`Undecryptable secrets should be cleared, got %q, %q and %q`, feed.Cookie, feed.Password, feed.Username)
+	}
+
+	// The user sets a new password but leaves the cookie empty.
+	feed.Password = "new password"
+	args, err := store.updateFeedArgs(feed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cookie := args[17]; cookie != storedFeed.Cookie {
+		t.Errorf(`The undecryptable cookie should be written back unchanged, got %q`, cookie)
+	}
+
+	password, ok := args[19].(string)
+	if !ok || password == storedFeed.Password {
+		t.Fatalf(`The new password should replace the undecryptable one, got %v`, args[19])
+	}
+
+	if result, err := store.decryptSecret(password); err != nil || result != "new password" {
+		t.Errorf(`Unexpected password, got %q (%v)`, result, err)
+	}
+
+	integration := &model.Integration{PinboardToken: storedFeed.Cookie}
+	store.decryptSecretField("pinboard_token", &integration.PinboardToken, &integration.UndecryptableSecrets)
+
+	encryptedIntegration, err := store.encryptIntegrationSecrets(integration)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if encryptedIntegration.PinboardToken != storedFeed.Cookie {
+		t.Errorf(`The undecryptable integration secret should be kept, got %q`, encryptedIntegration.PinboardToken)
+	}
+}
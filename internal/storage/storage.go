@@ -6,17 +6,23 @@ package storage // import "miniflux.app/v2/internal/storage"
 import (
 	"context"
 	"database/sql"
+	"sync"
 	"time"
+
+	"miniflux.app/v2/internal/crypto"
 )
 
 // Storage handles all operations related to the database.
 type Storage struct {
 	db *sql.DB
+
+	keyringMutex sync.Mutex
+	keyring      *crypto.Keyring
 }
 
 // NewStorage returns a new Storage.
 func NewStorage(db *sql.DB) *Storage {
-	return &Storage{db}
+	return &Storage{db: db}
 }
 
 // DatabaseVersion returns the version of the database which is in use.
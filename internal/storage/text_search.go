@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// defaultTextSearchConfiguration is used for entries written in a language without a dedicated configuration.
+const defaultTextSearchConfiguration = "simple"
+
+// textSearchConfigurations maps ISO 639-1 codes to the text search configurations shipped with PostgreSQL.
+var textSearchConfigurations = map[string]string{
+	"da": "danish",
+	"de": "german",
+	"en": "english",
+	"es": "spanish",
+	"fi": "finnish",
+	"fr": "french",
+	"hu": "hungarian",
+	"it": "italian",
+	"nb": "norwegian",
+	"nl": "dutch",
+	"nn": "norwegian",
+	"no": "norwegian",
+	"pt": "portuguese",
+	"ro": "romanian",
+	"ru": "russian",
+	"sv": "swedish",
+	"tr": "turkish",
+}
+
+// textSearchConfiguration returns the text search configuration used to index an entry written in the given language.
+func textSearchConfiguration(language string) string {
+	if configuration, found := textSearchConfigurations[language]; found {
+		return configuration
+	}
+	return defaultTextSearchConfiguration
+}
+
+// availableTextSearchConfigurations returns the sorted list of configurations that can be stored in the entries table.
+func availableTextSearchConfigurations() []string {
+	configurations := []string{defaultTextSearchConfiguration}
+	for _, configuration := range textSearchConfigurations {
+		configurations = append(configurations, configuration)
+	}
+	slices.Sort(configurations)
+	return slices.Compact(configurations)
+}
+
+// textSearchCondition returns a condition matching the search query given at the argument position.
+//
+// Each entry is indexed with its own configuration, the query must be parsed with the same configuration
+// to produce the same lexemes. One constant query per configuration keeps the GIN index usable.
+func textSearchCondition(argPosition int) string {
+	configurations := availableTextSearchConfigurations()
+	parts := make([]string, 0, len(configurations))
+	for _, configuration := range configurations {
+		parts = append(parts, fmt.Sprintf(
+			"(e.search_configuration = '%[1]s' AND e.document_vectors @@ plainto_tsquery('%[1]s', $%[2]d))",
+			configuration,
+			argPosition,
+		))
+	}
+	return "(" + strings.Join(parts, " OR ") + ")"
+}
+
+// textSearchRanking returns the expression used to rank entries matching the search query given at the argument position.
+func textSearchRanking(argPosition int) string {
+	return fmt.Sprintf("ts_rank(e.document_vectors, plainto_tsquery(e.search_configuration::regconfig, $%d))", argPosition)
+}
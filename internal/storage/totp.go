@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/totp"
+
+	"github.com/lib/pq"
+)
+
+// SetTOTPSecret stores the secret of a user starting the TOTP enrollment, TOTP is enabled later with EnableTOTP.
+func (s *Storage) SetTOTPSecret(userID int64, secret string) error {
+	encryptedSecret, err := s.encryptSecret(secret)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE users SET totp_secret=$1, totp_last_used_step=0 WHERE id=$2 AND totp_enabled='f'`
+	if _, err := s.db.Exec(query, encryptedSecret, userID); err != nil {
+		return fmt.Errorf(`store: unable to update TOTP secret: %v`, err)
+	}
+
+	return nil
+}
+
+// TOTPSecret returns the TOTP secret of the user.
+func (s *Storage) TOTPSecret(userID int64) (string, error) {
+	var secret string
+	err := s.db.QueryRow(`SELECT totp_secret FROM users WHERE id=$1`, userID).Scan(&secret)
+	switch {
+	case err == sql.ErrNoRows:
+		return "", nil
+	case err != nil:
+		return "", fmt.Errorf(`store: unable to fetch TOTP secret: %v`, err)
+	}
+
+	decryptedSecret, err := s.decryptSecret(secret)
+	if err != nil {
+		return "", fmt.Errorf(`store: unable to decrypt TOTP secret: %v`, err)
+	}
+
+	return decryptedSecret, nil
+}
+
+// EnableTOTP enables two-factor authentication once the user has entered a valid code, and replaces the recovery codes.
+func (s *Storage) EnableTOTP(userID int64, code string, recoveryCodes []string) (bool, error) {
+	valid, err := s.CheckTOTPCode(userID, code, false)
+	if err != nil || !valid {
+		return false, err
+	}
+
+	query := `UPDATE users SET totp_enabled='t', totp_recovery_codes=$1 WHERE id=$2`
+	if _, err := s.db.Exec(query, pq.Array(hashRecoveryCodes(recoveryCodes)), userID); err != nil {
+		return false, fmt.Errorf(`store: unable to enable TOTP: %v`, err)
+	}
+
+	return true, nil
+}
+
+// DisableTOTP removes the TOTP secret and the recovery codes of the user.
+func (s *Storage) DisableTOTP(userID int64) error {
+	query := `
+		UPDATE
+			users
+		SET
+			totp_enabled='f',
+			totp_secret='',
+			totp_recovery_codes='{}',
+			totp_last_used_step=0
+		WHERE
+			id=$1
+	`
+	if _, err := s.db.Exec(query, userID); err != nil {
+		return fmt.Errorf(`store: unable to disable TOTP: %v`, err)
+	}
+
+	return nil
+}
+
+// ReplaceTOTPRecoveryCodes invalidates the previous recovery codes of the user.
+func (s *Storage) ReplaceTOTPRecoveryCodes(userID int64, recoveryCodes []string) error {
+	query := `UPDATE users SET totp_recovery_codes=$1 WHERE id=$2 AND totp_enabled='t'`
+	if _, err := s.db.Exec(query, pq.Array(hashRecoveryCodes(recoveryCodes)), userID); err != nil {
+		return fmt.Errorf(`store: unable to update TOTP recovery codes: %v`, err)
+	}
+
+	return nil
+}
+
+// CountTOTPRecoveryCodes returns the number of recovery codes not used yet.
+func (s *Storage) CountTOTPRecoveryCodes(userID int64) (int, error) {
+	var count int
+	err := s.db.QueryRow(`SELECT cardinality(totp_recovery_codes) FROM users WHERE id=$1`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to count TOTP recovery codes: %v`, err)
+	}
+
+	return count, nil
+}
+
+// CheckTOTPCode validates a TOTP code of the user, each code can only be used once.
+// Recovery codes are accepted when allowRecoveryCode is true, they are removed once used.
+func (s *Storage) CheckTOTPCode(userID int64, code string, allowRecoveryCode bool) (bool, error) {
+	var (
+		encryptedSecret string
+		lastUsedStep    int64
+	)
+
+	err := s.db.QueryRow(`SELECT totp_secret, totp_last_used_step FROM users WHERE id=$1`, userID).Scan(&encryptedSecret, &lastUsedStep)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf(`store: unable to fetch TOTP secret: %v`, err)
+	}
+
+	// Recovery codes are still accepted when the secret cannot be decrypted.
+	if secret, err := s.decryptSecret(encryptedSecret); err == nil && secret != "" {
+		if step, valid := totp.Validate(secret, code, time.Now(), lastUsedStep); valid {
+			// The condition on the last used step prevents concurrent requests from using the same code.
+			query := `UPDATE users SET totp_last_used_step=$1 WHERE id=$2 AND totp_last_used_step < $1`
+			return s.updateTOTPState(query, step, userID)
+		}
+	}
+
+	if !allowRecoveryCode {
+		return false, nil
+	}
+
+	codeHash := crypto.SHA256(totp.NormalizeRecoveryCode(code))
+	query := `
+		UPDATE
+			users
+		SET
+			totp_recovery_codes=array_remove(totp_recovery_codes, $1)
+		WHERE
+			id=$2 AND totp_enabled='t' AND $1=ANY(totp_recovery_codes)
+	`
+	return s.updateTOTPState(query, codeHash, userID)
+}
+
+func (s *Storage) updateTOTPState(query string, args ...any) (bool, error) {
+	result, err := s.db.Exec(query, args...)
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to update TOTP state: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to update TOTP state: %v`, err)
+	}
+
+	return count == 1, nil
+}
+
+func hashRecoveryCodes(recoveryCodes []string) []string {
+	hashes := make([]string, len(recoveryCodes))
+	for i, code := range recoveryCodes {
+		hashes[i] = crypto.SHA256(totp.NormalizeRecoveryCode(code))
+	}
+	return hashes
+}
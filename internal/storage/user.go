@@ -39,6 +39,40 @@ func (s *Storage) SetLastLogin(userID int64) error {
 	return nil
 }
 
+// SetUserAdmin grants or revokes the administrator privileges of a user.
+func (s *Storage) SetUserAdmin(userID int64, isAdmin bool) error {
+	_, err := s.db.Exec(`UPDATE users SET is_admin=$1 WHERE id=$2`, isAdmin, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to update admin flag: %v`, err)
+	}
+
+	return nil
+}
+
+// SetUserLDAPProvisioned marks the user as managed by the LDAP directory.
+func (s *Storage) SetUserLDAPProvisioned(userID int64) error {
+	_, err := s.db.Exec(`UPDATE users SET ldap_provisioned='t' WHERE id=$1 AND ldap_provisioned='f'`, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to update LDAP provisioning flag: %v`, err)
+	}
+
+	return nil
+}
+
+// IsUserLDAPProvisioned returns true if the user has been created or authenticated through the LDAP directory.
+func (s *Storage) IsUserLDAPProvisioned(username string) (bool, error) {
+	var result bool
+	err := s.db.QueryRow(`SELECT ldap_provisioned FROM users WHERE username=LOWER($1)`, username).Scan(&result)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf(`store: unable to fetch LDAP provisioning flag: %v`, err)
+	}
+
+	return result, nil
+}
+
 // UserExists checks if a user exists by using the given username.
 func (s *Storage) UserExists(username string) bool {
 	var result bool
@@ -64,6 +98,27 @@ func (s *Storage) CreateUser(userCreationRequest *model.UserCreationRequest) (*m
 		}
 	}
 
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	user, err := createUser(tx, userCreationRequest, hashedPassword)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return user, nil
+}
+
+// createUser inserts a user with its default category and integration row within the transaction.
+// The caller is responsible for rolling back the transaction on error.
+func createUser(tx *sql.Tx, userCreationRequest *model.UserCreationRequest, hashedPassword string) (*model.User, error) {
 	query := `
 		INSERT INTO users
 			(username, password, is_admin, google_id, openid_connect_id)
@@ -98,16 +153,16 @@ func (s *Storage) CreateUser(userCreationRequest *model.UserCreationRequest) (*m
 			block_filter_entry_rules,
 			keep_filter_entry_rules,
 			always_open_external_links,
-			open_external_links_in_new_tab
+			open_external_links_in_new_tab,
+			entry_list_layout,
+			retention_policy,
+			retention_value,
+			totp_enabled,
+			totp_required
 	`
 
-	tx, err := s.db.Begin()
-	if err != nil {
-		return nil, fmt.Errorf(`store: unable to start transaction: %v`, err)
-	}
-
 	var user model.User
-	err = tx.QueryRow(
+	err := tx.QueryRow(
 		query,
 		userCreationRequest.Username,
 		hashedPassword,
@@ -144,28 +199,26 @@ func (s *Storage) CreateUser(userCreationRequest *model.UserCreationRequest) (*m
 		&user.KeepFilterEntryRules,
 		&user.AlwaysOpenExternalLinks,
 		&user.OpenExternalLinksInNewTab,
+		&user.EntryListLayout,
+		&user.RetentionPolicy,
+		&user.RetentionValue,
+		&user.TOTPEnabled,
+		&user.TOTPRequired,
 	)
 	if err != nil {
-		tx.Rollback()
 		return nil, fmt.Errorf(`store: unable to create user: %v`, err)
 	}
 
 	_, err = tx.Exec(`INSERT INTO categories (user_id, title) VALUES ($1, $2)`, user.ID, "All")
 	if err != nil {
-		tx.Rollback()
 		return nil, fmt.Errorf(`store: unable to create user default category: %v`, err)
 	}
 
 	_, err = tx.Exec(`INSERT INTO integrations (user_id) VALUES ($1)`, user.ID)
 	if err != nil {
-		tx.Rollback()
 		return nil, fmt.Errorf(`store: unable to create integration row: %v`, err)
 	}
 
-	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf(`store: unable to commit transaction: %v`, err)
-	}
-
 	return &user, nil
 }
 
@@ -210,9 +263,13 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				block_filter_entry_rules=$27,
 				keep_filter_entry_rules=$28,
 				always_open_external_links=$29,
-				open_external_links_in_new_tab=$30
+				open_external_links_in_new_tab=$30,
+				entry_list_layout=$31,
+				retention_policy=$32,
+				retention_value=$33,
+				totp_required=$34
 			WHERE
-				id=$31
+				id=$35
 		`
 
 		_, err = s.db.Exec(
@@ -247,6 +304,10 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.KeepFilterEntryRules,
 			user.AlwaysOpenExternalLinks,
 			user.OpenExternalLinksInNewTab,
+			user.EntryListLayout,
+			user.RetentionPolicy,
+			user.RetentionValue,
+			user.TOTPRequired,
 			user.ID,
 		)
 		if err != nil {
@@ -283,9 +344,13 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				block_filter_entry_rules=$26,
 				keep_filter_entry_rules=$27,
 				always_open_external_links=$28,
-				open_external_links_in_new_tab=$29
+				open_external_links_in_new_tab=$29,
+				entry_list_layout=$30,
+				retention_policy=$31,
+				retention_value=$32,
+				totp_required=$33
 			WHERE
-				id=$30
+				id=$34
 		`
 
 		_, err := s.db.Exec(
@@ -319,6 +384,10 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.KeepFilterEntryRules,
 			user.AlwaysOpenExternalLinks,
 			user.OpenExternalLinksInNewTab,
+			user.EntryListLayout,
+			user.RetentionPolicy,
+			user.RetentionValue,
+			user.TOTPRequired,
 			user.ID,
 		)
 
@@ -374,7 +443,12 @@ func (s *Storage) UserByID(userID int64) (*model.User, error) {
 			block_filter_entry_rules,
 			keep_filter_entry_rules,
 			always_open_external_links,
-			open_external_links_in_new_tab
+			open_external_links_in_new_tab,
+			entry_list_layout,
+			retention_policy,
+			retention_value,
+			totp_enabled,
+			totp_required
 		FROM
 			users
 		WHERE
@@ -417,7 +491,12 @@ func (s *Storage) UserByUsername(username string) (*model.User, error) {
 			block_filter_entry_rules,
 			keep_filter_entry_rules,
 			always_open_external_links,
-			open_external_links_in_new_tab
+			open_external_links_in_new_tab,
+			entry_list_layout,
+			retention_policy,
+			retention_value,
+			totp_enabled,
+			totp_required
 		FROM
 			users
 		WHERE
@@ -460,7 +539,12 @@ func (s *Storage) UserByField(field, value string) (*model.User, error) {
 			block_filter_entry_rules,
 			keep_filter_entry_rules,
 			always_open_external_links,
-			open_external_links_in_new_tab
+			open_external_links_in_new_tab,
+			entry_list_layout,
+			retention_policy,
+			retention_value,
+			totp_enabled,
+			totp_required
 		FROM
 			users
 		WHERE
@@ -476,51 +560,6 @@ func (s *Storage) AnotherUserWithFieldExists(userID int64, field, value string)
 	return result
 }
 
-// UserByAPIKey returns a User from an API Key.
-func (s *Storage) UserByAPIKey(token string) (*model.User, error) {
-	query := `
-		SELECT
-			u.id,
-			u.username,
-			u.is_admin,
-			u.theme,
-			u.language,
-			u.timezone,
-			u.entry_direction,
-			u.entries_per_page,
-			u.keyboard_shortcuts,
-			u.show_reading_time,
-			u.entry_swipe,
-			u.gesture_nav,
-			u.last_login_at,
-			u.stylesheet,
-			u.custom_js,
-			u.external_font_hosts,
-			u.google_id,
-			u.openid_connect_id,
-			u.display_mode,
-			u.entry_order,
-			u.default_reading_speed,
-			u.cjk_reading_speed,
-			u.default_home_page,
-			u.categories_sorting_order,
-			u.mark_read_on_view,
-			u.mark_read_on_media_player_completion,
-			media_playback_rate,
-			u.block_filter_entry_rules,
-			u.keep_filter_entry_rules,
-			u.always_open_external_links,
-			u.open_external_links_in_new_tab
-		FROM
-			users u
-		LEFT JOIN
-			api_keys ON api_keys.user_id=u.id
-		WHERE
-			api_keys.token = $1
-	`
-	return s.fetchUser(query, token)
-}
-
 func (s *Storage) fetchUser(query string, args ...any) (*model.User, error) {
 	var user model.User
 	err := s.db.QueryRow(query, args...).Scan(
@@ -555,6 +594,11 @@ func (s *Storage) fetchUser(query string, args ...any) (*model.User, error) {
 		&user.KeepFilterEntryRules,
 		&user.AlwaysOpenExternalLinks,
 		&user.OpenExternalLinksInNewTab,
+		&user.EntryListLayout,
+		&user.RetentionPolicy,
+		&user.RetentionValue,
+		&user.TOTPEnabled,
+		&user.TOTPRequired,
 	)
 
 	if err == sql.ErrNoRows {
@@ -670,7 +714,12 @@ func (s *Storage) Users() (model.Users, error) {
 			block_filter_entry_rules,
 			keep_filter_entry_rules,
 			always_open_external_links,
-			open_external_links_in_new_tab
+			open_external_links_in_new_tab,
+			entry_list_layout,
+			retention_policy,
+			retention_value,
+			totp_enabled,
+			totp_required
 		FROM
 			users
 		ORDER BY username ASC
@@ -716,6 +765,11 @@ func (s *Storage) Users() (model.Users, error) {
 			&user.KeepFilterEntryRules,
 			&user.AlwaysOpenExternalLinks,
 			&user.OpenExternalLinksInNewTab,
+			&user.EntryListLayout,
+			&user.RetentionPolicy,
+			&user.RetentionValue,
+			&user.TOTPEnabled,
+			&user.TOTPRequired,
 		)
 
 		if err != nil {
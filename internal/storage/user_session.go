@@ -94,16 +94,21 @@ func (s *Storage) UserSessionByToken(token string) (*model.UserSession, error) {
 
 	query := `
 		SELECT
-			id,
-			user_id,
-			token,
-			created_at,
-			user_agent,
-			ip 
+			s.id,
+			s.user_id,
+			s.token,
+			s.created_at,
+			s.user_agent,
+			s.ip,
+			u.password <> '',
+			u.totp_enabled,
+			u.totp_required
 		FROM
-			user_sessions
+			user_sessions s
+		JOIN
+			users u ON u.id = s.user_id
 		WHERE
-			token = $1
+			s.token = $1
 	`
 	err := s.db.QueryRow(query, token).Scan(
 		&session.ID,
@@ -112,6 +117,9 @@ func (s *Storage) UserSessionByToken(token string) (*model.UserSession, error) {
 		&session.CreatedAt,
 		&session.UserAgent,
 		&session.IP,
+		&session.UserHasPassword,
+		&session.UserTOTPEnabled,
+		&session.UserTOTPRequired,
 	)
 
 	switch {
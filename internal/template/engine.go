@@ -37,36 +37,54 @@ func NewEngine(router *mux.Router) *Engine {
 func (e *Engine) ParseTemplates() {
 	funcMap := e.funcMap.Map()
 	templates := map[string][]string{ // this isn't a global variable so that it can be garbage-collected.
-		"about.html":               {"layout.html", "settings_menu.html"},
-		"add_subscription.html":    {"feed_menu.html", "layout.html", "settings_menu.html"},
-		"api_keys.html":            {"layout.html", "settings_menu.html"},
-		"starred_entries.html":     {"item_meta.html", "layout.html", "pagination.html"},
-		"categories.html":          {"layout.html"},
-		"category_entries.html":    {"item_meta.html", "layout.html", "pagination.html"},
-		"category_feeds.html":      {"feed_list.html", "layout.html"},
-		"choose_subscription.html": {"feed_menu.html", "layout.html"},
-		"create_api_key.html":      {"layout.html", "settings_menu.html"},
-		"create_category.html":     {"layout.html"},
-		"create_user.html":         {"layout.html", "settings_menu.html"},
-		"edit_category.html":       {"layout.html", "settings_menu.html"},
-		"edit_feed.html":           {"layout.html"},
-		"edit_user.html":           {"layout.html", "settings_menu.html"},
-		"entry.html":               {"layout.html"},
-		"feed_entries.html":        {"item_meta.html", "layout.html", "pagination.html"},
-		"feeds.html":               {"feed_list.html", "feed_menu.html", "item_meta.html", "layout.html", "pagination.html"},
-		"history_entries.html":     {"item_meta.html", "layout.html", "pagination.html"},
-		"import.html":              {"feed_menu.html", "layout.html"},
-		"integrations.html":        {"layout.html", "settings_menu.html"},
-		"login.html":               {"layout.html"},
-		"offline.html":             {},
-		"search.html":              {"item_meta.html", "layout.html", "pagination.html"},
-		"sessions.html":            {"layout.html", "settings_menu.html"},
-		"settings.html":            {"layout.html", "settings_menu.html"},
-		"shared_entries.html":      {"layout.html", "pagination.html"},
-		"tag_entries.html":         {"item_meta.html", "layout.html", "pagination.html"},
-		"unread_entries.html":      {"item_meta.html", "layout.html", "pagination.html"},
-		"users.html":               {"layout.html", "settings_menu.html"},
-		"webauthn_rename.html":     {"layout.html"},
+		"about.html":                 {"layout.html", "settings_menu.html"},
+		"add_subscription.html":      {"feed_menu.html", "layout.html", "settings_menu.html"},
+		"api_keys.html":              {"layout.html", "settings_menu.html"},
+		"audit_logs.html":            {"layout.html", "pagination.html", "settings_menu.html"},
+		"starred_entries.html":       {"item_meta.html", "layout.html", "pagination.html"},
+		"categories.html":            {"layout.html"},
+		"category_entries.html":      {"item_meta.html", "layout.html", "pagination.html"},
+		"category_feeds.html":        {"feed_list.html", "layout.html"},
+		"choose_subscription.html":   {"feed_menu.html", "layout.html"},
+		"create_api_key.html":        {"layout.html", "settings_menu.html"},
+		"create_category.html":       {"layout.html", "retention_policy.html"},
+		"create_invitation.html":     {"layout.html", "settings_menu.html"},
+		"create_label.html":          {"layout.html"},
+		"create_user.html":           {"layout.html", "settings_menu.html"},
+		"edit_category.html":         {"layout.html", "retention_policy.html", "settings_menu.html"},
+		"edit_entry_labels.html":     {"layout.html"},
+		"edit_feed.html":             {"layout.html", "retention_policy.html"},
+		"edit_label.html":            {"layout.html"},
+		"edit_user.html":             {"layout.html", "settings_menu.html"},
+		"entry.html":                 {"layout.html"},
+		"feed_entries.html":          {"item_meta.html", "layout.html", "pagination.html"},
+		"feeds.html":                 {"feed_list.html", "feed_menu.html", "item_meta.html", "layout.html", "pagination.html"},
+		"feeds_batch.html":           {"feed_menu.html", "layout.html"},
+		"highlights.html":            {"layout.html", "pagination.html"},
+		"history_entries.html":       {"item_meta.html", "layout.html", "pagination.html"},
+		"import.html":                {"feed_menu.html", "layout.html"},
+		"integrations.html":          {"layout.html", "settings_menu.html"},
+		"invitations.html":           {"layout.html", "settings_menu.html"},
+		"label_entries.html":         {"item_meta.html", "layout.html", "pagination.html"},
+		"labels.html":                {"layout.html"},
+		"login.html":                 {"layout.html"},
+		"login_totp.html":            {"layout.html"},
+		"offline.html":               {},
+		"read_later_entries.html":    {"item_meta.html", "layout.html", "pagination.html"},
+		"registration.html":          {"layout.html"},
+		"registration_requests.html": {"layout.html", "settings_menu.html"},
+		"search.html":                {"item_meta.html", "layout.html", "pagination.html"},
+		"sessions.html":              {"layout.html", "settings_menu.html"},
+		"settings.html":              {"layout.html", "retention_policy.html", "settings_menu.html"},
+		"shared_entries.html":        {"layout.html", "pagination.html"},
+		"snooze_entry.html":          {"layout.html"},
+		"snoozed_entries.html":       {"item_meta.html", "layout.html", "pagination.html"},
+		"tag_entries.html":           {"item_meta.html", "layout.html", "pagination.html"},
+		"totp.html":                  {"layout.html", "settings_menu.html"},
+		"totp_recovery_codes.html":   {"layout.html", "settings_menu.html"},
+		"unread_entries.html":        {"item_meta.html", "layout.html", "pagination.html"},
+		"users.html":                 {"layout.html", "settings_menu.html"},
+		"webauthn_rename.html":       {"layout.html"},
 	}
 
 	for name, dependencies := range templates {
@@ -19,6 +19,7 @@ import (
 	"miniflux.app/v2/internal/locale"
 	"miniflux.app/v2/internal/mediaproxy"
 	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/oauth2"
 	"miniflux.app/v2/internal/timezone"
 	"miniflux.app/v2/internal/urllib"
 
@@ -45,6 +46,10 @@ func (f *funcMap) Map() template.FuncMap {
 		"hasOAuth2Provider": func(provider string) bool {
 			return config.Opts.OAuth2Provider() == provider
 		},
+		"oidcProviders": config.Opts.OIDCProviders,
+		"oidcLinkedProvider": func(identity string) string {
+			return oauth2.OIDCIdentityProvider(identity, config.Opts.OIDCProviderNames())
+		},
 		"hasAuthProxy": func() bool {
 			return config.Opts.AuthProxyHeader() != ""
 		},
@@ -4,6 +4,7 @@
 package timezone // import "miniflux.app/v2/internal/timezone"
 
 import (
+	"fmt"
 	"sync"
 	"time"
 )
@@ -46,6 +47,21 @@ func Now(tz string) time.Time {
 	return time.Now().In(getLocation(tz))
 }
 
+// Parse parses a date time, values without timezone information are interpreted in the given timezone.
+func Parse(tz string, value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.In(getLocation(tz)), nil
+	}
+
+	for _, layout := range []string{"2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02 15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, value, getLocation(tz)); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("timezone: unable to parse date time %q", value)
+}
+
 func getLocation(tz string) *time.Location {
 	if loc, ok := tzCache.Load(tz); ok {
 		return loc.(*time.Location)
@@ -89,3 +89,53 @@ func TestConvertPostgresDateTimeWithNegativeTimezoneOffset(t *testing.T) {
 		t.Fatalf(`Unexpected year, got %d instead of 0`, year)
 	}
 }
+
+func TestParseWithoutTimezoneInformation(t *testing.T) {
+	tz := "America/Montreal"
+	output, err := Parse(tz, "2024-03-04T09:30")
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if output.Location().String() != tz {
+		t.Fatalf(`Unexpected timezone, got %q instead of %s`, output.Location(), tz)
+	}
+
+	expected := time.Date(2024, 3, 4, 14, 30, 0, 0, time.UTC)
+	if !output.Equal(expected) {
+		t.Fatalf(`Unexpected time, got %v instead of %v`, output, expected)
+	}
+}
+
+func TestParseWithTimezoneInformation(t *testing.T) {
+	tz := "Europe/Paris"
+	output, err := Parse(tz, "2024-03-04T09:30:00Z")
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if output.Location().String() != tz {
+		t.Fatalf(`Unexpected timezone, got %q instead of %s`, output.Location(), tz)
+	}
+
+	if hours, minutes, _ := output.Clock(); hours != 10 || minutes != 30 {
+		t.Fatalf(`Unexpected time, got hours=%d, minutes=%d`, hours, minutes)
+	}
+}
+
+func TestParseWithDateOnly(t *testing.T) {
+	output, err := Parse("UTC", "2024-03-04")
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if !output.Equal(time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf(`Unexpected time, got %v`, output)
+	}
+}
+
+func TestParseWithInvalidValue(t *testing.T) {
+	if _, err := Parse("UTC", "next monday"); err == nil {
+		t.Fatal(`An invalid date time should generate an error`)
+	}
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package totp // import "miniflux.app/v2/internal/totp"
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"miniflux.app/v2/internal/crypto"
+)
+
+const (
+	// Issuer is the name displayed by authenticator applications.
+	Issuer = "Miniflux"
+
+	digits     = 6
+	period     = 30
+	secretSize = 20
+
+	// Codes of the previous and the next time steps are accepted to tolerate clock drifts.
+	allowedSkew = 1
+
+	recoveryCodesCount = 10
+)
+
+var secretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a new random secret encoded in base32.
+func GenerateSecret() string {
+	return secretEncoding.EncodeToString(crypto.GenerateRandomBytes(secretSize))
+}
+
+// Code returns the code of the time step containing the given time.
+func Code(secret string, t time.Time) (string, error) {
+	return codeForStep(secret, timeStep(t))
+}
+
+// Validate checks the code against the time steps around the given time.
+// Codes of steps lower or equal to lastUsedStep are refused to prevent replay attacks.
+// It returns the matching time step.
+func Validate(secret, code string, t time.Time, lastUsedStep int64) (int64, bool) {
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if len(code) != digits {
+		return 0, false
+	}
+
+	currentStep := timeStep(t)
+	for step := currentStep - allowedSkew; step <= currentStep+allowedSkew; step++ {
+		if step <= lastUsedStep {
+			continue
+		}
+
+		expectedCode, err := codeForStep(secret, step)
+		if err != nil {
+			return 0, false
+		}
+
+		if hmac.Equal([]byte(expectedCode), []byte(code)) {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// URI returns the otpauth URI understood by authenticator applications.
+func URI(account, secret string) string {
+	values := url.Values{}
+	values.Set("secret", secret)
+	values.Set("issuer", Issuer)
+	values.Set("digits", fmt.Sprint(digits))
+	values.Set("period", fmt.Sprint(period))
+
+	label := url.PathEscape(Issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + values.Encode()
+}
+
+// GenerateRecoveryCodes returns single-use codes that can replace a TOTP code.
+func GenerateRecoveryCodes() []string {
+	codes := make([]string, recoveryCodesCount)
+	for i := range codes {
+		code := crypto.GenerateRandomStringHex(5)
+		codes[i] = code[:5] + "-" + code[5:]
+	}
+	return codes
+}
+
+// NormalizeRecoveryCode removes the formatting of a recovery code typed by a user.
+func NormalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.ReplaceAll(strings.ReplaceAll(code, "-", ""), " ", "")
+}
+
+func timeStep(t time.Time) int64 {
+	return t.Unix() / period
+}
+
+func codeForStep(secret string, step int64) (string, error) {
+	key, err := secretEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", fmt.Errorf("totp: invalid secret: %w", err)
+	}
+
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", digits, value%1000000), nil
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package totp // import "miniflux.app/v2/internal/totp"
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// Test vectors from RFC 6238, truncated to 6 digits.
+func TestCode(t *testing.T) {
+	secret := secretEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	scenarios := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+
+	for timestamp, expected := range scenarios {
+		code, err := Code(secret, time.Unix(timestamp, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if code != expected {
+			t.Errorf(`Unexpected code at %d, got %q instead of %q`, timestamp, code, expected)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret := GenerateSecret()
+	now := time.Now()
+
+	code, err := Code(secret, now.Add(-30*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	step, valid := Validate(secret, code, now, 0)
+	if !valid {
+		t.Fatal(`The code of the previous time step should be accepted`)
+	}
+
+	if _, valid := Validate(secret, code, now, step); valid {
+		t.Fatal(`A code should not be accepted twice`)
+	}
+
+	if _, valid := Validate(secret, code, now.Add(2*time.Minute), 0); valid {
+		t.Fatal(`An old code should not be accepted`)
+	}
+
+	if _, valid := Validate(secret, "12345", now, 0); valid {
+		t.Fatal(`A code with the wrong length should not be accepted`)
+	}
+
+	if _, valid := Validate("invalid secret!", "123456", now, 0); valid {
+		t.Fatal(`An invalid secret should not validate codes`)
+	}
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("john doe", "ABCDEF")
+	if !strings.HasPrefix(uri, "otpauth://totp/Miniflux:john%20doe?") || !strings.Contains(uri, "secret=ABCDEF") || !strings.Contains(uri, "issuer=Miniflux") {
+		t.Fatalf(`Unexpected URI: %q`, uri)
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes := GenerateRecoveryCodes()
+	if len(codes) != recoveryCodesCount {
+		t.Fatalf(`Unexpected number of recovery codes: %d`, len(codes))
+	}
+
+	if normalized := NormalizeRecoveryCode(" " + strings.ToUpper(codes[0]) + " "); normalized != strings.ReplaceAll(codes[0], "-", "") {
+		t.Fatalf(`Unexpected normalized code: %q`, normalized)
+	}
+}
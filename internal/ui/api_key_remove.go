@@ -9,14 +9,28 @@ import (
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response/html"
 	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/model"
 )
 
 func (h *handler) deleteAPIKey(w http.ResponseWriter, r *http.Request) {
 	keyID := request.RouteInt64Param(r, "keyID")
+	apiKey, err := h.store.APIKey(request.UserID(r), keyID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if apiKey == nil {
+		html.NotFound(w, r)
+		return
+	}
+
 	if err := h.store.DeleteAPIKey(request.UserID(r), keyID); err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
+	h.store.Audit(request.UserID(r), model.AuditActionAPIKeyRemove, apiKey.Description, request.ClientIP(r))
+
 	html.Redirect(w, r, route.Path(h.router, "apiKeys"))
 }
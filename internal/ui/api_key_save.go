@@ -8,8 +8,9 @@ import (
 
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response/html"
-	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/locale"
 	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/timezone"
 	"miniflux.app/v2/internal/ui/form"
 	"miniflux.app/v2/internal/ui/session"
 	"miniflux.app/v2/internal/ui/view"
@@ -26,25 +27,54 @@ func (h *handler) saveAPIKey(w http.ResponseWriter, r *http.Request) {
 	apiKeyForm := form.NewAPIKeyForm(r)
 	apiKeyCreationRequest := &model.APIKeyCreationRequest{
 		Description: apiKeyForm.Description,
+		Scopes:      apiKeyForm.Scopes,
 	}
 
-	if validationErr := validator.ValidateAPIKeyCreation(h.store, user.ID, apiKeyCreationRequest); validationErr != nil {
-		sess := session.New(h.store, request.SessionID(r))
-		view := view.New(h.tpl, r, sess)
+	var validationErr *locale.LocalizedError
+	if apiKeyForm.ExpiresAt != "" {
+		if expiresAt, err := timezone.Parse(user.Timezone, apiKeyForm.ExpiresAt); err != nil {
+			validationErr = locale.NewLocalizedError("error.api_key_invalid_expiration")
+		} else {
+			apiKeyCreationRequest.ExpiresAt = &expiresAt
+		}
+	}
+
+	if validationErr == nil {
+		validationErr = validator.ValidateAPIKeyCreation(h.store, user.ID, apiKeyCreationRequest)
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	if validationErr != nil {
 		view.Set("form", apiKeyForm)
-		view.Set("menu", "settings")
-		view.Set("user", user)
-		view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
-		view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+		view.Set("scopes", model.APIKeyScopes())
 		view.Set("errorMessage", validationErr.Translate(user.Language))
 		html.OK(w, r, view.Render("create_api_key"))
 		return
 	}
 
-	if _, err = h.store.CreateAPIKey(user.ID, apiKeyCreationRequest.Description); err != nil {
+	apiKey, err := h.store.CreateAPIKey(user.ID, apiKeyCreationRequest)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	h.store.Audit(request.UserID(r), model.AuditActionAPIKeyCreate, apiKey.Description, request.ClientIP(r))
+
+	apiKeys, err := h.store.APIKeys(user.ID)
+	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
-	html.Redirect(w, r, route.Path(h.router, "apiKeys"))
+	// The token is not stored, this is the only time it can be displayed.
+	view.Set("apiKeys", apiKeys)
+	view.Set("scopes", model.APIKeyScopes())
+	view.Set("createdAPIKey", apiKey)
+	html.OK(w, r, view.Render("api_keys"))
 }
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/ui/session"
+	"miniflux.app/v2/internal/ui/view"
+)
+
+func (h *handler) showAuditLogsPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !user.IsAdmin {
+		html.Forbidden(w, r)
+		return
+	}
+
+	searchQuery := request.QueryStringParam(r, "q", "")
+	offset := request.QueryIntParam(r, "offset", 0)
+	filter := storage.AuditLogFilter{Search: searchQuery}
+
+	auditLogs, err := h.store.AuditLogs(filter, offset, user.EntriesPerPage)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	auditLogs.UseTimezone(user.Timezone)
+	count := h.store.CountAuditLogs(filter)
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	pagination := getPagination(route.Path(h.router, "auditLogs"), count, offset, user.EntriesPerPage)
+	pagination.SearchQuery = searchQuery
+
+	view.Set("searchQuery", searchQuery)
+	view.Set("auditLogs", auditLogs)
+	view.Set("total", count)
+	view.Set("pagination", pagination)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	html.OK(w, r, view.Render("audit_logs"))
+}
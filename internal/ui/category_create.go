@@ -8,6 +8,8 @@ import (
 
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ui/form"
 	"miniflux.app/v2/internal/ui/session"
 	"miniflux.app/v2/internal/ui/view"
 )
@@ -19,8 +21,17 @@ func (h *handler) showCreateCategoryPage(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	categories, err := h.store.Categories(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
+	view.Set("form", form.CategoryForm{})
+	view.Set("categories", categories.Tree())
+	view.Set("retention_policies", model.RetentionPolicies())
 	view.Set("menu", "categories")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
@@ -27,7 +27,7 @@ func (h *handler) showCategoryListPage(w http.ResponseWriter, r *http.Request) {
 
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
-	view.Set("categories", categories)
+	view.Set("categories", categories.Tree())
 	view.Set("total", len(categories))
 	view.Set("menu", "categories")
 	view.Set("user", user)
@@ -9,6 +9,8 @@ import (
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response/html"
 	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/ui/session"
+	"miniflux.app/v2/internal/validator"
 )
 
 func (h *handler) removeCategory(w http.ResponseWriter, r *http.Request) {
@@ -30,6 +32,13 @@ func (h *handler) removeCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if validationErr := validator.ValidateCategoryRemoval(h.store, user.ID, category.ID); validationErr != nil {
+		sess := session.New(h.store, request.SessionID(r))
+		sess.NewFlashErrorMessage(validationErr.Translate(user.Language))
+		html.Redirect(w, r, route.Path(h.router, "categories"))
+		return
+	}
+
 	if err := h.store.RemoveCategory(user.ID, category.ID); err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -23,17 +23,30 @@ func (h *handler) saveCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	categories, err := h.store.Categories(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
 	categoryForm := form.NewCategoryForm(r)
 
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("form", categoryForm)
+	view.Set("categories", categories.Tree())
+	view.Set("retention_policies", model.RetentionPolicies())
 	view.Set("menu", "categories")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
 	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
 
-	categoryCreationRequest := &model.CategoryCreationRequest{Title: categoryForm.Title}
+	categoryCreationRequest := &model.CategoryCreationRequest{
+		Title:           categoryForm.Title,
+		ParentID:        categoryForm.ParentID,
+		RetentionPolicy: categoryForm.RetentionPolicy,
+		RetentionValue:  categoryForm.RetentionValue,
+	}
 
 	if validationErr := validator.ValidateCategoryCreation(h.store, user.ID, categoryCreationRequest); validationErr != nil {
 		view.Set("errorMessage", validationErr.Translate(user.Language))
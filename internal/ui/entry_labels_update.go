@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ui/form"
+	"miniflux.app/v2/internal/ui/session"
+	"miniflux.app/v2/internal/ui/view"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) updateEntryLabels(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(user.ID)
+	builder.WithEntryID(request.RouteInt64Param(r, "entryID"))
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	entry, err := builder.GetEntry()
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	entryLabelsForm := form.NewEntryLabelsForm(r)
+	entryLabelsRequest := &model.EntryLabelsRequest{LabelIDs: entryLabelsForm.LabelIDs}
+
+	validationErr := validator.ValidateEntryLabels(h.store, user.ID, entryLabelsRequest)
+	if validationErr == nil && entryLabelsForm.NewLabel != "" {
+		label, err := h.store.LabelByTitle(user.ID, entryLabelsForm.NewLabel)
+		if err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		if label == nil {
+			labelCreationRequest := &model.LabelCreationRequest{Title: entryLabelsForm.NewLabel}
+			if validationErr = validator.ValidateLabelCreation(h.store, user.ID, labelCreationRequest); validationErr == nil {
+				if label, err = h.store.CreateLabel(user.ID, labelCreationRequest); err != nil {
+					html.ServerError(w, r, err)
+					return
+				}
+			}
+		}
+
+		if label != nil {
+			entryLabelsRequest.LabelIDs = append(entryLabelsRequest.LabelIDs, label.ID)
+		}
+	}
+
+	if validationErr != nil {
+		labels, err := h.store.Labels(user.ID)
+		if err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		sess := session.New(h.store, request.SessionID(r))
+		view := view.New(h.tpl, r, sess)
+		view.Set("entry", entry)
+		view.Set("labels", labels)
+		view.Set("errorMessage", validationErr.Translate(user.Language))
+		view.Set("menu", "labels")
+		view.Set("user", user)
+		view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+		view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+		html.OK(w, r, view.Render("edit_entry_labels"))
+		return
+	}
+
+	if err := h.store.SetEntryLabels(user.ID, entry.ID, entryLabelsRequest.LabelIDs); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "feedEntry", "feedID", entry.FeedID, "entryID", entry.ID))
+}
@@ -17,6 +17,7 @@ func (h *handler) saveEntry(w http.ResponseWriter, r *http.Request) {
 	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
 	builder.WithEntryID(entryID)
 	builder.WithoutStatus(model.EntryStatusRemoved)
+	builder.WithHighlights()
 
 	entry, err := builder.GetEntry()
 	if err != nil {
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+	"time"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/timezone"
+	"miniflux.app/v2/internal/ui/form"
+	"miniflux.app/v2/internal/ui/session"
+	"miniflux.app/v2/internal/ui/view"
+	"miniflux.app/v2/internal/validator"
+)
+
+// snoozeInputLayout is the format of the datetime-local input field.
+const snoozeInputLayout = "2006-01-02T15:04"
+
+func (h *handler) showSnoozeEntryPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(user.ID)
+	builder.WithEntryID(request.RouteInt64Param(r, "entryID"))
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	entry, err := builder.GetEntry()
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	// Suggest tomorrow morning by default.
+	now := timezone.Now(user.Timezone)
+	tomorrow := time.Date(now.Year(), now.Month(), now.Day()+1, 9, 0, 0, 0, now.Location())
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("entry", entry)
+	view.Set("form", &form.EntrySnoozeForm{SnoozedUntil: tomorrow.Format(snoozeInputLayout)})
+	view.Set("menu", "unread")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	html.OK(w, r, view.Render("snooze_entry"))
+}
+
+func (h *handler) snoozeEntry(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(user.ID)
+	builder.WithEntryID(request.RouteInt64Param(r, "entryID"))
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	entry, err := builder.GetEntry()
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	entrySnoozeForm := form.NewEntrySnoozeForm(r)
+
+	var validationErr *locale.LocalizedError
+	snoozedUntil, err := timezone.Parse(user.Timezone, entrySnoozeForm.SnoozedUntil)
+	if err != nil {
+		validationErr = locale.NewLocalizedError("error.snooze_time_invalid")
+	} else {
+		validationErr = validator.ValidateEntrySnooze(snoozedUntil)
+	}
+
+	if validationErr != nil {
+		sess := session.New(h.store, request.SessionID(r))
+		view := view.New(h.tpl, r, sess)
+		view.Set("entry", entry)
+		view.Set("form", entrySnoozeForm)
+		view.Set("errorMessage", validationErr.Translate(user.Language))
+		view.Set("menu", "unread")
+		view.Set("user", user)
+		view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+		view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+		html.OK(w, r, view.Render("snooze_entry"))
+		return
+	}
+
+	if err := h.store.SnoozeEntry(user.ID, entry.ID, snoozedUntil); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "unread"))
+}
+
+func (h *handler) unsnoozeEntry(w http.ResponseWriter, r *http.Request) {
+	if err := h.store.UnsnoozeEntry(request.UserID(r), request.RouteInt64Param(r, "entryID")); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "snoozedEntries"))
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"log/slog"
+	"net/http"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ui/form"
+	"miniflux.app/v2/internal/ui/session"
+	"miniflux.app/v2/internal/ui/view"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) showFeedBatchPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	h.renderFeedBatchPage(w, r, user, &form.FeedBatchForm{}, "", nil)
+}
+
+func (h *handler) applyFeedBatch(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	feedBatchForm := form.NewFeedBatchForm(r)
+	feedBatchRequest := feedBatchForm.BatchRequest()
+
+	if validationErr := validator.ValidateFeedBatchRequest(h.store, user.ID, feedBatchRequest); validationErr != nil {
+		h.renderFeedBatchPage(w, r, user, feedBatchForm, validationErr.Translate(user.Language), nil)
+		return
+	}
+
+	feeds, err := h.store.FeedsByIDs(user.ID, feedBatchRequest.FeedIDs)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if validationErrors := validator.ValidateFeedBatchFeeds(h.store, user.ID, feedBatchRequest, feeds); len(validationErrors) > 0 {
+		var batchErrors []model.FeedBatchError
+		for _, feedID := range feedBatchRequest.FeedIDs {
+			if validationErr, found := validationErrors[feedID]; found {
+				batchErrors = append(batchErrors, model.FeedBatchError{FeedID: feedID, ErrorMessage: validationErr.Translate(user.Language)})
+			}
+		}
+		h.renderFeedBatchPage(w, r, user, feedBatchForm, "", batchErrors)
+		return
+	}
+
+	switch feedBatchRequest.Action {
+	case model.FeedBatchActionDelete:
+		if err := h.store.RemoveFeeds(user.ID, feedBatchRequest.FeedIDs); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+	case model.FeedBatchActionRefresh:
+		jobs := make(model.JobList, 0, len(feeds))
+		for _, feed := range feeds {
+			jobs = append(jobs, model.Job{UserID: user.ID, FeedID: feed.ID, FeedURL: feed.FeedURL})
+		}
+		go h.pool.Push(jobs)
+	default:
+		for _, feed := range feeds {
+			feedBatchRequest.Patch(feed)
+			feed.ResetErrorCounter()
+		}
+
+		if err := h.store.UpdateFeeds(feeds); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+	}
+
+	slog.Info("Applied a batch action to feeds from the web ui",
+		slog.Int64("user_id", user.ID),
+		slog.String("action", feedBatchRequest.Action),
+		slog.Int("nb_feeds", len(feeds)),
+	)
+
+	sess := session.New(h.store, request.SessionID(r))
+	printer := locale.NewPrinter(user.Language)
+	sess.NewFlashMessage(printer.Plural("alert.feed_batch_applied", len(feeds), len(feeds)))
+	html.Redirect(w, r, route.Path(h.router, "feeds"))
+}
+
+func (h *handler) renderFeedBatchPage(w http.ResponseWriter, r *http.Request, user *model.User, feedBatchForm *form.FeedBatchForm, errorMessage string, batchErrors []model.FeedBatchError) {
+	feeds, err := h.store.Feeds(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	categories, err := h.store.Categories(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	feedTitles := make(map[int64]string, len(feeds))
+	for _, feed := range feeds {
+		feedTitles[feed.ID] = feed.Title
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", feedBatchForm)
+	view.Set("feeds", feeds)
+	view.Set("feedTitles", feedTitles)
+	view.Set("categories", categories)
+	view.Set("actions", model.FeedBatchActions())
+	view.Set("errorMessage", errorMessage)
+	view.Set("batchErrors", batchErrors)
+	view.Set("defaultUserAgent", config.Opts.HTTPClientUserAgent())
+	view.Set("menu", "feeds")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	html.OK(w, r, view.Render("feeds_batch"))
+}
@@ -4,11 +4,14 @@
 package ui // import "miniflux.app/v2/internal/ui"
 
 import (
+	"maps"
 	"net/http"
+	"slices"
 
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/ui/form"
 	"miniflux.app/v2/internal/ui/session"
 	"miniflux.app/v2/internal/ui/view"
@@ -51,15 +54,20 @@ func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 		KeeplistRules:               feed.KeeplistRules,
 		BlockFilterEntryRules:       feed.BlockFilterEntryRules,
 		KeepFilterEntryRules:        feed.KeepFilterEntryRules,
-		Crawler:                     feed.Crawler,
+		Crawler:                     form.InheritableBoolValue(feed.Crawler, feed.InheritCrawler),
+		LLMProcessing:               feed.LLMProcessing,
 		UserAgent:                   feed.UserAgent,
 		Cookie:                      feed.Cookie,
+		HTTPHeaders:                 feed.HTTPHeaders,
+		ClientCertificate:           feed.ClientCertificate,
+		ClientKey:                   feed.ClientKey,
+		CACertificates:              feed.CACertificates,
 		CategoryID:                  feed.Category.ID,
 		Username:                    feed.Username,
 		Password:                    feed.Password,
 		IgnoreHTTPCache:             feed.IgnoreHTTPCache,
 		AllowSelfSignedCertificates: feed.AllowSelfSignedCertificates,
-		FetchViaProxy:               feed.FetchViaProxy,
+		FetchViaProxy:               form.InheritableBoolValue(feed.FetchViaProxy, feed.InheritFetchViaProxy),
 		Disabled:                    feed.Disabled,
 		NoMediaPlayer:               feed.NoMediaPlayer,
 		HideGlobally:                feed.HideGlobally,
@@ -67,18 +75,27 @@ func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 		AppriseServiceURLs:          feed.AppriseServiceURLs,
 		WebhookURL:                  feed.WebhookURL,
 		DisableHTTP2:                feed.DisableHTTP2,
-		NtfyEnabled:                 feed.NtfyEnabled,
+		NtfyEnabled:                 form.InheritableBoolValue(feed.NtfyEnabled, feed.InheritNtfyEnabled),
 		NtfyPriority:                feed.NtfyPriority,
 		NtfyTopic:                   feed.NtfyTopic,
-		PushoverEnabled:             feed.PushoverEnabled,
+		PushoverEnabled:             form.InheritableBoolValue(feed.PushoverEnabled, feed.InheritPushoverEnabled),
 		PushoverPriority:            feed.PushoverPriority,
 		ProxyURL:                    feed.ProxyURL,
+		ProxyPool:                   feed.ProxyPool,
+		HideEntriesWhilePaused:      feed.HideEntriesWhilePaused,
+		RetentionPolicy:             feed.RetentionPolicy,
+		RetentionValue:              feed.RetentionValue,
+	}
+
+	if feed.IsPaused() {
+		feedForm.PausedUntil = feed.PausedUntil.Format("2006-01-02T15:04")
 	}
 
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("form", feedForm)
-	view.Set("categories", categories)
+	view.Set("categories", categories.Tree())
+	view.Set("retention_policies", model.RetentionPolicies())
 	view.Set("feed", feed)
 	view.Set("menu", "feeds")
 	view.Set("user", user)
@@ -86,6 +103,12 @@ func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
 	view.Set("defaultUserAgent", config.Opts.HTTPClientUserAgent())
 	view.Set("hasProxyConfigured", config.Opts.HasHTTPClientProxyURLConfigured())
+	view.Set("proxyPools", proxyPoolNames())
 
 	html.OK(w, r, view.Render("edit_feed"))
 }
+
+// proxyPoolNames returns the sorted names of the proxy pools that feeds can select.
+func proxyPoolNames() []string {
+	return slices.Sorted(maps.Keys(config.Opts.HTTPClientProxyPools()))
+}
@@ -10,6 +10,7 @@ import (
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response/html"
 	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/locale"
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/ui/form"
 	"miniflux.app/v2/internal/ui/session"
@@ -47,24 +48,33 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("form", feedForm)
-	view.Set("categories", categories)
+	view.Set("categories", categories.Tree())
+	view.Set("retention_policies", model.RetentionPolicies())
 	view.Set("feed", feed)
 	view.Set("menu", "feeds")
 	view.Set("user", loggedUser)
 	view.Set("countUnread", h.store.CountUnreadEntries(loggedUser.ID))
 	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(loggedUser.ID))
 	view.Set("defaultUserAgent", config.Opts.HTTPClientUserAgent())
+	view.Set("proxyPools", proxyPoolNames())
 
 	feedModificationRequest := &model.FeedModificationRequest{
-		FeedURL:         model.OptionalString(feedForm.FeedURL),
-		SiteURL:         model.OptionalString(feedForm.SiteURL),
-		Title:           model.OptionalString(feedForm.Title),
-		Description:     model.OptionalString(feedForm.Description),
-		CategoryID:      model.OptionalNumber(feedForm.CategoryID),
-		BlocklistRules:  model.OptionalString(feedForm.BlocklistRules),
-		KeeplistRules:   model.OptionalString(feedForm.KeeplistRules),
-		UrlRewriteRules: model.OptionalString(feedForm.UrlRewriteRules),
-		ProxyURL:        model.OptionalString(feedForm.ProxyURL),
+		FeedURL:           model.OptionalString(feedForm.FeedURL),
+		SiteURL:           model.OptionalString(feedForm.SiteURL),
+		Title:             model.OptionalString(feedForm.Title),
+		Description:       model.OptionalString(feedForm.Description),
+		CategoryID:        model.OptionalNumber(feedForm.CategoryID),
+		BlocklistRules:    model.OptionalString(feedForm.BlocklistRules),
+		KeeplistRules:     model.OptionalString(feedForm.KeeplistRules),
+		UrlRewriteRules:   model.OptionalString(feedForm.UrlRewriteRules),
+		ProxyURL:          model.OptionalString(feedForm.ProxyURL),
+		ProxyPool:         model.SetOptionalField(feedForm.ProxyPool),
+		HTTPHeaders:       model.SetOptionalField(feedForm.HTTPHeaders),
+		ClientCertificate: model.SetOptionalField(feedForm.ClientCertificate),
+		ClientKey:         model.SetOptionalField(feedForm.ClientKey),
+		CACertificates:    model.SetOptionalField(feedForm.CACertificates),
+		RetentionPolicy:   model.SetOptionalField(feedForm.RetentionPolicy),
+		RetentionValue:    model.SetOptionalField(feedForm.RetentionValue),
 	}
 
 	if validationErr := validator.ValidateFeedModification(h.store, loggedUser.ID, feed.ID, feedModificationRequest); validationErr != nil {
@@ -73,7 +83,17 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.store.UpdateFeed(feedForm.Merge(feed))
+	pausedUntil, err := feedForm.ParsePausedUntil(loggedUser.Timezone)
+	if err != nil {
+		view.Set("errorMessage", locale.NewLocalizedError("error.feed_invalid_paused_until").Translate(loggedUser.Language))
+		html.OK(w, r, view.Render("edit_feed"))
+		return
+	}
+
+	feed = feedForm.Merge(feed)
+	feed.PausedUntil = pausedUntil
+
+	err = h.store.UpdateFeed(feed)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
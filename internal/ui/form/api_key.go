@@ -5,17 +5,29 @@ package form // import "miniflux.app/v2/internal/ui/form"
 
 import (
 	"net/http"
+	"slices"
 	"strings"
 )
 
 // APIKeyForm represents the API Key form.
 type APIKeyForm struct {
 	Description string
+	Scopes      []string
+	ExpiresAt   string
+}
+
+// HasScope returns true if the scope is selected in the form.
+func (a APIKeyForm) HasScope(scope string) bool {
+	return slices.Contains(a.Scopes, scope)
 }
 
 // NewAPIKeyForm returns a new APIKeyForm.
 func NewAPIKeyForm(r *http.Request) *APIKeyForm {
+	r.ParseForm()
+
 	return &APIKeyForm{
 		Description: strings.TrimSpace(r.FormValue("description")),
+		Scopes:      r.Form["scopes"],
+		ExpiresAt:   strings.TrimSpace(r.FormValue("expires_at")),
 	}
 }
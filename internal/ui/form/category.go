@@ -5,18 +5,147 @@ package form // import "miniflux.app/v2/internal/ui/form"
 
 import (
 	"net/http"
+	"strconv"
+
+	"miniflux.app/v2/internal/model"
 )
 
 // CategoryForm represents a feed form in the UI
 type CategoryForm struct {
-	Title        string
-	HideGlobally bool
+	Title           string
+	HideGlobally    bool
+	ParentID        int64
+	RetentionPolicy string
+	RetentionValue  int
+
+	// Default feed settings.
+	ScraperRules          string
+	RewriteRules          string
+	UrlRewriteRules       string
+	BlocklistRules        string
+	KeeplistRules         string
+	BlockFilterEntryRules string
+	KeepFilterEntryRules  string
+	UserAgent             string
+	Cookie                string
+	ProxyURL              string
+	FetchViaProxy         bool
+	Crawler               bool
+	AppriseServiceURLs    string
+	WebhookURL            string
+	NtfyEnabled           bool
+	NtfyPriority          int
+	NtfyTopic             string
+	PushoverEnabled       bool
+	PushoverPriority      int
+}
+
+// NewCategoryFormFromCategory returns a CategoryForm prefilled with the category values.
+func NewCategoryFormFromCategory(category *model.Category) *CategoryForm {
+	return &CategoryForm{
+		Title:                 category.Title,
+		HideGlobally:          category.HideGlobally,
+		ParentID:              category.ParentID,
+		RetentionPolicy:       category.RetentionPolicy,
+		RetentionValue:        category.RetentionValue,
+		ScraperRules:          category.ScraperRules,
+		RewriteRules:          category.RewriteRules,
+		UrlRewriteRules:       category.UrlRewriteRules,
+		BlocklistRules:        category.BlocklistRules,
+		KeeplistRules:         category.KeeplistRules,
+		BlockFilterEntryRules: category.BlockFilterEntryRules,
+		KeepFilterEntryRules:  category.KeepFilterEntryRules,
+		UserAgent:             category.UserAgent,
+		Cookie:                category.Cookie,
+		ProxyURL:              category.ProxyURL,
+		FetchViaProxy:         category.FetchViaProxy,
+		Crawler:               category.Crawler,
+		AppriseServiceURLs:    category.AppriseServiceURLs,
+		WebhookURL:            category.WebhookURL,
+		NtfyEnabled:           category.NtfyEnabled,
+		NtfyPriority:          category.NtfyPriority,
+		NtfyTopic:             category.NtfyTopic,
+		PushoverEnabled:       category.PushoverEnabled,
+		PushoverPriority:      category.PushoverPriority,
+	}
+}
+
+// ModificationRequest returns the category modification request of the form.
+func (c CategoryForm) ModificationRequest() *model.CategoryModificationRequest {
+	return &model.CategoryModificationRequest{
+		Title:                 model.SetOptionalField(c.Title),
+		HideGlobally:          model.SetOptionalField(c.HideGlobally),
+		ParentID:              model.SetOptionalField(c.ParentID),
+		RetentionPolicy:       model.SetOptionalField(c.RetentionPolicy),
+		RetentionValue:        model.SetOptionalField(c.RetentionValue),
+		ScraperRules:          model.SetOptionalField(c.ScraperRules),
+		RewriteRules:          model.SetOptionalField(c.RewriteRules),
+		UrlRewriteRules:       model.SetOptionalField(c.UrlRewriteRules),
+		BlocklistRules:        model.SetOptionalField(c.BlocklistRules),
+		KeeplistRules:         model.SetOptionalField(c.KeeplistRules),
+		BlockFilterEntryRules: model.SetOptionalField(c.BlockFilterEntryRules),
+		KeepFilterEntryRules:  model.SetOptionalField(c.KeepFilterEntryRules),
+		UserAgent:             model.SetOptionalField(c.UserAgent),
+		Cookie:                model.SetOptionalField(c.Cookie),
+		ProxyURL:              model.SetOptionalField(c.ProxyURL),
+		FetchViaProxy:         model.SetOptionalField(c.FetchViaProxy),
+		Crawler:               model.SetOptionalField(c.Crawler),
+		AppriseServiceURLs:    model.SetOptionalField(c.AppriseServiceURLs),
+		WebhookURL:            model.SetOptionalField(c.WebhookURL),
+		NtfyEnabled:           model.SetOptionalField(c.NtfyEnabled),
+		NtfyPriority:          model.SetOptionalField(c.NtfyPriority),
+		NtfyTopic:             model.SetOptionalField(c.NtfyTopic),
+		PushoverEnabled:       model.SetOptionalField(c.PushoverEnabled),
+		PushoverPriority:      model.SetOptionalField(c.PushoverPriority),
+	}
 }
 
 // NewCategoryForm returns a new CategoryForm.
 func NewCategoryForm(r *http.Request) *CategoryForm {
+	parentID, err := strconv.ParseInt(r.FormValue("parent_id"), 10, 64)
+	if err != nil {
+		parentID = 0
+	}
+
+	retentionValue, err := strconv.Atoi(r.FormValue("retention_value"))
+	if err != nil {
+		retentionValue = 0
+	}
+
+	ntfyPriority, err := strconv.Atoi(r.FormValue("ntfy_priority"))
+	if err != nil {
+		ntfyPriority = 0
+	}
+
+	pushoverPriority, err := strconv.Atoi(r.FormValue("pushover_priority"))
+	if err != nil {
+		pushoverPriority = 0
+	}
+
 	return &CategoryForm{
-		Title:        r.FormValue("title"),
-		HideGlobally: r.FormValue("hide_globally") == "1",
+		Title:                 r.FormValue("title"),
+		HideGlobally:          r.FormValue("hide_globally") == "1",
+		ParentID:              parentID,
+		RetentionPolicy:       r.FormValue("retention_policy"),
+		RetentionValue:        retentionValue,
+		ScraperRules:          r.FormValue("scraper_rules"),
+		RewriteRules:          r.FormValue("rewrite_rules"),
+		UrlRewriteRules:       r.FormValue("urlrewrite_rules"),
+		BlocklistRules:        r.FormValue("blocklist_rules"),
+		KeeplistRules:         r.FormValue("keeplist_rules"),
+		BlockFilterEntryRules: r.FormValue("block_filter_entry_rules"),
+		KeepFilterEntryRules:  r.FormValue("keep_filter_entry_rules"),
+		UserAgent:             r.FormValue("user_agent"),
+		Cookie:                r.FormValue("cookie"),
+		ProxyURL:              r.FormValue("proxy_url"),
+		FetchViaProxy:         r.FormValue("fetch_via_proxy") == "1",
+		Crawler:               r.FormValue("crawler") == "1",
+		AppriseServiceURLs:    r.FormValue("apprise_service_urls"),
+		WebhookURL:            r.FormValue("webhook_url"),
+		NtfyEnabled:           r.FormValue("ntfy_enabled") == "1",
+		NtfyPriority:          ntfyPriority,
+		NtfyTopic:             r.FormValue("ntfy_topic"),
+		PushoverEnabled:       r.FormValue("pushover_enabled") == "1",
+		PushoverPriority:      pushoverPriority,
 	}
 }
@@ -6,11 +6,16 @@ package form // import "miniflux.app/v2/internal/ui/form"
 import (
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/timezone"
 )
 
 // FeedForm represents a feed form in the UI
+//
+// Crawler, FetchViaProxy, NtfyEnabled and PushoverEnabled are "1", "0", or empty to use the category setting.
 type FeedForm struct {
 	FeedURL                     string
 	SiteURL                     string
@@ -23,15 +28,20 @@ type FeedForm struct {
 	KeeplistRules               string
 	BlockFilterEntryRules       string
 	KeepFilterEntryRules        string
-	Crawler                     bool
+	Crawler                     string
+	LLMProcessing               bool
 	UserAgent                   string
 	Cookie                      string
+	HTTPHeaders                 string
+	ClientCertificate           string
+	ClientKey                   string
+	CACertificates              string
 	CategoryID                  int64
 	Username                    string
 	Password                    string
 	IgnoreHTTPCache             bool
 	AllowSelfSignedCertificates bool
-	FetchViaProxy               bool
+	FetchViaProxy               string
 	Disabled                    bool
 	NoMediaPlayer               bool
 	HideGlobally                bool
@@ -39,12 +49,17 @@ type FeedForm struct {
 	AppriseServiceURLs          string
 	WebhookURL                  string
 	DisableHTTP2                bool
-	NtfyEnabled                 bool
+	NtfyEnabled                 string
 	NtfyPriority                int
 	NtfyTopic                   string
-	PushoverEnabled             bool
+	PushoverEnabled             string
 	PushoverPriority            int
 	ProxyURL                    string
+	ProxyPool                   string
+	PausedUntil                 string
+	HideEntriesWhilePaused      bool
+	RetentionPolicy             string
+	RetentionValue              int
 }
 
 // Merge updates the fields of the given feed.
@@ -61,31 +76,54 @@ func (f FeedForm) Merge(feed *model.Feed) *model.Feed {
 	feed.KeeplistRules = f.KeeplistRules
 	feed.BlockFilterEntryRules = f.BlockFilterEntryRules
 	feed.KeepFilterEntryRules = f.KeepFilterEntryRules
-	feed.Crawler = f.Crawler
+	feed.Crawler, feed.InheritCrawler = inheritableBool(f.Crawler)
+	feed.LLMProcessing = f.LLMProcessing
 	feed.UserAgent = f.UserAgent
 	feed.Cookie = f.Cookie
+	feed.HTTPHeaders = f.HTTPHeaders
+	feed.ClientCertificate = f.ClientCertificate
+	feed.ClientKey = f.ClientKey
+	feed.CACertificates = f.CACertificates
 	feed.ParsingErrorCount = 0
 	feed.ParsingErrorMsg = ""
 	feed.Username = f.Username
 	feed.Password = f.Password
 	feed.IgnoreHTTPCache = f.IgnoreHTTPCache
 	feed.AllowSelfSignedCertificates = f.AllowSelfSignedCertificates
-	feed.FetchViaProxy = f.FetchViaProxy
+	feed.FetchViaProxy, feed.InheritFetchViaProxy = inheritableBool(f.FetchViaProxy)
 	feed.Disabled = f.Disabled
 	feed.NoMediaPlayer = f.NoMediaPlayer
 	feed.HideGlobally = f.HideGlobally
 	feed.AppriseServiceURLs = f.AppriseServiceURLs
 	feed.WebhookURL = f.WebhookURL
 	feed.DisableHTTP2 = f.DisableHTTP2
-	feed.NtfyEnabled = f.NtfyEnabled
+	feed.NtfyEnabled, feed.InheritNtfyEnabled = inheritableBool(f.NtfyEnabled)
 	feed.NtfyPriority = f.NtfyPriority
 	feed.NtfyTopic = f.NtfyTopic
-	feed.PushoverEnabled = f.PushoverEnabled
+	feed.PushoverEnabled, feed.InheritPushoverEnabled = inheritableBool(f.PushoverEnabled)
 	feed.PushoverPriority = f.PushoverPriority
 	feed.ProxyURL = f.ProxyURL
+	feed.ProxyPool = f.ProxyPool
+	feed.HideEntriesWhilePaused = f.HideEntriesWhilePaused
+	feed.RetentionPolicy = f.RetentionPolicy
+	feed.RetentionValue = f.RetentionValue
 	return feed
 }
 
+// ParsePausedUntil returns the pause date interpreted in the given timezone, nil when the feed is not paused.
+func (f FeedForm) ParsePausedUntil(tz string) (*time.Time, error) {
+	if f.PausedUntil == "" {
+		return nil, nil
+	}
+
+	pausedUntil, err := timezone.Parse(tz, f.PausedUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pausedUntil, nil
+}
+
 // NewFeedForm parses the HTTP request and returns a FeedForm
 func NewFeedForm(r *http.Request) *FeedForm {
 	categoryID, err := strconv.Atoi(r.FormValue("category_id"))
@@ -103,6 +141,11 @@ func NewFeedForm(r *http.Request) *FeedForm {
 		pushoverPriority = 0
 	}
 
+	retentionValue, err := strconv.Atoi(r.FormValue("retention_value"))
+	if err != nil {
+		retentionValue = 0
+	}
+
 	return &FeedForm{
 		FeedURL:                     r.FormValue("feed_url"),
 		SiteURL:                     r.FormValue("site_url"),
@@ -111,30 +154,40 @@ func NewFeedForm(r *http.Request) *FeedForm {
 		ScraperRules:                r.FormValue("scraper_rules"),
 		UserAgent:                   r.FormValue("user_agent"),
 		Cookie:                      r.FormValue("cookie"),
+		HTTPHeaders:                 r.FormValue("http_headers"),
+		ClientCertificate:           strings.TrimSpace(r.FormValue("client_certificate")),
+		ClientKey:                   strings.TrimSpace(r.FormValue("client_key")),
+		CACertificates:              strings.TrimSpace(r.FormValue("ca_certificates")),
 		RewriteRules:                r.FormValue("rewrite_rules"),
 		UrlRewriteRules:             r.FormValue("urlrewrite_rules"),
 		BlocklistRules:              r.FormValue("blocklist_rules"),
 		KeeplistRules:               r.FormValue("keeplist_rules"),
 		BlockFilterEntryRules:       r.FormValue("block_filter_entry_rules"),
 		KeepFilterEntryRules:        r.FormValue("keep_filter_entry_rules"),
-		Crawler:                     r.FormValue("crawler") == "1",
+		Crawler:                     r.FormValue("crawler"),
+		LLMProcessing:               r.FormValue("llm_processing") == "1",
 		CategoryID:                  int64(categoryID),
 		Username:                    r.FormValue("feed_username"),
 		Password:                    r.FormValue("feed_password"),
 		IgnoreHTTPCache:             r.FormValue("ignore_http_cache") == "1",
 		AllowSelfSignedCertificates: r.FormValue("allow_self_signed_certificates") == "1",
-		FetchViaProxy:               r.FormValue("fetch_via_proxy") == "1",
+		FetchViaProxy:               r.FormValue("fetch_via_proxy"),
 		Disabled:                    r.FormValue("disabled") == "1",
 		NoMediaPlayer:               r.FormValue("no_media_player") == "1",
 		HideGlobally:                r.FormValue("hide_globally") == "1",
 		AppriseServiceURLs:          r.FormValue("apprise_service_urls"),
 		WebhookURL:                  r.FormValue("webhook_url"),
 		DisableHTTP2:                r.FormValue("disable_http2") == "1",
-		NtfyEnabled:                 r.FormValue("ntfy_enabled") == "1",
+		NtfyEnabled:                 r.FormValue("ntfy_enabled"),
 		NtfyPriority:                ntfyPriority,
 		NtfyTopic:                   r.FormValue("ntfy_topic"),
-		PushoverEnabled:             r.FormValue("pushover_enabled") == "1",
+		PushoverEnabled:             r.FormValue("pushover_enabled"),
 		PushoverPriority:            pushoverPriority,
 		ProxyURL:                    r.FormValue("proxy_url"),
+		ProxyPool:                   r.FormValue("proxy_pool"),
+		PausedUntil:                 r.FormValue("paused_until"),
+		HideEntriesWhilePaused:      r.FormValue("hide_entries_while_paused") == "1",
+		RetentionPolicy:             r.FormValue("retention_policy"),
+		RetentionValue:              retentionValue,
 	}
 }
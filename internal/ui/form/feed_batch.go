@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package form // import "miniflux.app/v2/internal/ui/form"
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// FeedBatchForm represents the form used to apply an action to many feeds.
+// Empty values of the update action leave the feed settings unchanged.
+type FeedBatchForm struct {
+	FeedIDs       []int64
+	Action        string
+	CategoryID    int64
+	Crawler       string
+	FetchViaProxy string
+	HideGlobally  string
+	UserAgent     string
+	Cookie        string
+	ProxyURL      string
+}
+
+// IsSelected returns true if the feed is part of the selection.
+func (f FeedBatchForm) IsSelected(feedID int64) bool {
+	for _, id := range f.FeedIDs {
+		if id == feedID {
+			return true
+		}
+	}
+	return false
+}
+
+// BatchRequest returns the batch request built from the form.
+func (f FeedBatchForm) BatchRequest() *model.FeedBatchRequest {
+	request := &model.FeedBatchRequest{
+		FeedIDs:    f.FeedIDs,
+		Action:     f.Action,
+		CategoryID: f.CategoryID,
+	}
+
+	if f.Action == model.FeedBatchActionUpdate {
+		changes := &model.FeedModificationRequest{
+			Crawler:       optionalBool(f.Crawler),
+			FetchViaProxy: optionalBool(f.FetchViaProxy),
+			HideGlobally:  optionalBool(f.HideGlobally),
+		}
+
+		if f.UserAgent != "" {
+			changes.UserAgent = &f.UserAgent
+		}
+
+		if f.Cookie != "" {
+			changes.Cookie = &f.Cookie
+		}
+
+		if f.ProxyURL != "" {
+			changes.ProxyURL = &f.ProxyURL
+		}
+
+		request.Changes = changes
+	}
+
+	return request
+}
+
+// NewFeedBatchForm parses the HTTP request and returns a FeedBatchForm.
+func NewFeedBatchForm(r *http.Request) *FeedBatchForm {
+	r.ParseForm()
+
+	var feedIDs []int64
+	for _, value := range r.Form["feed_id"] {
+		if feedID, err := strconv.ParseInt(value, 10, 64); err == nil {
+			feedIDs = append(feedIDs, feedID)
+		}
+	}
+
+	categoryID, err := strconv.ParseInt(r.FormValue("category_id"), 10, 64)
+	if err != nil {
+		categoryID = 0
+	}
+
+	return &FeedBatchForm{
+		FeedIDs:       feedIDs,
+		Action:        r.FormValue("action"),
+		CategoryID:    categoryID,
+		Crawler:       r.FormValue("crawler"),
+		FetchViaProxy: r.FormValue("fetch_via_proxy"),
+		HideGlobally:  r.FormValue("hide_globally"),
+		UserAgent:     strings.TrimSpace(r.FormValue("user_agent")),
+		Cookie:        strings.TrimSpace(r.FormValue("cookie")),
+		ProxyURL:      strings.TrimSpace(r.FormValue("proxy_url")),
+	}
+}
+
+func optionalBool(value string) *bool {
+	var result bool
+	switch value {
+	case "1":
+		result = true
+	case "0":
+		result = false
+	default:
+		return nil
+	}
+	return &result
+}
+
+// InheritableBoolValue returns the form value of a feed flag: "1", "0", or empty when the category flag is inherited.
+func InheritableBoolValue(value, inherited bool) string {
+	switch {
+	case inherited:
+		return ""
+	case value:
+		return "1"
+	default:
+		return "0"
+	}
+}
+
+// inheritableBool returns the value of a feed flag from its form value and whether the category flag is inherited.
+func inheritableBool(value string) (bool, bool) {
+	return value == "1", value == ""
+}
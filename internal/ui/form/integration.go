@@ -128,6 +128,12 @@ type IntegrationForm struct {
 	PushoverToken                    string
 	PushoverDevice                   string
 	PushoverPrefix                   string
+	LLMEnabled                       bool
+	LLMURL                           string
+	LLMAPIKey                        string
+	LLMModel                         string
+	LLMSummarize                     bool
+	LLMTagging                       bool
 }
 
 // Merge copy form values to the model.
@@ -245,6 +251,12 @@ func (i IntegrationForm) Merge(integration *model.Integration) {
 	integration.PushoverToken = i.PushoverToken
 	integration.PushoverDevice = i.PushoverDevice
 	integration.PushoverPrefix = i.PushoverPrefix
+	integration.LLMEnabled = i.LLMEnabled
+	integration.LLMURL = i.LLMURL
+	integration.LLMAPIKey = i.LLMAPIKey
+	integration.LLMModel = i.LLMModel
+	integration.LLMSummarize = i.LLMSummarize
+	integration.LLMTagging = i.LLMTagging
 }
 
 // NewIntegrationForm returns a new IntegrationForm.
@@ -365,6 +377,12 @@ func NewIntegrationForm(r *http.Request) *IntegrationForm {
 		PushoverToken:                    r.FormValue("pushover_token"),
 		PushoverDevice:                   r.FormValue("pushover_device"),
 		PushoverPrefix:                   r.FormValue("pushover_prefix"),
+		LLMEnabled:                       r.FormValue("llm_enabled") == "1",
+		LLMURL:                           r.FormValue("llm_url"),
+		LLMAPIKey:                        r.FormValue("llm_api_key"),
+		LLMModel:                         r.FormValue("llm_model"),
+		LLMSummarize:                     r.FormValue("llm_summarize") == "1",
+		LLMTagging:                       r.FormValue("llm_tagging") == "1",
 	}
 }
 
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package form // import "miniflux.app/v2/internal/ui/form"
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// InvitationForm represents the invitation form.
+type InvitationForm struct {
+	Description string
+	Language    string
+	Categories  string
+	MaxUses     int
+	ExpiresAt   string
+}
+
+// CategoryTitles returns the titles of the categories to create, one per line.
+func (i InvitationForm) CategoryTitles() []string {
+	var titles []string
+	for line := range strings.Lines(i.Categories) {
+		if title := strings.TrimSpace(line); title != "" {
+			titles = append(titles, title)
+		}
+	}
+	return titles
+}
+
+// NewInvitationForm returns a new InvitationForm.
+func NewInvitationForm(r *http.Request) *InvitationForm {
+	maxUses, err := strconv.Atoi(r.FormValue("max_uses"))
+	if err != nil {
+		maxUses = -1
+	}
+
+	return &InvitationForm{
+		Description: strings.TrimSpace(r.FormValue("description")),
+		Language:    r.FormValue("language"),
+		Categories:  r.FormValue("categories"),
+		MaxUses:     maxUses,
+		ExpiresAt:   strings.TrimSpace(r.FormValue("expires_at")),
+	}
+}
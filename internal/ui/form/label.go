@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package form // import "miniflux.app/v2/internal/ui/form"
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// LabelForm represents a label form in the UI.
+type LabelForm struct {
+	Title string
+}
+
+// NewLabelForm returns a new LabelForm.
+func NewLabelForm(r *http.Request) *LabelForm {
+	return &LabelForm{
+		Title: r.FormValue("title"),
+	}
+}
+
+// EntryLabelsForm represents the form used to attach labels to an entry.
+type EntryLabelsForm struct {
+	LabelIDs []int64
+	NewLabel string
+}
+
+// NewEntryLabelsForm returns a new EntryLabelsForm.
+func NewEntryLabelsForm(r *http.Request) *EntryLabelsForm {
+	newLabel := r.FormValue("new_label")
+
+	var labelIDs []int64
+	for _, value := range r.Form["label_id"] {
+		if labelID, err := strconv.ParseInt(value, 10, 64); err == nil {
+			labelIDs = append(labelIDs, labelID)
+		}
+	}
+
+	return &EntryLabelsForm{
+		LabelIDs: labelIDs,
+		NewLabel: newLabel,
+	}
+}
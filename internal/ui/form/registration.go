@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package form // import "miniflux.app/v2/internal/ui/form"
+
+import (
+	"net/http"
+	"strings"
+
+	"miniflux.app/v2/internal/locale"
+)
+
+// RegistrationForm represents the form used to create an account with an invitation or to request one.
+type RegistrationForm struct {
+	Username     string
+	Password     string
+	Confirmation string
+}
+
+// Validate makes sure the form is not empty and the passwords match.
+func (r RegistrationForm) Validate() *locale.LocalizedError {
+	if r.Username == "" || r.Password == "" || r.Confirmation == "" {
+		return locale.NewLocalizedError("error.fields_mandatory")
+	}
+
+	if r.Password != r.Confirmation {
+		return locale.NewLocalizedError("error.different_passwords")
+	}
+
+	return nil
+}
+
+// NewRegistrationForm returns a new RegistrationForm.
+func NewRegistrationForm(r *http.Request) *RegistrationForm {
+	return &RegistrationForm{
+		Username:     strings.TrimSpace(r.FormValue("username")),
+		Password:     r.FormValue("password"),
+		Confirmation: r.FormValue("confirmation"),
+	}
+}
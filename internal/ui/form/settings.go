@@ -42,6 +42,7 @@ type SettingsForm struct {
 	EntrySwipe             bool
 	GestureNav             string
 	DisplayMode            string
+	EntryListLayout        string
 	DefaultReadingSpeed    int
 	CJKReadingSpeed        int
 	DefaultHomePage        string
@@ -54,6 +55,8 @@ type SettingsForm struct {
 	KeepFilterEntryRules      string
 	AlwaysOpenExternalLinks   bool
 	OpenExternalLinksInNewTab bool
+	RetentionPolicy           string
+	RetentionValue            int
 }
 
 // MarkAsReadBehavior returns the MarkReadBehavior from the given MarkReadOnView and MarkReadOnMediaPlayerCompletion values.
@@ -109,6 +112,7 @@ func (s *SettingsForm) Merge(user *model.User) *model.User {
 	user.EntrySwipe = s.EntrySwipe
 	user.GestureNav = s.GestureNav
 	user.DisplayMode = s.DisplayMode
+	user.EntryListLayout = s.EntryListLayout
 	user.CJKReadingSpeed = s.CJKReadingSpeed
 	user.DefaultReadingSpeed = s.DefaultReadingSpeed
 	user.DefaultHomePage = s.DefaultHomePage
@@ -118,6 +122,8 @@ func (s *SettingsForm) Merge(user *model.User) *model.User {
 	user.KeepFilterEntryRules = s.KeepFilterEntryRules
 	user.AlwaysOpenExternalLinks = s.AlwaysOpenExternalLinks
 	user.OpenExternalLinksInNewTab = s.OpenExternalLinksInNewTab
+	user.RetentionPolicy = s.RetentionPolicy
+	user.RetentionValue = s.RetentionValue
 
 	MarkReadOnView, MarkReadOnMediaPlayerCompletion := extractMarkAsReadBehavior(s.MarkReadBehavior)
 	user.MarkReadOnView = MarkReadOnView
@@ -182,6 +188,10 @@ func NewSettingsForm(r *http.Request) *SettingsForm {
 	if err != nil {
 		mediaPlaybackRate = 1
 	}
+	retentionValue, err := strconv.ParseInt(r.FormValue("retention_value"), 10, 0)
+	if err != nil {
+		retentionValue = 0
+	}
 	return &SettingsForm{
 		Username:                  r.FormValue("username"),
 		Password:                  r.FormValue("password"),
@@ -200,6 +210,7 @@ func NewSettingsForm(r *http.Request) *SettingsForm {
 		EntrySwipe:                r.FormValue("entry_swipe") == "1",
 		GestureNav:                r.FormValue("gesture_nav"),
 		DisplayMode:               r.FormValue("display_mode"),
+		EntryListLayout:           r.FormValue("entry_list_layout"),
 		DefaultReadingSpeed:       int(defaultReadingSpeed),
 		CJKReadingSpeed:           int(cjkReadingSpeed),
 		DefaultHomePage:           r.FormValue("default_home_page"),
@@ -211,5 +222,7 @@ func NewSettingsForm(r *http.Request) *SettingsForm {
 		KeepFilterEntryRules:      r.FormValue("keep_filter_entry_rules"),
 		AlwaysOpenExternalLinks:   r.FormValue("always_open_external_links") == "1",
 		OpenExternalLinksInNewTab: r.FormValue("open_external_links_in_new_tab") == "1",
+		RetentionPolicy:           r.FormValue("retention_policy"),
+		RetentionValue:            int(retentionValue),
 	}
 }
@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package form // import "miniflux.app/v2/internal/ui/form"
+
+import (
+	"net/http"
+)
+
+// EntrySnoozeForm represents the form used to snooze an entry.
+type EntrySnoozeForm struct {
+	SnoozedUntil string
+}
+
+// NewEntrySnoozeForm returns a new EntrySnoozeForm.
+func NewEntrySnoozeForm(r *http.Request) *EntrySnoozeForm {
+	return &EntrySnoozeForm{
+		SnoozedUntil: r.FormValue("snoozed_until"),
+	}
+}
@@ -6,8 +6,10 @@ package form // import "miniflux.app/v2/internal/ui/form"
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/validator"
 )
 
@@ -20,6 +22,10 @@ type SubscriptionForm struct {
 	AllowSelfSignedCertificates bool
 	UserAgent                   string
 	Cookie                      string
+	HTTPHeaders                 string
+	ClientCertificate           string
+	ClientKey                   string
+	CACertificates              string
 	Username                    string
 	Password                    string
 	ScraperRules                string
@@ -31,6 +37,7 @@ type SubscriptionForm struct {
 	KeepFilterEntryRules        string
 	DisableHTTP2                bool
 	ProxyURL                    string
+	ProxyPool                   string
 }
 
 // Validate makes sure the form values locale.are valid.
@@ -59,6 +66,22 @@ func (s *SubscriptionForm) Validate() *locale.LocalizedError {
 		return locale.NewLocalizedError("error.invalid_feed_proxy_url")
 	}
 
+	if err := validator.ValidateProxyPool(s.ProxyPool); err != nil {
+		return err
+	}
+
+	if err := validator.ValidateHTTPHeaders(s.HTTPHeaders); err != nil {
+		return err
+	}
+
+	if err := validator.ValidateClientCertificate(s.ClientCertificate, s.ClientKey); err != nil {
+		return err
+	}
+
+	if err := validator.ValidateCACertificates(s.CACertificates); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -77,6 +100,10 @@ func NewSubscriptionForm(r *http.Request) *SubscriptionForm {
 		FetchViaProxy:               r.FormValue("fetch_via_proxy") == "1",
 		UserAgent:                   r.FormValue("user_agent"),
 		Cookie:                      r.FormValue("cookie"),
+		HTTPHeaders:                 r.FormValue("http_headers"),
+		ClientCertificate:           strings.TrimSpace(r.FormValue("client_certificate")),
+		ClientKey:                   strings.TrimSpace(r.FormValue("client_key")),
+		CACertificates:              strings.TrimSpace(r.FormValue("ca_certificates")),
 		Username:                    r.FormValue("feed_username"),
 		Password:                    r.FormValue("feed_password"),
 		ScraperRules:                r.FormValue("scraper_rules"),
@@ -88,5 +115,15 @@ func NewSubscriptionForm(r *http.Request) *SubscriptionForm {
 		BlockFilterEntryRules:       r.FormValue("block_filter_entry_rules"),
 		DisableHTTP2:                r.FormValue("disable_http2") == "1",
 		ProxyURL:                    r.FormValue("proxy_url"),
+		ProxyPool:                   r.FormValue("proxy_pool"),
+	}
+}
+
+// RequestHeaders returns the custom HTTP headers sent during the discovery.
+func (s *SubscriptionForm) RequestHeaders() http.Header {
+	headers, err := model.ParseHTTPHeaders(s.HTTPHeaders)
+	if err != nil {
+		return nil
 	}
+	return headers
 }
@@ -16,6 +16,7 @@ type UserForm struct {
 	Password     string
 	Confirmation string
 	IsAdmin      bool
+	TOTPRequired bool
 }
 
 // ValidateCreation validates user creation.
@@ -54,6 +55,7 @@ func (u UserForm) ValidateModification() *locale.LocalizedError {
 func (u UserForm) Merge(user *model.User) *model.User {
 	user.Username = u.Username
 	user.IsAdmin = u.IsAdmin
+	user.TOTPRequired = u.TOTPRequired
 
 	if u.Password != "" {
 		user.Password = u.Password
@@ -69,5 +71,6 @@ func NewUserForm(r *http.Request) *UserForm {
 		Password:     r.FormValue("password"),
 		Confirmation: r.FormValue("confirmation"),
 		IsAdmin:      r.FormValue("is_admin") == "1",
+		TOTPRequired: r.FormValue("totp_required") == "1",
 	}
 }
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/json"
+)
+
+func (h *handler) removeHighlight(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	highlightID := request.RouteInt64Param(r, "highlightID")
+
+	highlight, err := h.store.Highlight(userID, highlightID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if highlight == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveHighlight(userID, highlight.ID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
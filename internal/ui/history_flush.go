@@ -8,6 +8,7 @@ import (
 
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response/json"
+	"miniflux.app/v2/internal/model"
 )
 
 func (h *handler) flushHistory(w http.ResponseWriter, r *http.Request) {
@@ -17,5 +18,7 @@ func (h *handler) flushHistory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.store.Audit(request.UserID(r), model.AuditActionHistoryFlush, "", request.ClientIP(r))
+
 	json.OK(w, r, "OK")
 }
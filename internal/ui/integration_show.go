@@ -141,6 +141,12 @@ func (h *handler) showIntegrationPage(w http.ResponseWriter, r *http.Request) {
 		PushoverToken:                    integration.PushoverToken,
 		PushoverDevice:                   integration.PushoverDevice,
 		PushoverPrefix:                   integration.PushoverPrefix,
+		LLMEnabled:                       integration.LLMEnabled,
+		LLMURL:                           integration.LLMURL,
+		LLMAPIKey:                        integration.LLMAPIKey,
+		LLMModel:                         integration.LLMModel,
+		LLMSummarize:                     integration.LLMSummarize,
+		LLMTagging:                       integration.LLMTagging,
 	}
 
 	sess := session.New(h.store, request.SessionID(r))
@@ -13,6 +13,7 @@ import (
 	"miniflux.app/v2/internal/http/response/html"
 	"miniflux.app/v2/internal/http/route"
 	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/ui/form"
 	"miniflux.app/v2/internal/ui/session"
 )
@@ -86,12 +87,22 @@ func (h *handler) updateIntegration(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if integrationForm.LLMEnabled {
+		if integrationForm.LLMURL == "" || integrationForm.LLMModel == "" {
+			sess.NewFlashErrorMessage(printer.Print("error.llm_missing_required_fields"))
+			html.Redirect(w, r, route.Path(h.router, "integrations"))
+			return
+		}
+	}
+
 	err = h.store.UpdateIntegration(integration)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
+	h.store.Audit(request.UserID(r), model.AuditActionIntegrationUpdate, "", request.ClientIP(r))
+
 	sess.NewFlashMessage(printer.Print("alert.prefs_saved"))
 	html.Redirect(w, r, route.Path(h.router, "integrations"))
 }
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/model"
+)
+
+func (h *handler) removeInvitation(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !user.IsAdmin {
+		html.Forbidden(w, r)
+		return
+	}
+
+	invitation, err := h.store.Invitation(request.RouteInt64Param(r, "invitationID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if invitation == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.DeleteInvitation(invitation.ID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	h.store.Audit(request.UserID(r), model.AuditActionInvitationRemove, invitation.Description, request.ClientIP(r))
+
+	html.Redirect(w, r, route.Path(h.router, "invitations"))
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/timezone"
+	"miniflux.app/v2/internal/ui/form"
+	"miniflux.app/v2/internal/ui/session"
+	"miniflux.app/v2/internal/ui/view"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) saveInvitation(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !user.IsAdmin {
+		html.Forbidden(w, r)
+		return
+	}
+
+	invitationForm := form.NewInvitationForm(r)
+	invitationCreationRequest := &model.InvitationCreationRequest{
+		Description: invitationForm.Description,
+		Language:    invitationForm.Language,
+		Categories:  invitationForm.CategoryTitles(),
+		MaxUses:     invitationForm.MaxUses,
+	}
+
+	var validationErr *locale.LocalizedError
+	if invitationForm.ExpiresAt != "" {
+		if expiresAt, err := timezone.Parse(user.Timezone, invitationForm.ExpiresAt); err != nil {
+			validationErr = locale.NewLocalizedError("error.invitation_invalid_expiration")
+		} else {
+			invitationCreationRequest.ExpiresAt = &expiresAt
+		}
+	}
+
+	if validationErr == nil {
+		validationErr = validator.ValidateInvitationCreation(invitationCreationRequest)
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("languages", locale.AvailableLanguages)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	if validationErr != nil {
+		view.Set("form", invitationForm)
+		view.Set("errorMessage", validationErr.Translate(user.Language))
+		html.OK(w, r, view.Render("create_invitation"))
+		return
+	}
+
+	invitation, err := h.store.CreateInvitation(invitationCreationRequest)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	h.store.Audit(request.UserID(r), model.AuditActionInvitationCreate, invitation.Description, request.ClientIP(r))
+
+	invitations, err := h.store.Invitations()
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	// The token is not stored, this is the only time the invitation link can be displayed.
+	view.Set("invitations", invitations)
+	view.Set("createdInvitation", invitation)
+	html.OK(w, r, view.Render("invitations"))
+}
@@ -6,22 +6,30 @@ package ui // import "miniflux.app/v2/internal/ui"
 import (
 	"log/slog"
 	"net/http"
+	"time"
 
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/http/cookie"
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response/html"
 	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/ldap"
 	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ratelimit"
 	"miniflux.app/v2/internal/ui/form"
 	"miniflux.app/v2/internal/ui/session"
 	"miniflux.app/v2/internal/ui/view"
 )
 
+// totpPendingLoginLifetime is how long the two-factor authentication code is accepted after the password check.
+const totpPendingLoginLifetime = 5 * time.Minute
+
 func (h *handler) checkLogin(w http.ResponseWriter, r *http.Request) {
 	clientIP := request.ClientIP(r)
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
+	view.Set("openRegistration", h.store.IsOpenRegistrationEnabled())
 
 	if config.Opts.DisableLocalAuth() {
 		slog.Warn("blocking local auth login attempt, local auth is disabled",
@@ -49,7 +57,21 @@ func (h *handler) checkLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.store.CheckPassword(authForm.Username, authForm.Password); err != nil {
+	limiter := ratelimit.AuthenticationLimiterInstance
+	if !limiter.Allow(clientIP, authForm.Username) {
+		slog.Warn("Login attempt refused because of too many failed authentication attempts",
+			slog.Bool("authentication_failed", true),
+			slog.String("client_ip", clientIP),
+			slog.String("user_agent", r.UserAgent()),
+			slog.String("username", authForm.Username),
+		)
+		view.Set("errorMessage", locale.NewLocalizedError("error.too_many_login_attempts").Translate(request.UserLanguage(r)))
+		html.OK(w, r, view.Render("login"))
+		return
+	}
+
+	if err := ldap.CheckPassword(h.store, authForm.Username, authForm.Password, clientIP); err != nil {
+		limiter.RecordFailure(ratelimit.MethodWeb, clientIP, authForm.Username)
 		slog.Warn("Incorrect username or password",
 			slog.Bool("authentication_failed", true),
 			slog.String("client_ip", clientIP),
@@ -61,21 +83,45 @@ func (h *handler) checkLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	sessionToken, userID, err := h.store.CreateUserSessionFromUsername(authForm.Username, r.UserAgent(), clientIP)
+	user, err := h.store.UserByUsername(authForm.Username)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
-	slog.Info("User authenticated successfully with username/password",
-		slog.Bool("authentication_successful", true),
-		slog.String("client_ip", clientIP),
-		slog.String("user_agent", r.UserAgent()),
-		slog.Int64("user_id", userID),
-		slog.String("username", authForm.Username),
-	)
+	if user == nil {
+		html.OK(w, r, view.Render("login"))
+		return
+	}
 
-	h.store.SetLastLogin(userID)
+	if user.TOTPEnabled {
+		slog.Info("Password verified, waiting for the two-factor authentication code",
+			slog.String("client_ip", clientIP),
+			slog.String("user_agent", r.UserAgent()),
+			slog.Int64("user_id", user.ID),
+			slog.String("username", user.Username),
+		)
+
+		sess.SetTOTPPendingLogin(user.ID)
+		view.Set("errorMessage", "")
+		html.OK(w, r, view.Render("login_totp"))
+		return
+	}
+
+	h.openUserSession(w, r, sess, user, "User authenticated successfully with username/password")
+}
+
+func (h *handler) checkLoginTOTP(w http.ResponseWriter, r *http.Request) {
+	clientIP := request.ClientIP(r)
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+
+	userID, passwordCheckedAt := request.TOTPPendingLogin(r)
+	if userID == 0 || time.Since(passwordCheckedAt) > totpPendingLoginLifetime {
+		sess.ClearTOTPPendingLogin()
+		html.Redirect(w, r, route.Path(h.router, "login"))
+		return
+	}
 
 	user, err := h.store.UserByID(userID)
 	if err != nil {
@@ -83,6 +129,72 @@ func (h *handler) checkLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if user == nil {
+		html.Redirect(w, r, route.Path(h.router, "login"))
+		return
+	}
+
+	limiter := ratelimit.AuthenticationLimiterInstance
+	if !limiter.Allow(clientIP, user.Username) {
+		slog.Warn("Two-factor authentication attempt refused because of too many failed authentication attempts",
+			slog.Bool("authentication_failed", true),
+			slog.String("client_ip", clientIP),
+			slog.String("user_agent", r.UserAgent()),
+			slog.Int64("user_id", user.ID),
+			slog.String("username", user.Username),
+		)
+		view.Set("errorMessage", locale.NewLocalizedError("error.too_many_login_attempts").Translate(request.UserLanguage(r)))
+		html.OK(w, r, view.Render("login_totp"))
+		return
+	}
+
+	totpForm := form.NewTOTPForm(r)
+	valid := false
+	if totpForm.Validate() == nil {
+		if valid, err = h.store.CheckTOTPCode(user.ID, totpForm.Code, true); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+	}
+
+	if !valid {
+		limiter.RecordFailure(ratelimit.MethodTOTP, clientIP, user.Username)
+		slog.Warn("Incorrect two-factor authentication code",
+			slog.Bool("authentication_failed", true),
+			slog.String("client_ip", clientIP),
+			slog.String("user_agent", r.UserAgent()),
+			slog.Int64("user_id", user.ID),
+			slog.String("username", user.Username),
+		)
+		view.Set("errorMessage", locale.NewLocalizedError("error.totp_invalid_code").Translate(request.UserLanguage(r)))
+		html.OK(w, r, view.Render("login_totp"))
+		return
+	}
+
+	sess.ClearTOTPPendingLogin()
+	h.openUserSession(w, r, sess, user, "User authenticated successfully with username/password and two-factor authentication code")
+}
+
+// openUserSession creates the user session once all authentication steps have been completed.
+func (h *handler) openUserSession(w http.ResponseWriter, r *http.Request, sess *session.Session, user *model.User, message string) {
+	clientIP := request.ClientIP(r)
+	sessionToken, _, err := h.store.CreateUserSessionFromUsername(user.Username, r.UserAgent(), clientIP)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	slog.Info(message,
+		slog.Bool("authentication_successful", true),
+		slog.String("client_ip", clientIP),
+		slog.String("user_agent", r.UserAgent()),
+		slog.Int64("user_id", user.ID),
+		slog.String("username", user.Username),
+	)
+
+	ratelimit.AuthenticationLimiterInstance.RecordSuccess(user.Username)
+	h.store.SetLastLogin(user.ID)
+
 	sess.SetLanguage(user.Language)
 	sess.SetTheme(user.Theme)
 
@@ -27,5 +27,6 @@ func (h *handler) showLoginPage(w http.ResponseWriter, r *http.Request) {
 
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
+	view.Set("openRegistration", h.store.IsOpenRegistrationEnabled())
 	html.OK(w, r, view.Render("login"))
 }
@@ -55,6 +55,7 @@ func (m *middleware) handleUserSession(next http.Handler) http.Handler {
 			ctx = context.WithValue(ctx, request.UserIDContextKey, session.UserID)
 			ctx = context.WithValue(ctx, request.IsAuthenticatedContextKey, true)
 			ctx = context.WithValue(ctx, request.UserSessionTokenContextKey, session.Token)
+			ctx = context.WithValue(ctx, request.TOTPEnrollmentRequiredContextKey, session.MustEnrollTOTP(config.Opts.TOTPRequired()))
 
 			next.ServeHTTP(w, r.WithContext(ctx))
 		}
@@ -107,7 +108,7 @@ func (m *middleware) handleAppSession(next http.Handler) http.Handler {
 					slog.String("header_csrf", headerValue),
 				)
 
-				if mux.CurrentRoute(r).GetName() == "checkLogin" {
+				if routeName := mux.CurrentRoute(r).GetName(); routeName == "checkLogin" || routeName == "checkLoginTOTP" {
 					html.Redirect(w, r, route.Path(m.router, "login"))
 					return
 				}
@@ -128,10 +129,26 @@ func (m *middleware) handleAppSession(next http.Handler) http.Handler {
 		ctx = context.WithValue(ctx, request.UserThemeContextKey, session.Data.Theme)
 		ctx = context.WithValue(ctx, request.LastForceRefreshContextKey, session.Data.LastForceRefresh)
 		ctx = context.WithValue(ctx, request.WebAuthnDataContextKey, session.Data.WebAuthnSessionData)
+		ctx = context.WithValue(ctx, request.TOTPPendingLoginContextKey, session.Data.TOTPPendingLogin)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+func (m *middleware) handleTOTPEnrollment(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !request.TOTPEnrollmentRequired(r) || m.isPublicRoute(r) || isTOTPEnrollmentRoute(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		slog.Debug("Redirecting to the two-factor authentication page because enrollment is required",
+			slog.String("url", r.RequestURI),
+			slog.Int64("user_id", request.UserID(r)),
+		)
+		html.Redirect(w, r, route.Path(m.router, "totpSettings"))
+	})
+}
+
 func (m *middleware) getAppSessionValueFromCookie(r *http.Request) *model.Session {
 	cookieValue := request.CookieValue(r, cookie.CookieAppSessionID)
 	if cookieValue == "" {
@@ -155,6 +172,7 @@ func (m *middleware) isPublicRoute(r *http.Request) bool {
 	switch route.GetName() {
 	case "login",
 		"checkLogin",
+		"checkLoginTOTP",
 		"stylesheet",
 		"javascript",
 		"oauth2Redirect",
@@ -169,7 +187,11 @@ func (m *middleware) isPublicRoute(r *http.Request) bool {
 		"offline",
 		"proxy",
 		"webauthnLoginBegin",
-		"webauthnLoginFinish":
+		"webauthnLoginFinish",
+		"registration",
+		"submitRegistration",
+		"invitationRegistration",
+		"submitInvitationRegistration":
 		return true
 	default:
 		return false
@@ -268,3 +290,13 @@ func (m *middleware) handleAuthProxy(next http.Handler) http.Handler {
 		html.Redirect(w, r, route.Path(m.router, user.DefaultHomePage))
 	})
 }
+
+// isTOTPEnrollmentRoute returns true for the pages a user can reach before configuring two-factor authentication.
+func isTOTPEnrollmentRoute(r *http.Request) bool {
+	switch mux.CurrentRoute(r).GetName() {
+	case "totpSettings", "totpQRCode", "totpEnable", "logout":
+		return true
+	default:
+		return false
+	}
+}
@@ -4,18 +4,40 @@
 package ui // import "miniflux.app/v2/internal/ui"
 
 import (
-	"context"
+	"sync"
 
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/oauth2"
 )
 
-func getOAuth2Manager(ctx context.Context) *oauth2.Manager {
-	return oauth2.NewManager(
-		ctx,
+// getOAuth2Manager returns the manager shared by all the requests, so the OpenID Connect discovery is only done once.
+var getOAuth2Manager = sync.OnceValue(func() *oauth2.Manager {
+	manager := oauth2.NewManager(
 		config.Opts.OAuth2ClientID(),
 		config.Opts.OAuth2ClientSecret(),
 		config.Opts.OAuth2RedirectURL(),
 		config.Opts.OIDCDiscoveryEndpoint(),
+		&oauth2.RoleMapping{
+			GroupsClaims:   config.Opts.OIDCGroupsClaims(),
+			AdminGroups:    config.Opts.OIDCAdminGroups(),
+			RequiredGroups: config.Opts.OIDCRequiredGroups(),
+		},
 	)
-}
+
+	for _, provider := range config.Opts.OIDCProviders() {
+		manager.AddOIDCProvider(
+			provider.Name,
+			provider.ClientID,
+			provider.ClientSecret,
+			provider.RedirectURL,
+			provider.DiscoveryEndpoint,
+			&oauth2.RoleMapping{
+				GroupsClaims:   provider.GroupsClaims,
+				AdminGroups:    provider.AdminGroups,
+				RequiredGroups: provider.RequiredGroups,
+			},
+		)
+	}
+
+	return manager
+})
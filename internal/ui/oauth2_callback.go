@@ -16,6 +16,7 @@ import (
 	"miniflux.app/v2/internal/http/route"
 	"miniflux.app/v2/internal/locale"
 	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/oauth2"
 	"miniflux.app/v2/internal/ui/session"
 )
 
@@ -44,7 +45,7 @@ func (h *handler) oauth2Callback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	authProvider, err := getOAuth2Manager(r.Context()).FindProvider(provider)
+	authProvider, err := getOAuth2Manager().FindProvider(r.Context(), provider)
 	if err != nil {
 		slog.Error("Unable to initialize OAuth2 provider",
 			slog.String("provider", provider),
@@ -64,6 +65,26 @@ func (h *handler) oauth2Callback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The subjects of the default provider must not be confused with the prefixed identities of the additional providers.
+	if profile.Key == "openid_connect_id" && oauth2.OIDCIdentityProvider(profile.ID, config.Opts.OIDCProviderNames()) != provider {
+		slog.Warn("OAuth2 profile ID conflicts with the identities of another provider",
+			slog.String("provider", provider),
+			slog.String("oauth2_profile_id", profile.ID),
+		)
+		html.Redirect(w, r, route.Path(h.router, "login"))
+		return
+	}
+
+	if !profile.IsAllowed() {
+		slog.Warn("OAuth2 user is not a member of the required groups",
+			slog.String("provider", provider),
+			slog.String("oauth2_profile_id", profile.ID),
+			slog.String("username", profile.Username),
+		)
+		html.Forbidden(w, r)
+		return
+	}
+
 	printer := locale.NewPrinter(request.UserLanguage(r))
 	sess := session.New(h.store, request.SessionID(r))
 
@@ -102,6 +123,8 @@ func (h *handler) oauth2Callback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	isAdmin, adminFlagManaged := profile.AdminFlag()
+
 	if user == nil {
 		if !config.Opts.IsOAuth2UserCreationAllowed() {
 			html.Forbidden(w, r)
@@ -113,7 +136,7 @@ func (h *handler) oauth2Callback(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		userCreationRequest := &model.UserCreationRequest{Username: profile.Username}
+		userCreationRequest := &model.UserCreationRequest{Username: profile.Username, IsAdmin: isAdmin}
 		authProvider.PopulateUserCreationWithProfileID(userCreationRequest, profile)
 
 		user, err = h.store.CreateUser(userCreationRequest)
@@ -121,6 +144,29 @@ func (h *handler) oauth2Callback(w http.ResponseWriter, r *http.Request) {
 			html.ServerError(w, r, err)
 			return
 		}
+
+		h.store.Audit(request.UserID(r), model.AuditActionUserCreate, user.Username, request.ClientIP(r))
+		if user.IsAdmin {
+			h.store.Audit(request.UserID(r), model.AuditActionUserAdminGrant, user.Username, request.ClientIP(r))
+		}
+	} else if adminFlagManaged && user.IsAdmin != isAdmin {
+		if err := h.store.SetUserAdmin(user.ID, isAdmin); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		slog.Info("Admin flag updated from the OAuth2 groups",
+			slog.String("provider", provider),
+			slog.Int64("user_id", user.ID),
+			slog.String("username", user.Username),
+			slog.Bool("is_admin", isAdmin),
+		)
+		if isAdmin {
+			h.store.Audit(request.UserID(r), model.AuditActionUserAdminGrant, user.Username, request.ClientIP(r))
+		} else {
+			h.store.Audit(request.UserID(r), model.AuditActionUserAdminRevoke, user.Username, request.ClientIP(r))
+		}
+		user.IsAdmin = isAdmin
 	}
 
 	clientIP := request.ClientIP(r)
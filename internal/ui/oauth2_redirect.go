@@ -22,7 +22,7 @@ func (h *handler) oauth2Redirect(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	authProvider, err := getOAuth2Manager(r.Context()).FindProvider(provider)
+	authProvider, err := getOAuth2Manager().FindProvider(r.Context(), provider)
 	if err != nil {
 		slog.Error("Unable to initialize OAuth2 provider",
 			slog.String("provider", provider),
@@ -12,6 +12,7 @@ import (
 	"miniflux.app/v2/internal/http/response/html"
 	"miniflux.app/v2/internal/http/route"
 	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/oauth2"
 	"miniflux.app/v2/internal/ui/session"
 )
 
@@ -31,7 +32,7 @@ func (h *handler) oauth2Unlink(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	authProvider, err := getOAuth2Manager(r.Context()).FindProvider(provider)
+	authProvider, err := getOAuth2Manager().FindProvider(r.Context(), provider)
 	if err != nil {
 		slog.Error("Unable to initialize OAuth2 provider",
 			slog.String("provider", provider),
@@ -47,6 +48,12 @@ func (h *handler) oauth2Unlink(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Only the identity of the provider being unlinked is removed.
+	if authProvider.GetUserExtraKey() == "openid_connect_id" && oauth2.OIDCIdentityProvider(user.OpenIDConnectID, config.Opts.OIDCProviderNames()) != provider {
+		html.Redirect(w, r, route.Path(h.router, "settings"))
+		return
+	}
+
 	hasPassword, err := h.store.HasPassword(request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
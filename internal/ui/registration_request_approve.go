@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"errors"
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/ui/session"
+)
+
+func (h *handler) approveRegistrationRequest(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !user.IsAdmin {
+		html.Forbidden(w, r)
+		return
+	}
+
+	registrationRequest, err := h.store.RegistrationRequest(request.RouteInt64Param(r, "requestID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if registrationRequest == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	// The username may have been taken by an account created after the request.
+	if h.store.UserExists(registrationRequest.Username) {
+		sess := session.New(h.store, request.SessionID(r))
+		sess.NewFlashErrorMessage(locale.NewPrinter(user.Language).Print("error.user_already_exists"))
+		html.Redirect(w, r, route.Path(h.router, "registrationRequests"))
+		return
+	}
+
+	newUser, err := h.store.ApproveRegistrationRequest(registrationRequest.ID)
+	if errors.Is(err, storage.ErrRegistrationRequestNotFound) {
+		html.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	h.store.Audit(request.UserID(r), model.AuditActionRegistrationApprove, newUser.Username, request.ClientIP(r))
+
+	html.Redirect(w, r, route.Path(h.router, "registrationRequests"))
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/model"
+)
+
+func (h *handler) updateRegistrationSettings(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !user.IsAdmin {
+		html.Forbidden(w, r)
+		return
+	}
+
+	openRegistration := r.FormValue("open_registration") == "1"
+	if err := h.store.SetOpenRegistration(openRegistration); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	target := "open_registration=disabled"
+	if openRegistration {
+		target = "open_registration=enabled"
+	}
+	h.store.Audit(request.UserID(r), model.AuditActionRegistrationSettings, target, request.ClientIP(r))
+
+	html.Redirect(w, r, route.Path(h.router, "registrationRequests"))
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/ui/form"
+	"miniflux.app/v2/internal/ui/session"
+	"miniflux.app/v2/internal/ui/view"
+)
+
+func (h *handler) showRegistrationPage(w http.ResponseWriter, r *http.Request) {
+	if config.Opts.DisableLocalAuth() || !h.store.IsOpenRegistrationEnabled() {
+		html.NotFound(w, r)
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", &form.RegistrationForm{})
+	html.OK(w, r, view.Render("registration"))
+}
+
+func (h *handler) showInvitationRegistrationPage(w http.ResponseWriter, r *http.Request) {
+	if config.Opts.DisableLocalAuth() {
+		html.NotFound(w, r)
+		return
+	}
+
+	token := request.RouteStringParam(r, "token")
+	invitation, err := h.store.InvitationByToken(token)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if invitation == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", &form.RegistrationForm{})
+	view.Set("invitationToken", token)
+
+	if !invitation.IsUsable() {
+		view.Set("invitationUnusable", true)
+		view.Set("errorMessage", locale.NewLocalizedError("error.invitation_unusable").Translate(request.UserLanguage(r)))
+	}
+
+	html.OK(w, r, view.Render("registration"))
+}
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ratelimit"
+	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/ui/form"
+	"miniflux.app/v2/internal/ui/session"
+	"miniflux.app/v2/internal/ui/view"
+	"miniflux.app/v2/internal/validator"
+)
+
+// maxPendingRegistrationRequests limits the size of the approval queue of the open registration.
+const maxPendingRegistrationRequests = 100
+
+func (h *handler) submitRegistration(w http.ResponseWriter, r *http.Request) {
+	if config.Opts.DisableLocalAuth() || !h.store.IsOpenRegistrationEnabled() {
+		html.NotFound(w, r)
+		return
+	}
+
+	registrationForm := form.NewRegistrationForm(r)
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", registrationForm)
+
+	clientIP := request.ClientIP(r)
+	limiter := ratelimit.RegistrationLimiterInstance
+	if !limiter.Allow(clientIP, "") {
+		slog.Warn("Account request refused because of too many attempts",
+			slog.String("client_ip", clientIP),
+			slog.String("user_agent", r.UserAgent()),
+		)
+		view.Set("errorMessage", locale.NewLocalizedError("error.too_many_registration_attempts").Translate(request.UserLanguage(r)))
+		html.OK(w, r, view.Render("registration"))
+		return
+	}
+
+	// Every submission counts as an attempt, so a client can neither fill the approval queue nor probe usernames.
+	limiter.RecordFailure(ratelimit.MethodRegistration, clientIP, "")
+
+	validationErr := registrationForm.Validate()
+	if validationErr == nil {
+		validationErr = validator.ValidateUserRegistration(&model.UserCreationRequest{
+			Username: registrationForm.Username,
+			Password: registrationForm.Password,
+		})
+	}
+
+	if validationErr == nil && h.store.CountRegistrationRequests() >= maxPendingRegistrationRequests {
+		validationErr = locale.NewLocalizedError("error.registration_queue_full")
+	}
+
+	if validationErr != nil {
+		view.Set("errorMessage", validationErr.Translate(request.UserLanguage(r)))
+		html.OK(w, r, view.Render("registration"))
+		return
+	}
+
+	// A taken username gets the same answer as a new request, so the form does not reveal the existing accounts.
+	if validator.IsUsernameAvailable(h.store, registrationForm.Username) {
+		registrationRequest, err := h.store.CreateRegistrationRequest(registrationForm.Username, registrationForm.Password, maxPendingRegistrationRequests)
+		if errors.Is(err, storage.ErrTooManyRegistrationRequests) {
+			view.Set("errorMessage", locale.NewLocalizedError("error.registration_queue_full").Translate(request.UserLanguage(r)))
+			html.OK(w, r, view.Render("registration"))
+			return
+		}
+		if err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		slog.Info("Account requested with the registration form",
+			slog.String("username", registrationRequest.Username),
+			slog.String("client_ip", clientIP),
+		)
+		h.store.Audit(request.UserID(r), model.AuditActionRegistrationRequest, registrationRequest.Username, request.ClientIP(r))
+	} else {
+		slog.Info("Account requested with a username that is already taken",
+			slog.String("username", registrationForm.Username),
+			slog.String("client_ip", clientIP),
+		)
+	}
+
+	view.Set("form", &form.RegistrationForm{})
+	view.Set("registrationRequested", true)
+	html.OK(w, r, view.Render("registration"))
+}
+
+func (h *handler) submitInvitationRegistration(w http.ResponseWriter, r *http.Request) {
+	if config.Opts.DisableLocalAuth() {
+		html.NotFound(w, r)
+		return
+	}
+
+	token := request.RouteStringParam(r, "token")
+	invitation, err := h.store.InvitationByToken(token)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if invitation == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	registrationForm := form.NewRegistrationForm(r)
+	userCreationRequest := &model.UserCreationRequest{
+		Username: registrationForm.Username,
+		Password: registrationForm.Password,
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", registrationForm)
+	view.Set("invitationToken", token)
+
+	validationErr := registrationForm.Validate()
+	if validationErr == nil {
+		validationErr = validator.ValidateUserRegistration(userCreationRequest)
+	}
+
+	if validationErr == nil && !validator.IsUsernameAvailable(h.store, userCreationRequest.Username) {
+		validationErr = locale.NewLocalizedError("error.user_already_exists")
+	}
+
+	if validationErr != nil {
+		view.Set("errorMessage", validationErr.Translate(request.UserLanguage(r)))
+		html.OK(w, r, view.Render("registration"))
+		return
+	}
+
+	newUser, err := h.store.CreateUserWithInvitation(invitation.ID, userCreationRequest)
+	if errors.Is(err, storage.ErrInvitationUnusable) || errors.Is(err, storage.ErrInvitationNotFound) {
+		view.Set("invitationUnusable", true)
+		view.Set("errorMessage", locale.NewLocalizedError("error.invitation_unusable").Translate(request.UserLanguage(r)))
+		html.OK(w, r, view.Render("registration"))
+		return
+	}
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	slog.Info("Account created with an invitation",
+		slog.Int64("user_id", newUser.ID),
+		slog.String("username", newUser.Username),
+		slog.Int64("invitation_id", invitation.ID),
+		slog.String("client_ip", request.ClientIP(r)),
+	)
+	h.store.Audit(request.UserID(r), model.AuditActionInvitationAccept, newUser.Username, request.ClientIP(r))
+
+	sess.NewFlashMessage(locale.NewPrinter(newUser.Language).Print("alert.account_created"))
+	html.Redirect(w, r, route.Path(h.router, "login"))
+}
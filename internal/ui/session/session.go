@@ -4,6 +4,7 @@
 package session // import "miniflux.app/v2/internal/ui/session"
 
 import (
+	"fmt"
 	"time"
 
 	"miniflux.app/v2/internal/model"
@@ -72,3 +73,13 @@ func (s *Session) SetTheme(theme string) {
 func (s *Session) SetWebAuthnSessionData(sessionData *model.WebAuthnSession) {
 	s.store.UpdateAppSessionObjectField(s.sessionID, "webauthn_session_data", sessionData)
 }
+
+// SetTOTPPendingLogin remembers the user who has to enter a TOTP code to finish logging in.
+func (s *Session) SetTOTPPendingLogin(userID int64) {
+	s.store.UpdateAppSessionField(s.sessionID, "totp_pending_login", fmt.Sprintf("%d:%d", userID, time.Now().Unix()))
+}
+
+// ClearTOTPPendingLogin forgets the user waiting for the second authentication step.
+func (s *Session) ClearTOTPPendingLogin() {
+	s.store.UpdateAppSessionField(s.sessionID, "totp_pending_login", "")
+}
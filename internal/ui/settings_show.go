@@ -38,6 +38,7 @@ func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
 		EntrySwipe:                user.EntrySwipe,
 		GestureNav:                user.GestureNav,
 		DisplayMode:               user.DisplayMode,
+		EntryListLayout:           user.EntryListLayout,
 		DefaultReadingSpeed:       user.DefaultReadingSpeed,
 		CJKReadingSpeed:           user.CJKReadingSpeed,
 		DefaultHomePage:           user.DefaultHomePage,
@@ -48,6 +49,8 @@ func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
 		KeepFilterEntryRules:      user.KeepFilterEntryRules,
 		AlwaysOpenExternalLinks:   user.AlwaysOpenExternalLinks,
 		OpenExternalLinksInNewTab: user.OpenExternalLinksInNewTab,
+		RetentionPolicy:           user.RetentionPolicy,
+		RetentionValue:            user.RetentionValue,
 	}
 
 	timezones, err := h.store.Timezones()
@@ -80,6 +83,8 @@ func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
 	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
 	view.Set("default_home_pages", model.HomePages())
 	view.Set("categories_sorting_options", model.CategoriesSortingOptions())
+	view.Set("entry_list_layouts", model.EntryListLayouts())
+	view.Set("retention_policies", model.RetentionPolicies())
 	view.Set("countWebAuthnCerts", h.store.CountWebAuthnCredentialsByUserID(user.ID))
 	view.Set("webAuthnCerts", creds)
 
@@ -56,6 +56,8 @@ func (h *handler) updateSettings(w http.ResponseWriter, r *http.Request) {
 	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
 	view.Set("default_home_pages", model.HomePages())
 	view.Set("categories_sorting_options", model.CategoriesSortingOptions())
+	view.Set("entry_list_layouts", model.EntryListLayouts())
+	view.Set("retention_policies", model.RetentionPolicies())
 	view.Set("countWebAuthnCerts", h.store.CountWebAuthnCredentialsByUserID(user.ID))
 	view.Set("webAuthnCerts", creds)
 
@@ -76,6 +78,7 @@ func (h *handler) updateSettings(w http.ResponseWriter, r *http.Request) {
 		EntriesPerPage:         model.OptionalNumber(settingsForm.EntriesPerPage),
 		CategoriesSortingOrder: model.OptionalString(settingsForm.CategoriesSortingOrder),
 		DisplayMode:            model.OptionalString(settingsForm.DisplayMode),
+		EntryListLayout:        model.OptionalString(settingsForm.EntryListLayout),
 		GestureNav:             model.OptionalString(settingsForm.GestureNav),
 		DefaultReadingSpeed:    model.OptionalNumber(settingsForm.DefaultReadingSpeed),
 		CJKReadingSpeed:        model.OptionalNumber(settingsForm.CJKReadingSpeed),
@@ -84,6 +87,8 @@ func (h *handler) updateSettings(w http.ResponseWriter, r *http.Request) {
 		BlockFilterEntryRules:  model.OptionalString(settingsForm.BlockFilterEntryRules),
 		KeepFilterEntryRules:   model.OptionalString(settingsForm.KeepFilterEntryRules),
 		ExternalFontHosts:      model.OptionalString(settingsForm.ExternalFontHosts),
+		RetentionPolicy:        model.SetOptionalField(settingsForm.RetentionPolicy),
+		RetentionValue:         model.SetOptionalField(settingsForm.RetentionValue),
 	}
 
 	if validationErr := validator.ValidateUserModification(h.store, user.ID, userModificationRequest); validationErr != nil {
@@ -237,6 +237,18 @@ function setStarredButtonState(buttonElement, newState) {
     setIconAndLabelElement(buttonElement, iconType, buttonElement.dataset[newState === "star" ? "labelUnstar" : "labelStar"]);
 }
 
+/**
+ * Set the read later button state.
+ *
+ * @param {Element} buttonElement - The button element to update.
+ * @param {string} newState - The new state to set ("later" or "unlater").
+ */
+function setReadLaterButtonState(buttonElement, newState) {
+    buttonElement.dataset.value = newState;
+    const iconType = newState === "later" ? "unlater" : "later";
+    setIconAndLabelElement(buttonElement, iconType, buttonElement.dataset[newState === "later" ? "labelUnlater" : "labelLater"]);
+}
+
 /**
  * Set the read status button state.
  *
@@ -739,6 +751,33 @@ function handleStarAction(element) {
     });
 }
 
+/**
+ * Handle adding or removing an entry from the read later queue.
+ *
+ * @param {Element} element - The element that triggered the read later action.
+ */
+function handleReadLaterAction(element) {
+    const currentEntry = findEntry(element);
+    if (!currentEntry) return;
+
+    const buttonElement = currentEntry.querySelector(":is(a, button)[data-toggle-read-later]");
+    if (!buttonElement) return;
+
+    setButtonToLoadingState(buttonElement);
+
+    sendPOSTRequest(buttonElement.dataset.readLaterUrl).then(() => {
+        const currentState = buttonElement.dataset.value;
+        const isQueued = currentState === "later";
+        const newState = isQueued ? "unlater" : "later";
+
+        setReadLaterButtonState(buttonElement, newState);
+
+        if (isEntryView()) {
+            showToastNotification(currentState, buttonElement.dataset[isQueued ? "toastUnlater" : "toastLater"]);
+        }
+    });
+}
+
 /**
  * Handle fetching the original content of an entry.
  *
@@ -759,6 +798,7 @@ function handleFetchOriginalContentAction() {
             if (data.content && data.reading_time) {
                 const ttpolicy = trustedTypes.createPolicy('html', {createHTML: html => html});
                 document.querySelector(".entry-content").innerHTML = ttpolicy.createHTML(data.content);
+                renderEntryHighlights();
                 const entryReadingtimeElement = document.querySelector(".entry-reading-time");
                 if (entryReadingtimeElement) {
                     entryReadingtimeElement.textContent = data.reading_time;
@@ -768,6 +808,130 @@ function handleFetchOriginalContentAction() {
     });
 }
 
+/**
+ * Get the position of a boundary point in the text content of the given element.
+ *
+ * @param {Element} rootElement - The element containing the boundary point.
+ * @param {Node} node - The node of the boundary point.
+ * @param {number} offset - The offset of the boundary point in the node.
+ * @returns {number} The number of characters before the boundary point.
+ */
+function getTextOffset(rootElement, node, offset) {
+    const range = document.createRange();
+    range.selectNodeContents(rootElement);
+    range.setEnd(node, offset);
+    return range.toString().length;
+}
+
+/**
+ * Save the text selected in the entry content as a highlight, with an optional note.
+ *
+ * @returns {void}
+ */
+function handleHighlightAction() {
+    if (!isEntryView()) return;
+
+    const buttonElement = document.querySelector(":is(a, button)[data-highlight-entry]");
+    const contentElement = document.querySelector(".entry-content");
+    if (!buttonElement || !contentElement) return;
+
+    const selection = window.getSelection();
+    if (!selection || selection.isCollapsed || selection.rangeCount === 0) {
+        showToastNotification("highlight", buttonElement.dataset.toastEmpty);
+        return;
+    }
+
+    const range = selection.getRangeAt(0);
+    if (!contentElement.contains(range.commonAncestorContainer) || range.toString().trim() === "") {
+        showToastNotification("highlight", buttonElement.dataset.toastEmpty);
+        return;
+    }
+
+    const note = window.prompt(buttonElement.dataset.labelNote, "");
+    if (note === null) return;
+
+    const startOffset = getTextOffset(contentElement, range.startContainer, range.startOffset);
+    const endOffset = getTextOffset(contentElement, range.endContainer, range.endOffset);
+
+    sendPOSTRequest(buttonElement.dataset.highlightUrl, {
+        quote: range.toString(),
+        note: note.trim(),
+        start_offset: startOffset,
+        end_offset: endOffset
+    }).then((response) => {
+        if (response.ok) {
+            window.location.reload();
+        }
+    });
+}
+
+/**
+ * Wrap the text between two positions of the text content of an element in mark elements.
+ *
+ * @param {Element} rootElement - The element containing the text.
+ * @param {number} start - The position of the first character.
+ * @param {number} end - The position after the last character.
+ * @returns {void}
+ */
+function markTextRange(rootElement, start, end) {
+    const walker = document.createTreeWalker(rootElement, NodeFilter.SHOW_TEXT);
+    const textRanges = [];
+    let position = 0;
+
+    while (walker.nextNode()) {
+        const node = walker.currentNode;
+        const nodeStart = position;
+        position += node.data.length;
+
+        if (position <= start || nodeStart >= end) continue;
+
+        textRanges.push({
+            node,
+            from: Math.max(start - nodeStart, 0),
+            to: Math.min(end, position) - nodeStart
+        });
+    }
+
+    // Each text node is wrapped separately to keep the structure of the document.
+    for (const {node, from, to} of textRanges) {
+        if (node.data.slice(from, to).trim() === "") continue;
+
+        const range = document.createRange();
+        range.setStart(node, from);
+        range.setEnd(node, to);
+
+        const markElement = document.createElement("mark");
+        markElement.className = "entry-highlight";
+        range.surroundContents(markElement);
+    }
+}
+
+/**
+ * Show the highlights of the entry in its content.
+ * The quote is searched in the text when the content changed since the highlight was saved.
+ *
+ * @returns {void}
+ */
+function renderEntryHighlights() {
+    const contentElement = document.querySelector(".entry-content");
+    if (!contentElement) return;
+
+    const text = contentElement.textContent;
+    document.querySelectorAll(".entry-highlights [data-quote]").forEach((element) => {
+        const quote = element.dataset.quote;
+        let start = parseInt(element.dataset.startOffset, 10);
+        let end = parseInt(element.dataset.endOffset, 10);
+
+        if (text.slice(start, end) !== quote) {
+            start = text.indexOf(quote);
+            if (start === -1) return;
+            end = start + quote.length;
+        }
+
+        markTextRange(contentElement, start, end);
+    });
+}
+
 /**
  * Open the original link of an entry.
  *
@@ -1172,6 +1336,7 @@ function initializeKeyboardShortcuts() {
     // Navigation shortcuts
     keyboardHandler.on("g u", () => goToPage("unread"));
     keyboardHandler.on("g b", () => goToPage("starred"));
+    keyboardHandler.on("g l", () => goToPage("readLater"));
     keyboardHandler.on("g h", () => goToPage("history"));
     keyboardHandler.on("g f", goToFeedOrFeedsPage);
     keyboardHandler.on("g c", () => goToPage("categories"));
@@ -1206,6 +1371,7 @@ function initializeKeyboardShortcuts() {
     keyboardHandler.on("s", () => handleSaveEntryAction());
     keyboardHandler.on("d", handleFetchOriginalContentAction);
     keyboardHandler.on("f", () => handleStarAction());
+    keyboardHandler.on("L", () => handleReadLaterAction());
 
     // Feed actions
     keyboardHandler.on("F", goToFeedPage);
@@ -1241,9 +1407,11 @@ function initializeClickHandlers() {
     // Entry actions
     onClick(":is(a, button)[data-save-entry]", (event) => handleSaveEntryAction(event.target));
     onClick(":is(a, button)[data-toggle-starred]", (event) => handleStarAction(event.target));
+    onClick(":is(a, button)[data-toggle-read-later]", (event) => handleReadLaterAction(event.target));
     onClick(":is(a, button)[data-toggle-status]", (event) => handleEntryStatus("next", event.target));
     onClick(":is(a, button)[data-fetch-content-entry]", handleFetchOriginalContentAction);
     onClick(":is(a, button)[data-share-status]", handleEntryShareAction);
+    onClick(":is(a, button)[data-highlight-entry]", handleHighlightAction);
 
     // Page actions with confirmation
     onClick(":is(a, button)[data-action=markPageAsRead]", (event) => handleConfirmationMessage(event.target, markPageAsReadAction));
@@ -1284,6 +1452,10 @@ initializeTouchHandler();
 initializeClickHandlers();
 initializeServiceWorker();
 
+if (isEntryView()) {
+    renderEntryHighlights();
+}
+
 // Reload the page if it was restored from the back-forward cache and mark entries as read is enabled.
 window.addEventListener("pageshow", (event) => {
     if (event.persisted && document.body.dataset.markAsReadOnView === "true") {
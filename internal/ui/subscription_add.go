@@ -29,7 +29,7 @@ func (h *handler) showAddSubscriptionPage(w http.ResponseWriter, r *http.Request
 
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
-	view.Set("categories", categories)
+	view.Set("categories", categories.Tree())
 	view.Set("menu", "feeds")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
@@ -37,6 +37,7 @@ func (h *handler) showAddSubscriptionPage(w http.ResponseWriter, r *http.Request
 	view.Set("defaultUserAgent", config.Opts.HTTPClientUserAgent())
 	view.Set("form", &form.SubscriptionForm{CategoryID: 0})
 	view.Set("hasProxyConfigured", config.Opts.HasHTTPClientProxyURLConfigured())
+	view.Set("proxyPools", proxyPoolNames())
 
 	html.OK(w, r, view.Render("add_subscription"))
 }
@@ -32,12 +32,13 @@ func (h *handler) showChooseSubscriptionPage(w http.ResponseWriter, r *http.Requ
 
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
-	view.Set("categories", categories)
+	view.Set("categories", categories.Tree())
 	view.Set("menu", "feeds")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
 	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
 	view.Set("defaultUserAgent", config.Opts.HTTPClientUserAgent())
+	view.Set("proxyPools", proxyPoolNames())
 
 	subscriptionForm := form.NewSubscriptionForm(r)
 	if validationErr := subscriptionForm.Validate(); validationErr != nil {
@@ -51,9 +52,14 @@ func (h *handler) showChooseSubscriptionPage(w http.ResponseWriter, r *http.Requ
 		CategoryID:                  subscriptionForm.CategoryID,
 		FeedURL:                     subscriptionForm.URL,
 		Crawler:                     subscriptionForm.Crawler,
+		InheritCrawler:              !subscriptionForm.Crawler,
 		AllowSelfSignedCertificates: subscriptionForm.AllowSelfSignedCertificates,
 		UserAgent:                   subscriptionForm.UserAgent,
 		Cookie:                      subscriptionForm.Cookie,
+		HTTPHeaders:                 subscriptionForm.HTTPHeaders,
+		ClientCertificate:           subscriptionForm.ClientCertificate,
+		ClientKey:                   subscriptionForm.ClientKey,
+		CACertificates:              subscriptionForm.CACertificates,
 		Username:                    subscriptionForm.Username,
 		Password:                    subscriptionForm.Password,
 		ScraperRules:                subscriptionForm.ScraperRules,
@@ -64,8 +70,10 @@ func (h *handler) showChooseSubscriptionPage(w http.ResponseWriter, r *http.Requ
 		KeepFilterEntryRules:        subscriptionForm.KeepFilterEntryRules,
 		BlockFilterEntryRules:       subscriptionForm.BlockFilterEntryRules,
 		FetchViaProxy:               subscriptionForm.FetchViaProxy,
+		InheritFetchViaProxy:        !subscriptionForm.FetchViaProxy,
 		DisableHTTP2:                subscriptionForm.DisableHTTP2,
 		ProxyURL:                    subscriptionForm.ProxyURL,
+		ProxyPool:                   subscriptionForm.ProxyPool,
 	})
 	if localizedError != nil {
 		view.Set("form", subscriptionForm)
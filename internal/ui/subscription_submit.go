@@ -36,13 +36,14 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 
 	sess := session.New(h.store, request.SessionID(r))
 	v := view.New(h.tpl, r, sess)
-	v.Set("categories", categories)
+	v.Set("categories", categories.Tree())
 	v.Set("menu", "feeds")
 	v.Set("user", user)
 	v.Set("countUnread", h.store.CountUnreadEntries(user.ID))
 	v.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
 	v.Set("defaultUserAgent", config.Opts.HTTPClientUserAgent())
 	v.Set("hasProxyConfigured", config.Opts.HasHTTPClientProxyURLConfigured())
+	v.Set("proxyPools", proxyPoolNames())
 
 	subscriptionForm := form.NewSubscriptionForm(r)
 	if validationErr := subscriptionForm.Validate(); validationErr != nil {
@@ -61,7 +62,7 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 
 	requestBuilder := fetcher.NewRequestBuilder()
 	requestBuilder.WithTimeout(config.Opts.HTTPClientTimeout())
-	requestBuilder.WithProxyRotator(proxyrotator.ProxyRotatorInstance)
+	requestBuilder.WithProxyRotator(proxyrotator.Pool(subscriptionForm.ProxyPool))
 	requestBuilder.WithCustomFeedProxyURL(subscriptionForm.ProxyURL)
 	requestBuilder.WithCustomApplicationProxyURL(config.Opts.HTTPClientProxyURL())
 	requestBuilder.UseCustomApplicationProxyURL(subscriptionForm.FetchViaProxy)
@@ -70,6 +71,9 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 	requestBuilder.WithUsernameAndPassword(subscriptionForm.Username, subscriptionForm.Password)
 	requestBuilder.IgnoreTLSErrors(subscriptionForm.AllowSelfSignedCertificates)
 	requestBuilder.DisableHTTP2(subscriptionForm.DisableHTTP2)
+	requestBuilder.WithCustomHeaders(subscriptionForm.RequestHeaders())
+	requestBuilder.WithClientCertificate(subscriptionForm.ClientCertificate, subscriptionForm.ClientKey)
+	requestBuilder.WithCACertificates(subscriptionForm.CACertificates)
 
 	subscriptionFinder := subscription.NewSubscriptionFinder(requestBuilder)
 	subscriptions, localizedError := subscriptionFinder.FindSubscriptions(
@@ -100,8 +104,13 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 				FeedURL:                     subscriptions[0].URL,
 				AllowSelfSignedCertificates: subscriptionForm.AllowSelfSignedCertificates,
 				Crawler:                     subscriptionForm.Crawler,
+				InheritCrawler:              !subscriptionForm.Crawler,
 				UserAgent:                   subscriptionForm.UserAgent,
 				Cookie:                      subscriptionForm.Cookie,
+				HTTPHeaders:                 subscriptionForm.HTTPHeaders,
+				ClientCertificate:           subscriptionForm.ClientCertificate,
+				ClientKey:                   subscriptionForm.ClientKey,
+				CACertificates:              subscriptionForm.CACertificates,
 				Username:                    subscriptionForm.Username,
 				Password:                    subscriptionForm.Password,
 				ScraperRules:                subscriptionForm.ScraperRules,
@@ -112,8 +121,10 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 				KeepFilterEntryRules:        subscriptionForm.KeepFilterEntryRules,
 				BlockFilterEntryRules:       subscriptionForm.BlockFilterEntryRules,
 				FetchViaProxy:               subscriptionForm.FetchViaProxy,
+				InheritFetchViaProxy:        !subscriptionForm.FetchViaProxy,
 				DisableHTTP2:                subscriptionForm.DisableHTTP2,
 				ProxyURL:                    subscriptionForm.ProxyURL,
+				ProxyPool:                   subscriptionForm.ProxyPool,
 			},
 		})
 		if localizedError != nil {
@@ -129,9 +140,14 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 			CategoryID:                  subscriptionForm.CategoryID,
 			FeedURL:                     subscriptions[0].URL,
 			Crawler:                     subscriptionForm.Crawler,
+			InheritCrawler:              !subscriptionForm.Crawler,
 			AllowSelfSignedCertificates: subscriptionForm.AllowSelfSignedCertificates,
 			UserAgent:                   subscriptionForm.UserAgent,
 			Cookie:                      subscriptionForm.Cookie,
+			HTTPHeaders:                 subscriptionForm.HTTPHeaders,
+			ClientCertificate:           subscriptionForm.ClientCertificate,
+			ClientKey:                   subscriptionForm.ClientKey,
+			CACertificates:              subscriptionForm.CACertificates,
 			Username:                    subscriptionForm.Username,
 			Password:                    subscriptionForm.Password,
 			ScraperRules:                subscriptionForm.ScraperRules,
@@ -142,8 +158,10 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 			KeepFilterEntryRules:        subscriptionForm.KeepFilterEntryRules,
 			BlockFilterEntryRules:       subscriptionForm.BlockFilterEntryRules,
 			FetchViaProxy:               subscriptionForm.FetchViaProxy,
+			InheritFetchViaProxy:        !subscriptionForm.FetchViaProxy,
 			DisableHTTP2:                subscriptionForm.DisableHTTP2,
 			ProxyURL:                    subscriptionForm.ProxyURL,
+			ProxyPool:                   subscriptionForm.ProxyPool,
 		})
 		if localizedError != nil {
 			v.Set("form", subscriptionForm)
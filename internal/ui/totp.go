@@ -0,0 +1,280 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/qrcode"
+	"miniflux.app/v2/internal/ratelimit"
+	"miniflux.app/v2/internal/totp"
+	"miniflux.app/v2/internal/ui/form"
+	"miniflux.app/v2/internal/ui/session"
+	"miniflux.app/v2/internal/ui/view"
+)
+
+func (h *handler) showTOTPPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !user.TOTPEnabled {
+		// A new secret is generated each time the enrollment page is displayed.
+		if err := h.store.SetTOTPSecret(user.ID, totp.GenerateSecret()); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+	}
+
+	h.renderTOTPPage(w, r, user, "")
+}
+
+func (h *handler) showTOTPQRCode(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if user.TOTPEnabled {
+		html.NotFound(w, r)
+		return
+	}
+
+	secret, err := h.store.TOTPSecret(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if secret == "" {
+		html.NotFound(w, r)
+		return
+	}
+
+	code, err := qrcode.Encode(totp.URI(user.Username, secret))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	var buffer bytes.Buffer
+	if err := code.WritePNG(&buffer, 6); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	response.New(w, r).
+		WithHeader("Content-Type", "image/png").
+		WithHeader("Cache-Control", "no-store").
+		WithoutCompression().
+		WithBody(buffer.Bytes()).
+		Write()
+}
+
+func (h *handler) enableTOTP(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if user.TOTPEnabled {
+		html.Redirect(w, r, route.Path(h.router, "totpSettings"))
+		return
+	}
+
+	totpForm := form.NewTOTPForm(r)
+	if validationErr := totpForm.Validate(); validationErr != nil {
+		h.renderTOTPPage(w, r, user, validationErr.Translate(user.Language))
+		return
+	}
+
+	clientIP := request.ClientIP(r)
+	limiter := ratelimit.AuthenticationLimiterInstance
+	if !limiter.Allow(clientIP, user.Username) {
+		h.renderTOTPPage(w, r, user, locale.NewLocalizedError("error.too_many_login_attempts").Translate(user.Language))
+		return
+	}
+
+	recoveryCodes := totp.GenerateRecoveryCodes()
+	enabled, err := h.store.EnableTOTP(user.ID, totpForm.Code, recoveryCodes)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !enabled {
+		limiter.RecordFailure(ratelimit.MethodTOTP, clientIP, user.Username)
+		h.renderTOTPPage(w, r, user, locale.NewLocalizedError("error.totp_invalid_code").Translate(user.Language))
+		return
+	}
+
+	slog.Info("Two-factor authentication enabled",
+		slog.Int64("user_id", user.ID),
+		slog.String("client_ip", request.ClientIP(r)),
+	)
+	h.store.Audit(request.UserID(r), model.AuditActionTOTPEnable, user.Username, request.ClientIP(r))
+
+	user.TOTPEnabled = true
+	h.renderTOTPRecoveryCodesPage(w, r, user, recoveryCodes)
+}
+
+func (h *handler) disableTOTP(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !user.TOTPEnabled || user.TOTPRequired || config.Opts.TOTPRequired() {
+		html.Redirect(w, r, route.Path(h.router, "totpSettings"))
+		return
+	}
+
+	valid, errorMessage, err := h.checkTOTPForm(r, user, true)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !valid {
+		h.renderTOTPPage(w, r, user, errorMessage)
+		return
+	}
+
+	if err := h.store.DisableTOTP(user.ID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	slog.Info("Two-factor authentication disabled",
+		slog.Int64("user_id", user.ID),
+		slog.String("client_ip", request.ClientIP(r)),
+	)
+	h.store.Audit(request.UserID(r), model.AuditActionTOTPDisable, user.Username, request.ClientIP(r))
+
+	sess := session.New(h.store, request.SessionID(r))
+	sess.NewFlashMessage(locale.NewPrinter(user.Language).Print("alert.totp_disabled"))
+	html.Redirect(w, r, route.Path(h.router, "settings"))
+}
+
+func (h *handler) regenerateTOTPRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !user.TOTPEnabled {
+		html.Redirect(w, r, route.Path(h.router, "totpSettings"))
+		return
+	}
+
+	valid, errorMessage, err := h.checkTOTPForm(r, user, false)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !valid {
+		h.renderTOTPPage(w, r, user, errorMessage)
+		return
+	}
+
+	recoveryCodes := totp.GenerateRecoveryCodes()
+	if err := h.store.ReplaceTOTPRecoveryCodes(user.ID, recoveryCodes); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+	h.store.Audit(request.UserID(r), model.AuditActionTOTPRecoveryCodesGenerate, user.Username, request.ClientIP(r))
+
+	h.renderTOTPRecoveryCodesPage(w, r, user, recoveryCodes)
+}
+
+// checkTOTPForm returns a translated error message when the submitted code is missing or invalid.
+// Invalid codes count as failed authentication attempts, like on the login page.
+func (h *handler) checkTOTPForm(r *http.Request, user *model.User, allowRecoveryCode bool) (bool, string, error) {
+	totpForm := form.NewTOTPForm(r)
+	if validationErr := totpForm.Validate(); validationErr != nil {
+		return false, validationErr.Translate(user.Language), nil
+	}
+
+	clientIP := request.ClientIP(r)
+	limiter := ratelimit.AuthenticationLimiterInstance
+	if !limiter.Allow(clientIP, user.Username) {
+		return false, locale.NewLocalizedError("error.too_many_login_attempts").Translate(user.Language), nil
+	}
+
+	valid, err := h.store.CheckTOTPCode(user.ID, totpForm.Code, allowRecoveryCode)
+	if err != nil {
+		return false, "", err
+	}
+
+	if !valid {
+		limiter.RecordFailure(ratelimit.MethodTOTP, clientIP, user.Username)
+		slog.Warn("Incorrect two-factor authentication code",
+			slog.Bool("authentication_failed", true),
+			slog.String("client_ip", clientIP),
+			slog.String("user_agent", r.UserAgent()),
+			slog.Int64("user_id", user.ID),
+			slog.String("username", user.Username),
+		)
+		return false, locale.NewLocalizedError("error.totp_invalid_code").Translate(user.Language), nil
+	}
+
+	return true, "", nil
+}
+
+func (h *handler) renderTOTPPage(w http.ResponseWriter, r *http.Request, user *model.User, errorMessage string) {
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+	view.Set("errorMessage", errorMessage)
+	view.Set("totpRequired", user.TOTPRequired || config.Opts.TOTPRequired())
+
+	if user.TOTPEnabled {
+		countRecoveryCodes, err := h.store.CountTOTPRecoveryCodes(user.ID)
+		if err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+		view.Set("countRecoveryCodes", countRecoveryCodes)
+	} else {
+		secret, err := h.store.TOTPSecret(user.ID)
+		if err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+		view.Set("totpSecret", secret)
+	}
+
+	html.OK(w, r, view.Render("totp"))
+}
+
+func (h *handler) renderTOTPRecoveryCodesPage(w http.ResponseWriter, r *http.Request, user *model.User, recoveryCodes []string) {
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+	view.Set("recoveryCodes", recoveryCodes)
+
+	html.OK(w, r, view.Render("totp_recovery_codes"))
+}
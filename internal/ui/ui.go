@@ -26,6 +26,7 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	uiRouter := router.NewRoute().Subrouter()
 	uiRouter.Use(middleware.handleUserSession)
 	uiRouter.Use(middleware.handleAppSession)
+	uiRouter.Use(middleware.handleTOTPEnrollment)
 	uiRouter.StrictSlash(true)
 
 	// Static assets.
@@ -55,6 +56,13 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	uiRouter.HandleFunc("/starred", handler.showStarredPage).Name("starred").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/starred/entry/{entryID}", handler.showStarredEntryPage).Name("starredEntry").Methods(http.MethodGet)
 
+	// Read later pages.
+	uiRouter.HandleFunc("/later", handler.showReadLaterPage).Name("readLater").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/later/entry/{entryID}", handler.showReadLaterEntryPage).Name("readLaterEntry").Methods(http.MethodGet)
+
+	// Snoozed entries.
+	uiRouter.HandleFunc("/snoozed", handler.showSnoozedEntriesPage).Name("snoozedEntries").Methods(http.MethodGet)
+
 	// Search pages.
 	uiRouter.HandleFunc("/search", handler.showSearchPage).Name("search").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/search/entry/{entryID}", handler.showSearchEntryPage).Name("searchEntry").Methods(http.MethodGet)
@@ -62,6 +70,8 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	// Feed listing pages.
 	uiRouter.HandleFunc("/feeds", handler.showFeedsPage).Name("feeds").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/feeds/refresh", handler.refreshAllFeeds).Name("refreshAllFeeds").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/feeds/batch", handler.showFeedBatchPage).Name("feedBatch").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/feeds/batch", handler.applyFeedBatch).Name("applyFeedBatch").Methods(http.MethodPost)
 
 	// Individual feed pages.
 	uiRouter.HandleFunc("/feed/{feedID}/refresh", handler.refreshFeed).Name("refreshFeed").Methods(http.MethodGet, http.MethodPost)
@@ -94,6 +104,16 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	uiRouter.HandleFunc("/category/{categoryID}/remove", handler.removeCategory).Name("removeCategory").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/category/{categoryID}/mark-all-as-read", handler.markCategoryAsRead).Name("markCategoryAsRead").Methods(http.MethodPost)
 
+	// Label pages.
+	uiRouter.HandleFunc("/labels", handler.showLabelListPage).Name("labels").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/label/create", handler.showCreateLabelPage).Name("createLabel").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/label/save", handler.saveLabel).Name("saveLabel").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/label/{labelID}/entries", handler.showLabelEntriesPage).Name("labelEntries").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/label/{labelID}/entry/{entryID}", handler.showLabelEntryPage).Name("labelEntry").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/label/{labelID}/edit", handler.showEditLabelPage).Name("editLabel").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/label/{labelID}/update", handler.updateLabel).Name("updateLabel").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/label/{labelID}/remove", handler.removeLabel).Name("removeLabel").Methods(http.MethodPost)
+
 	// Tag pages.
 	uiRouter.HandleFunc("/tags/{tagName}/entries/all", handler.showTagEntriesAllPage).Name("tagEntriesAll").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/tags/{tagName}/entry/{entryID}", handler.showTagEntryPage).Name("tagEntry").Methods(http.MethodGet)
@@ -105,6 +125,15 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	uiRouter.HandleFunc("/entry/download/{entryID}", handler.fetchContent).Name("fetchContent").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", handler.mediaProxy).Name("proxy").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/entry/star/{entryID}", handler.toggleStarred).Name("toggleStarred").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/entry/later/{entryID}", handler.toggleReadLater).Name("toggleReadLater").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/entry/snooze/{entryID}", handler.showSnoozeEntryPage).Name("snoozeEntry").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/entry/snooze/{entryID}", handler.snoozeEntry).Name("saveEntrySnooze").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/entry/unsnooze/{entryID}", handler.unsnoozeEntry).Name("unsnoozeEntry").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/entry/labels/{entryID}", handler.showEditEntryLabelsPage).Name("editEntryLabels").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/entry/labels/{entryID}", handler.updateEntryLabels).Name("updateEntryLabels").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/entry/highlights/{entryID}", handler.createHighlight).Name("createHighlight").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/highlight/{highlightID}/remove", handler.removeHighlight).Name("removeHighlight").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/highlights", handler.showHighlightsPage).Name("highlights").Methods(http.MethodGet)
 
 	// Share pages.
 	uiRouter.HandleFunc("/entry/share/{entryID}", handler.createSharedEntry).Name("shareEntry").Methods(http.MethodPost)
@@ -120,6 +149,19 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	uiRouter.HandleFunc("/users/{userID}/update", handler.updateUser).Name("updateUser").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/users/{userID}/remove", handler.removeUser).Name("removeUser").Methods(http.MethodPost)
 
+	// Invitation and registration pages.
+	uiRouter.HandleFunc("/invitations", handler.showInvitationsPage).Name("invitations").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/invitations/create", handler.showCreateInvitationPage).Name("createInvitation").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/invitations/save", handler.saveInvitation).Name("saveInvitation").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/invitations/{invitationID}/remove", handler.removeInvitation).Name("removeInvitation").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/registrations", handler.showRegistrationRequestsPage).Name("registrationRequests").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/registrations/settings", handler.updateRegistrationSettings).Name("updateRegistrationSettings").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/registrations/{requestID}/approve", handler.approveRegistrationRequest).Name("approveRegistrationRequest").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/registrations/{requestID}/reject", handler.rejectRegistrationRequest).Name("rejectRegistrationRequest").Methods(http.MethodPost)
+
+	// Audit log pages.
+	uiRouter.HandleFunc("/audit-logs", handler.showAuditLogsPage).Name("auditLogs").Methods(http.MethodGet)
+
 	// Settings pages.
 	uiRouter.HandleFunc("/settings", handler.showSettingsPage).Name("settings").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/settings", handler.updateSettings).Name("updateSettings").Methods(http.MethodPost)
@@ -131,6 +173,13 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	uiRouter.HandleFunc("/sessions", handler.showSessionsPage).Name("sessions").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/sessions/{sessionID}/remove", handler.removeSession).Name("removeSession").Methods(http.MethodPost)
 
+	// Two-factor authentication pages.
+	uiRouter.HandleFunc("/totp", handler.showTOTPPage).Name("totpSettings").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/totp/qrcode", handler.showTOTPQRCode).Name("totpQRCode").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/totp/enable", handler.enableTOTP).Name("totpEnable").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/totp/disable", handler.disableTOTP).Name("totpDisable").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/totp/recovery-codes", handler.regenerateTOTPRecoveryCodes).Name("totpRecoveryCodes").Methods(http.MethodPost)
+
 	// API Keys pages.
 	uiRouter.HandleFunc("/keys", handler.showAPIKeysPage).Name("apiKeys").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/keys/{keyID}/delete", handler.deleteAPIKey).Name("deleteAPIKey").Methods(http.MethodPost)
@@ -144,7 +193,7 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	uiRouter.HandleFunc("/fetch", handler.fetchOPML).Name("fetchOPML").Methods(http.MethodPost)
 
 	// OAuth2 flow.
-	if config.Opts.OAuth2Provider() != "" {
+	if config.Opts.HasOAuth2Providers() {
 		uiRouter.HandleFunc("/oauth2/{provider}/unlink", handler.oauth2Unlink).Name("oauth2Unlink").Methods(http.MethodGet)
 		uiRouter.HandleFunc("/oauth2/{provider}/redirect", handler.oauth2Redirect).Name("oauth2Redirect").Methods(http.MethodGet)
 		uiRouter.HandleFunc("/oauth2/{provider}/callback", handler.oauth2Callback).Name("oauth2Callback").Methods(http.MethodGet)
@@ -155,7 +204,12 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 
 	// Authentication pages.
 	uiRouter.HandleFunc("/login", handler.checkLogin).Name("checkLogin").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/login/totp", handler.checkLoginTOTP).Name("checkLoginTOTP").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/logout", handler.logout).Name("logout").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/register", handler.showRegistrationPage).Name("registration").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/register", handler.submitRegistration).Name("submitRegistration").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/invitation/{token}", handler.showInvitationRegistrationPage).Name("invitationRegistration").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/invitation/{token}", handler.submitInvitationRegistration).Name("submitInvitationRegistration").Methods(http.MethodPost)
 	uiRouter.Handle("/", middleware.handleAuthProxy(http.HandlerFunc(handler.showLoginPage))).Name("login").Methods(http.MethodGet)
 
 	// WebAuthn flow
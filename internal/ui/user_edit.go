@@ -39,8 +39,9 @@ func (h *handler) showEditUserPage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	userForm := &form.UserForm{
-		Username: selectedUser.Username,
-		IsAdmin:  selectedUser.IsAdmin,
+		Username:     selectedUser.Username,
+		IsAdmin:      selectedUser.IsAdmin,
+		TOTPRequired: selectedUser.TOTPRequired,
 	}
 
 	sess := session.New(h.store, request.SessionID(r))
@@ -35,6 +35,7 @@ func (h *handler) showUsersPage(w http.ResponseWriter, r *http.Request) {
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("users", users)
+	view.Set("countRegistrationRequests", h.store.CountRegistrationRequests())
 	view.Set("menu", "settings")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
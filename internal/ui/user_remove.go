@@ -10,6 +10,7 @@ import (
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response/html"
 	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/model"
 )
 
 func (h *handler) removeUser(w http.ResponseWriter, r *http.Request) {
@@ -46,5 +47,7 @@ func (h *handler) removeUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.store.Audit(request.UserID(r), model.AuditActionUserRemove, selectedUser.Username, request.ClientIP(r))
+
 	html.Redirect(w, r, route.Path(h.router, "users"))
 }
@@ -63,10 +63,16 @@ func (h *handler) saveUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if _, err := h.store.CreateUser(userCreationRequest); err != nil {
+	newUser, err := h.store.CreateUser(userCreationRequest)
+	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
+	h.store.Audit(request.UserID(r), model.AuditActionUserCreate, newUser.Username, request.ClientIP(r))
+	if newUser.IsAdmin {
+		h.store.Audit(request.UserID(r), model.AuditActionUserAdminGrant, newUser.Username, request.ClientIP(r))
+	}
+
 	html.Redirect(w, r, route.Path(h.router, "users"))
 }
@@ -10,6 +10,7 @@ import (
 	"miniflux.app/v2/internal/http/response/html"
 	"miniflux.app/v2/internal/http/route"
 	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/ui/form"
 	"miniflux.app/v2/internal/ui/session"
 	"miniflux.app/v2/internal/ui/view"
@@ -62,11 +63,20 @@ func (h *handler) updateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	wasAdmin := selectedUser.IsAdmin
 	userForm.Merge(selectedUser)
 	if err := h.store.UpdateUser(selectedUser); err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
+	h.store.Audit(request.UserID(r), model.AuditActionUserUpdate, selectedUser.Username, request.ClientIP(r))
+	switch {
+	case !wasAdmin && selectedUser.IsAdmin:
+		h.store.Audit(request.UserID(r), model.AuditActionUserAdminGrant, selectedUser.Username, request.ClientIP(r))
+	case wasAdmin && !selectedUser.IsAdmin:
+		h.store.Audit(request.UserID(r), model.AuditActionUserAdminRevoke, selectedUser.Username, request.ClientIP(r))
+	}
+
 	html.Redirect(w, r, route.Path(h.router, "users"))
 }
@@ -4,14 +4,16 @@
 package validator // import "miniflux.app/v2/internal/validator"
 
 import (
+	"time"
+
 	"miniflux.app/v2/internal/locale"
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/storage"
 )
 
 func ValidateAPIKeyCreation(store *storage.Storage, userID int64, request *model.APIKeyCreationRequest) *locale.LocalizedError {
-	if request.Description == "" {
-		return locale.NewLocalizedError("error.fields_mandatory")
+	if err := validateAPIKeyCreationRequest(request); err != nil {
+		return err
 	}
 
 	if store.APIKeyExists(userID, request.Description) {
@@ -20,3 +22,22 @@ func ValidateAPIKeyCreation(store *storage.Storage, userID int64, request *model
 
 	return nil
 }
+
+func validateAPIKeyCreationRequest(request *model.APIKeyCreationRequest) *locale.LocalizedError {
+	if request.Description == "" {
+		return locale.NewLocalizedError("error.fields_mandatory")
+	}
+
+	scopes := model.APIKeyScopes()
+	for _, scope := range request.Scopes {
+		if _, found := scopes[scope]; !found {
+			return locale.NewLocalizedError("error.api_key_invalid_scope")
+		}
+	}
+
+	if request.ExpiresAt != nil && !request.ExpiresAt.After(time.Now()) {
+		return locale.NewLocalizedError("error.api_key_expiration_in_past")
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestValidateAPIKeyCreationRequest(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	scenarios := []struct {
+		request *model.APIKeyCreationRequest
+		valid   bool
+	}{
+		{&model.APIKeyCreationRequest{Description: "key"}, true},
+		{&model.APIKeyCreationRequest{Description: ""}, false},
+		{&model.APIKeyCreationRequest{Description: "key", Scopes: []string{model.APIKeyScopeReadOnly, model.APIKeyScopeFever}}, true},
+		{&model.APIKeyCreationRequest{Description: "key", Scopes: []string{"admin"}}, false},
+		{&model.APIKeyCreationRequest{Description: "key", ExpiresAt: &future}, true},
+		{&model.APIKeyCreationRequest{Description: "key", ExpiresAt: &past}, false},
+	}
+
+	for _, scenario := range scenarios {
+		err := validateAPIKeyCreationRequest(scenario.request)
+		if scenario.valid && err != nil {
+			t.Errorf(`The request %+v should be valid, got %v`, scenario.request, err)
+		}
+		if !scenario.valid && err == nil {
+			t.Errorf(`The request %+v should be invalid`, scenario.request)
+		}
+	}
+}
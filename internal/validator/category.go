@@ -15,24 +15,92 @@ func ValidateCategoryCreation(store *storage.Storage, userID int64, request *mod
 		return locale.NewLocalizedError("error.title_required")
 	}
 
-	if store.CategoryTitleExists(userID, request.Title) {
+	if store.CategoryTitleExists(userID, request.ParentID, request.Title) {
 		return locale.NewLocalizedError("error.category_already_exists")
 	}
 
+	if request.ParentID != 0 && !store.CategoryIDExists(userID, request.ParentID) {
+		return locale.NewLocalizedError("error.parent_category_not_found")
+	}
+
+	if err := ValidateRetentionPolicy(request.RetentionPolicy, request.RetentionValue); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 // ValidateCategoryModification validates category modification.
-func ValidateCategoryModification(store *storage.Storage, userID, categoryID int64, request *model.CategoryModificationRequest) *locale.LocalizedError {
-	if request.Title != nil {
-		if *request.Title == "" {
-			return locale.NewLocalizedError("error.title_required")
+// The title must remain unique among the subcategories of the new parent category.
+func ValidateCategoryModification(store *storage.Storage, userID int64, category *model.Category, request *model.CategoryModificationRequest) *locale.LocalizedError {
+	categoryID := category.ID
+
+	if request.Title != nil && *request.Title == "" {
+		return locale.NewLocalizedError("error.title_required")
+	}
+
+	if request.Title != nil || request.ParentID != nil {
+		title, parentID := category.Title, category.ParentID
+		if request.Title != nil {
+			title = *request.Title
+		}
+		if request.ParentID != nil {
+			parentID = *request.ParentID
 		}
 
-		if store.AnotherCategoryExists(userID, categoryID, *request.Title) {
+		if store.AnotherCategoryExists(userID, categoryID, parentID, title) {
 			return locale.NewLocalizedError("error.category_already_exists")
 		}
 	}
 
+	if request.ParentID != nil && *request.ParentID != 0 {
+		if !store.CategoryIDExists(userID, *request.ParentID) {
+			return locale.NewLocalizedError("error.parent_category_not_found")
+		}
+
+		// A category cannot be moved under itself or one of its subcategories.
+		if *request.ParentID == categoryID || store.CategoryHasDescendant(userID, categoryID, *request.ParentID) {
+			return locale.NewLocalizedError("error.category_parent_cycle")
+		}
+	}
+
+	if err := validateRetentionPolicyModification(request.RetentionPolicy, request.RetentionValue); err != nil {
+		return err
+	}
+
+	if request.BlocklistRules != nil && !IsValidRegex(*request.BlocklistRules) {
+		return locale.NewLocalizedError("error.feed_invalid_blocklist_rule")
+	}
+
+	if request.KeeplistRules != nil && !IsValidRegex(*request.KeeplistRules) {
+		return locale.NewLocalizedError("error.feed_invalid_keeplist_rule")
+	}
+
+	if request.BlockFilterEntryRules != nil && *request.BlockFilterEntryRules != "" {
+		if err := isValidFilterRules(*request.BlockFilterEntryRules, "block"); err != nil {
+			return err
+		}
+	}
+
+	if request.KeepFilterEntryRules != nil && *request.KeepFilterEntryRules != "" {
+		if err := isValidFilterRules(*request.KeepFilterEntryRules, "keep"); err != nil {
+			return err
+		}
+	}
+
+	if request.ProxyURL != nil && *request.ProxyURL != "" && !IsValidURL(*request.ProxyURL) {
+		return locale.NewLocalizedError("error.invalid_feed_proxy_url")
+	}
+
+	return nil
+}
+
+// ValidateCategoryRemoval validates category removal.
+// The subcategories must not conflict with the categories of the parent category they are moved to.
+func ValidateCategoryRemoval(store *storage.Storage, userID, categoryID int64) *locale.LocalizedError {
+	if store.SubcategoryTitleConflicts(userID, categoryID) {
+		return locale.NewLocalizedError("error.category_subcategory_title_conflict")
+	}
+
 	return nil
 }
@@ -5,7 +5,9 @@ package validator // import "miniflux.app/v2/internal/validator"
 
 import (
 	"fmt"
+	"time"
 
+	"miniflux.app/v2/internal/locale"
 	"miniflux.app/v2/internal/model"
 )
 
@@ -50,3 +52,12 @@ func ValidateEntryModification(request *model.EntryUpdateRequest) error {
 
 	return nil
 }
+
+// ValidateEntrySnooze makes sure the entry is snoozed until a time in the future.
+func ValidateEntrySnooze(snoozedUntil time.Time) *locale.LocalizedError {
+	if !snoozedUntil.After(time.Now()) {
+		return locale.NewLocalizedError("error.snooze_time_in_past")
+	}
+
+	return nil
+}
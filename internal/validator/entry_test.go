@@ -5,6 +5,7 @@ package validator // import "miniflux.app/v2/internal/validator"
 
 import (
 	"testing"
+	"time"
 
 	"miniflux.app/v2/internal/model"
 )
@@ -57,3 +58,13 @@ func TestValidateEntryOrder(t *testing.T) {
 		t.Error(`An invalid order should generate a error`)
 	}
 }
+
+func TestValidateEntrySnooze(t *testing.T) {
+	if err := ValidateEntrySnooze(time.Now().Add(time.Hour)); err != nil {
+		t.Error(`A time in the future should be accepted`)
+	}
+
+	if err := ValidateEntrySnooze(time.Now().Add(-time.Hour)); err == nil {
+		t.Error(`A time in the past should be rejected`)
+	}
+}
@@ -4,6 +4,8 @@
 package validator // import "miniflux.app/v2/internal/validator"
 
 import (
+	"time"
+
 	"miniflux.app/v2/internal/locale"
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/storage"
@@ -39,6 +41,22 @@ func ValidateFeedCreation(store *storage.Storage, userID int64, request *model.F
 		return locale.NewLocalizedError("error.invalid_feed_proxy_url")
 	}
 
+	if err := ValidateProxyPool(request.ProxyPool); err != nil {
+		return err
+	}
+
+	if err := ValidateHTTPHeaders(request.HTTPHeaders); err != nil {
+		return err
+	}
+
+	if err := ValidateClientCertificate(request.ClientCertificate, request.ClientKey); err != nil {
+		return err
+	}
+
+	if err := ValidateCACertificates(request.CACertificates); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -102,5 +120,43 @@ func ValidateFeedModification(store *storage.Storage, userID, feedID int64, requ
 		}
 	}
 
+	if request.ProxyPool != nil {
+		if err := ValidateProxyPool(*request.ProxyPool); err != nil {
+			return err
+		}
+	}
+
+	if request.HTTPHeaders != nil {
+		if err := ValidateHTTPHeaders(*request.HTTPHeaders); err != nil {
+			return err
+		}
+	}
+
+	if request.ClientCertificate != nil || request.ClientKey != nil {
+		if request.ClientCertificate == nil || request.ClientKey == nil {
+			return locale.NewLocalizedError("error.feed_invalid_client_certificate")
+		}
+
+		if err := ValidateClientCertificate(*request.ClientCertificate, *request.ClientKey); err != nil {
+			return err
+		}
+	}
+
+	if request.CACertificates != nil {
+		if err := ValidateCACertificates(*request.CACertificates); err != nil {
+			return err
+		}
+	}
+
+	if request.PausedUntil != nil && *request.PausedUntil != "" {
+		if _, err := time.Parse(time.RFC3339, *request.PausedUntil); err != nil {
+			return locale.NewLocalizedError("error.feed_invalid_paused_until")
+		}
+	}
+
+	if err := validateRetentionPolicyModification(request.RetentionPolicy, request.RetentionValue); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+)
+
+// ValidateFeedBatchRequest validates a batch request before it is applied to each feed.
+func ValidateFeedBatchRequest(store *storage.Storage, userID int64, request *model.FeedBatchRequest) *locale.LocalizedError {
+	if err := validateFeedBatchRequest(request); err != nil {
+		return err
+	}
+
+	if request.Action == model.FeedBatchActionMove && !store.CategoryIDExists(userID, request.CategoryID) {
+		return locale.NewLocalizedError("error.feed_category_not_found")
+	}
+
+	return nil
+}
+
+// ValidateFeedBatchFeeds validates the batch request against each selected feed.
+// The returned map is keyed by feed ID and is empty when all feeds are valid.
+func ValidateFeedBatchFeeds(store *storage.Storage, userID int64, request *model.FeedBatchRequest, feeds model.Feeds) map[int64]*locale.LocalizedError {
+	errors := make(map[int64]*locale.LocalizedError)
+
+	found := make(map[int64]bool, len(feeds))
+	for _, feed := range feeds {
+		found[feed.ID] = true
+	}
+
+	for _, feedID := range request.FeedIDs {
+		if !found[feedID] {
+			errors[feedID] = locale.NewLocalizedError("error.feed_not_found")
+		}
+	}
+
+	if request.Action == model.FeedBatchActionUpdate {
+		for _, feed := range feeds {
+			if err := ValidateFeedModification(store, userID, feed.ID, request.Changes); err != nil {
+				errors[feed.ID] = err
+			}
+		}
+	}
+
+	return errors
+}
+
+func validateFeedBatchRequest(request *model.FeedBatchRequest) *locale.LocalizedError {
+	if len(request.FeedIDs) == 0 {
+		return locale.NewLocalizedError("error.feed_batch_empty")
+	}
+
+	switch request.Action {
+	case model.FeedBatchActionMove:
+		if request.CategoryID <= 0 {
+			return locale.NewLocalizedError("error.feed_category_not_found")
+		}
+	case model.FeedBatchActionUpdate:
+		if request.Changes == nil {
+			return locale.NewLocalizedError("error.feed_batch_missing_changes")
+		}
+
+		if request.Changes.FeedURL != nil {
+			return locale.NewLocalizedError("error.feed_batch_feed_url_not_allowed")
+		}
+	case model.FeedBatchActionEnable, model.FeedBatchActionDisable, model.FeedBatchActionRefresh, model.FeedBatchActionDelete:
+	default:
+		return locale.NewLocalizedError("error.feed_batch_invalid_action")
+	}
+
+	return nil
+}
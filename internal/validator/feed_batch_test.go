@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestValidateFeedBatchRequest(t *testing.T) {
+	feedURL := "https://example.org/feed.xml"
+	crawler := true
+
+	scenarios := []struct {
+		request model.FeedBatchRequest
+		valid   bool
+	}{
+		{model.FeedBatchRequest{FeedIDs: []int64{1, 2}, Action: model.FeedBatchActionRefresh}, true},
+		{model.FeedBatchRequest{FeedIDs: []int64{1}, Action: model.FeedBatchActionDelete}, true},
+		{model.FeedBatchRequest{FeedIDs: []int64{1}, Action: model.FeedBatchActionEnable}, true},
+		{model.FeedBatchRequest{FeedIDs: []int64{1}, Action: model.FeedBatchActionDisable}, true},
+		{model.FeedBatchRequest{FeedIDs: []int64{1}, Action: model.FeedBatchActionMove, CategoryID: 3}, true},
+		{model.FeedBatchRequest{FeedIDs: []int64{1}, Action: model.FeedBatchActionUpdate, Changes: &model.FeedModificationRequest{Crawler: &crawler}}, true},
+		{model.FeedBatchRequest{Action: model.FeedBatchActionRefresh}, false},
+		{model.FeedBatchRequest{FeedIDs: []int64{1}, Action: "archive"}, false},
+		{model.FeedBatchRequest{FeedIDs: []int64{1}, Action: model.FeedBatchActionMove}, false},
+		{model.FeedBatchRequest{FeedIDs: []int64{1}, Action: model.FeedBatchActionUpdate}, false},
+		{model.FeedBatchRequest{FeedIDs: []int64{1}, Action: model.FeedBatchActionUpdate, Changes: &model.FeedModificationRequest{FeedURL: &feedURL}}, false},
+	}
+
+	for _, scenario := range scenarios {
+		err := validateFeedBatchRequest(&scenario.request)
+		if scenario.valid && err != nil {
+			t.Errorf(`The request %+v should be valid, got %v`, scenario.request, err)
+		}
+		if !scenario.valid && err == nil {
+			t.Errorf(`The request %+v should be rejected`, scenario.request)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestValidateHighlightCreation(t *testing.T) {
+	scenarios := []struct {
+		request model.HighlightCreationRequest
+		valid   bool
+	}{
+		{model.HighlightCreationRequest{Quote: "Some text", StartOffset: 10, EndOffset: 19}, true},
+		{model.HighlightCreationRequest{Quote: "Some text", Note: "A note"}, true},
+		{model.HighlightCreationRequest{Quote: "  ", StartOffset: 0, EndOffset: 2}, false},
+		{model.HighlightCreationRequest{Quote: "Some text", StartOffset: -1, EndOffset: 8}, false},
+		{model.HighlightCreationRequest{Quote: "Some text", StartOffset: 20, EndOffset: 10}, false},
+	}
+
+	for _, scenario := range scenarios {
+		err := ValidateHighlightCreation(&scenario.request)
+		if scenario.valid && err != nil {
+			t.Errorf(`The request %+v should be valid, got %v`, scenario.request, err)
+		}
+		if !scenario.valid && err == nil {
+			t.Errorf(`The request %+v should be rejected`, scenario.request)
+		}
+	}
+}
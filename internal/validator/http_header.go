@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"errors"
+	"strings"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+)
+
+// ValidateHTTPHeaders validates the custom HTTP headers of a feed.
+// Headers are stored encrypted, so an encryption key must be configured to use them.
+func ValidateHTTPHeaders(value string) *locale.LocalizedError {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+
+	if _, err := model.ParseHTTPHeaders(value); err != nil {
+		if errors.Is(err, model.ErrForbiddenHTTPHeader) {
+			return locale.NewLocalizedError("error.feed_forbidden_http_header")
+		}
+		return locale.NewLocalizedError("error.feed_invalid_http_headers")
+	}
+
+	if config.Opts.EncryptionKey() == "" {
+		return locale.NewLocalizedError("error.encryption_key_missing")
+	}
+
+	return nil
+}
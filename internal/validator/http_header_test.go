@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"os"
+	"testing"
+
+	"miniflux.app/v2/internal/config"
+)
+
+func TestValidateHTTPHeaders(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ENCRYPTION_KEY", "secret")
+
+	var err error
+	if config.Opts, err = config.NewParser().ParseEnvironmentVariables(); err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	scenarios := map[string]bool{
+		"":                              true,
+		"Authorization: Bearer token":   true,
+		"X-Api-Key: abc\nX-Other: def":  true,
+		"Authorization":                 false,
+		"Bad Header: value":             false,
+		"Connection: close":             false,
+		"Transfer-Encoding: chunked":    false,
+		"X-Api-Key: abc\nKeep-Alive: 5": false,
+	}
+
+	for value, valid := range scenarios {
+		err := ValidateHTTPHeaders(value)
+		if valid && err != nil {
+			t.Errorf(`The headers %q should be valid, got %v`, value, err)
+		}
+		if !valid && err == nil {
+			t.Errorf(`The headers %q should be rejected`, value)
+		}
+	}
+}
+
+func TestValidateHTTPHeadersWithoutEncryptionKey(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	if config.Opts, err = config.NewParser().ParseEnvironmentVariables(); err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if err := ValidateHTTPHeaders("Authorization: Bearer token"); err == nil {
+		t.Error(`Custom headers should be rejected when no encryption key is configured`)
+	}
+}
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"time"
+
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+)
+
+// ValidateInvitationCreation validates invitation creation.
+func ValidateInvitationCreation(request *model.InvitationCreationRequest) *locale.LocalizedError {
+	if request.MaxUses < 0 {
+		return locale.NewLocalizedError("error.invitation_invalid_max_uses")
+	}
+
+	if request.ExpiresAt != nil && !request.ExpiresAt.After(time.Now()) {
+		return locale.NewLocalizedError("error.invitation_expiration_in_past")
+	}
+
+	if request.Language != "" {
+		if err := validateLanguage(request.Language); err != nil {
+			return err
+		}
+	}
+
+	for _, title := range request.Categories {
+		if title == "" {
+			return locale.NewLocalizedError("error.title_required")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestValidateInvitationCreation(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	scenarios := []struct {
+		request *model.InvitationCreationRequest
+		valid   bool
+	}{
+		{&model.InvitationCreationRequest{}, true},
+		{&model.InvitationCreationRequest{Description: "family", MaxUses: 5, ExpiresAt: &future}, true},
+		{&model.InvitationCreationRequest{MaxUses: -1}, false},
+		{&model.InvitationCreationRequest{ExpiresAt: &past}, false},
+		{&model.InvitationCreationRequest{Language: "fr_FR", Categories: []string{"News", "Blogs"}}, true},
+		{&model.InvitationCreationRequest{Language: "xx_XX"}, false},
+		{&model.InvitationCreationRequest{Categories: []string{"News", ""}}, false},
+	}
+
+	for _, scenario := range scenarios {
+		err := ValidateInvitationCreation(scenario.request)
+		if scenario.valid && err != nil {
+			t.Errorf(`The request %+v should be valid, got %v`, scenario.request, err)
+		}
+		if !scenario.valid && err == nil {
+			t.Errorf(`The request %+v should be invalid`, scenario.request)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/locale"
+)
+
+// ValidateProxyPool checks that the selected proxy pool is defined in the configuration.
+// An empty name selects the default proxy rotation.
+func ValidateProxyPool(name string) *locale.LocalizedError {
+	if name == "" {
+		return nil
+	}
+
+	if _, found := config.Opts.HTTPClientProxyPools()[name]; !found {
+		return locale.NewLocalizedError("error.feed_invalid_proxy_pool")
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"os"
+	"testing"
+
+	"miniflux.app/v2/internal/config"
+)
+
+func TestValidateProxyPool(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_PROXY_POOLS", "europe=socks5://proxy.example.com:1080")
+
+	var err error
+	if config.Opts, err = config.NewParser().ParseEnvironmentVariables(); err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if err := ValidateProxyPool(""); err != nil {
+		t.Errorf(`An empty proxy pool should be valid, got %v`, err)
+	}
+
+	if err := ValidateProxyPool("europe"); err != nil {
+		t.Errorf(`A configured proxy pool should be valid, got %v`, err)
+	}
+
+	if err := ValidateProxyPool("asia"); err == nil {
+		t.Error(`An unknown proxy pool should be invalid`)
+	}
+}
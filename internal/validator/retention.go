@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+)
+
+// ValidateRetentionPolicy makes sure the retention policy and its value are valid.
+func ValidateRetentionPolicy(policy string, value int) *locale.LocalizedError {
+	if _, found := model.RetentionPolicies()[policy]; !found {
+		return locale.NewLocalizedError("error.invalid_retention_policy")
+	}
+
+	switch policy {
+	case model.RetentionPolicyDays, model.RetentionPolicyCount:
+		if value < 1 {
+			return locale.NewLocalizedError("error.invalid_retention_value")
+		}
+	}
+
+	return nil
+}
+
+func validateRetentionPolicyModification(policy *string, value *int) *locale.LocalizedError {
+	if policy == nil {
+		if value != nil && *value < 0 {
+			return locale.NewLocalizedError("error.invalid_retention_value")
+		}
+		return nil
+	}
+
+	var retentionValue int
+	if value != nil {
+		retentionValue = *value
+	}
+
+	return ValidateRetentionPolicy(*policy, retentionValue)
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestValidateRetentionPolicy(t *testing.T) {
+	scenarios := []struct {
+		policy string
+		value  int
+		valid  bool
+	}{
+		{model.RetentionPolicyDefault, 0, true},
+		{model.RetentionPolicyNever, 0, true},
+		{model.RetentionPolicyDays, 1, true},
+		{model.RetentionPolicyCount, 100, true},
+		{model.RetentionPolicyDays, 0, false},
+		{model.RetentionPolicyCount, -1, false},
+		{"weeks", 2, false},
+	}
+
+	for _, scenario := range scenarios {
+		err := ValidateRetentionPolicy(scenario.policy, scenario.value)
+		if scenario.valid && err != nil {
+			t.Errorf(`The policy %q with value %d should be valid, got %v`, scenario.policy, scenario.value, err)
+		}
+
+		if !scenario.valid && err == nil {
+			t.Errorf(`The policy %q with value %d should be invalid`, scenario.policy, scenario.value)
+		}
+	}
+}
+
+func TestValidateRetentionPolicyModification(t *testing.T) {
+	days := model.RetentionPolicyDays
+	never := model.RetentionPolicyNever
+	zero := 0
+	negative := -1
+	thirty := 30
+
+	if err := validateRetentionPolicyModification(nil, nil); err != nil {
+		t.Errorf(`An empty modification should be valid, got %v`, err)
+	}
+
+	if err := validateRetentionPolicyModification(nil, &negative); err == nil {
+		t.Error(`A negative retention value should be invalid`)
+	}
+
+	if err := validateRetentionPolicyModification(&days, &thirty); err != nil {
+		t.Errorf(`A retention of 30 days should be valid, got %v`, err)
+	}
+
+	if err := validateRetentionPolicyModification(&days, nil); err == nil {
+		t.Error(`A retention in days without value should be invalid`)
+	}
+
+	if err := validateRetentionPolicyModification(&never, &zero); err != nil {
+		t.Errorf(`The policy "never" should be valid without value, got %v`, err)
+	}
+}
@@ -18,5 +18,21 @@ func ValidateSubscriptionDiscovery(request *model.SubscriptionDiscoveryRequest)
 		return locale.NewLocalizedError("error.invalid_proxy_url")
 	}
 
+	if err := ValidateProxyPool(request.ProxyPool); err != nil {
+		return err
+	}
+
+	if err := ValidateHTTPHeaders(request.HTTPHeaders); err != nil {
+		return err
+	}
+
+	if err := ValidateClientCertificate(request.ClientCertificate, request.ClientKey); err != nil {
+		return err
+	}
+
+	if err := ValidateCACertificates(request.CACertificates); err != nil {
+		return err
+	}
+
 	return nil
 }
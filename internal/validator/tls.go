@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/locale"
+)
+
+// ValidateClientCertificate validates the PEM encoded certificate and private key used for mutual TLS.
+// Both values must be provided together, they are stored encrypted.
+func ValidateClientCertificate(certificate, key string) *locale.LocalizedError {
+	if certificate == "" && key == "" {
+		return nil
+	}
+
+	if _, err := tls.X509KeyPair([]byte(certificate), []byte(key)); err != nil {
+		return locale.NewLocalizedError("error.feed_invalid_client_certificate")
+	}
+
+	if config.Opts.EncryptionKey() == "" {
+		return locale.NewLocalizedError("error.encryption_key_missing")
+	}
+
+	return nil
+}
+
+// ValidateCACertificates validates a bundle of PEM encoded certificate authorities.
+func ValidateCACertificates(certificates string) *locale.LocalizedError {
+	if certificates == "" {
+		return nil
+	}
+
+	if !x509.NewCertPool().AppendCertsFromPEM([]byte(certificates)) {
+		return locale.NewLocalizedError("error.feed_invalid_ca_certificates")
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+)
+
+func TestValidateClientCertificate(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ENCRYPTION_KEY", "secret")
+
+	var err error
+	if config.Opts, err = config.NewParser().ParseEnvironmentVariables(); err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	certificate, key := generateCertificate(t)
+	otherCertificate, _ := generateCertificate(t)
+
+	if err := ValidateClientCertificate("", ""); err != nil {
+		t.Errorf(`An empty client certificate should be valid, got %v`, err)
+	}
+
+	if err := ValidateClientCertificate(certificate, key); err != nil {
+		t.Errorf(`The client certificate should be valid, got %v`, err)
+	}
+
+	if err := ValidateClientCertificate(certificate, ""); err == nil {
+		t.Error(`A client certificate without key should be rejected`)
+	}
+
+	if err := ValidateClientCertificate(otherCertificate, key); err == nil {
+		t.Error(`A client certificate with a mismatched key should be rejected`)
+	}
+}
+
+func TestValidateCACertificates(t *testing.T) {
+	certificate, _ := generateCertificate(t)
+
+	if err := ValidateCACertificates(""); err != nil {
+		t.Errorf(`An empty CA bundle should be valid, got %v`, err)
+	}
+
+	if err := ValidateCACertificates(certificate); err != nil {
+		t.Errorf(`The CA bundle should be valid, got %v`, err)
+	}
+
+	if err := ValidateCACertificates("not a certificate"); err == nil {
+		t.Error(`An invalid CA bundle should be rejected`)
+	}
+}
+
+func generateCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "miniflux"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: key}))
+}
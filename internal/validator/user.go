@@ -34,6 +34,29 @@ func ValidateUserCreationWithPassword(store *storage.Storage, request *model.Use
 	return nil
 }
 
+// ValidateUserRegistration validates the account requested with an invitation or the open registration form.
+// The availability of the username is not checked, see IsUsernameAvailable.
+func ValidateUserRegistration(request *model.UserCreationRequest) *locale.LocalizedError {
+	if request.Username == "" {
+		return locale.NewLocalizedError("error.user_mandatory_fields")
+	}
+
+	if err := validateUsername(request.Username); err != nil {
+		return err
+	}
+
+	if err := validatePassword(request.Password); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IsUsernameAvailable returns true if the username is not used by a user or by a pending registration request.
+func IsUsernameAvailable(store *storage.Storage, username string) bool {
+	return !store.UserExists(username) && !store.RegistrationRequestExists(username)
+}
+
 // ValidateUserModification validates user modifications.
 func ValidateUserModification(store *storage.Storage, userID int64, changes *model.UserModificationRequest) *locale.LocalizedError {
 	if changes.Username != nil {
@@ -98,6 +121,12 @@ func ValidateUserModification(store *storage.Storage, userID int64, changes *mod
 		}
 	}
 
+	if changes.EntryListLayout != nil {
+		if err := validateEntryListLayout(*changes.EntryListLayout); err != nil {
+			return err
+		}
+	}
+
 	if changes.GestureNav != nil {
 		if err := validateGestureNav(*changes.GestureNav); err != nil {
 			return err
@@ -146,6 +175,10 @@ func ValidateUserModification(store *storage.Storage, userID int64, changes *mod
 		}
 	}
 
+	if err := validateRetentionPolicyModification(changes.RetentionPolicy, changes.RetentionValue); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -240,6 +273,13 @@ func validateDisplayMode(displayMode string) *locale.LocalizedError {
 	return nil
 }
 
+func validateEntryListLayout(layout string) *locale.LocalizedError {
+	if _, found := model.EntryListLayouts()[layout]; !found {
+		return locale.NewLocalizedError("error.invalid_entry_list_layout")
+	}
+	return nil
+}
+
 func validateGestureNav(gestureNav string) *locale.LocalizedError {
 	if gestureNav != "none" && gestureNav != "tap" && gestureNav != "swipe" {
 		return locale.NewLocalizedError("error.invalid_gesture_nav")
@@ -264,7 +304,7 @@ func validateMediaPlaybackRate(mediaPlaybackRate float64) *locale.LocalizedError
 
 func isValidFilterRules(filterEntryRules string, filterType string) *locale.LocalizedError {
 	// Valid Format: FieldName=RegEx\nFieldName=RegEx...
-	fieldNames := []string{"EntryTitle", "EntryURL", "EntryCommentsURL", "EntryContent", "EntryAuthor", "EntryTag", "EntryDate"}
+	fieldNames := []string{"EntryTitle", "EntryURL", "EntryCommentsURL", "EntryContent", "EntryAuthor", "EntryTag", "EntryDate", "EntryLanguage"}
 
 	rules := strings.Split(filterEntryRules, "\n")
 	for i, rule := range rules {
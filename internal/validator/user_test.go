@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestValidateUserRegistration(t *testing.T) {
+	scenarios := []struct {
+		request *model.UserCreationRequest
+		valid   bool
+	}{
+		{&model.UserCreationRequest{Username: "alice", Password: "secret123"}, true},
+		{&model.UserCreationRequest{Username: "alice@example.org", Password: "secret123"}, true},
+		{&model.UserCreationRequest{Password: "secret123"}, false},
+		{&model.UserCreationRequest{Username: "alice bob", Password: "secret123"}, false},
+		{&model.UserCreationRequest{Username: "alice", Password: "12345"}, false},
+	}
+
+	for _, scenario := range scenarios {
+		err := ValidateUserRegistration(scenario.request)
+		if scenario.valid && err != nil {
+			t.Errorf(`The request %+v should be valid, got %v`, scenario.request, err)
+		}
+		if !scenario.valid && err == nil {
+			t.Errorf(`The request %+v should be invalid`, scenario.request)
+		}
+	}
+}